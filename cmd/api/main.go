@@ -1,11 +1,17 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/configs"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bootstrap"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/migrations"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/server"
 
 	// Import swagger docs - this is important!
@@ -54,6 +60,9 @@ import (
 //	@Failure	500	{object}	models.SwaggerErrorResponse
 //	@Failure	503	{object}	models.SwaggerErrorResponse
 
+// backgroundShutdownTimeout is how long background jobs get to stop once the server has stopped
+const backgroundShutdownTimeout = 30 * time.Second
+
 func main() {
 
 	appContainer := bootstrap.Bootstrap()
@@ -63,11 +72,29 @@ func main() {
 	// Initialize logger
 	logger.InitLogger(configs.AppConfig.Env)
 
+	// Refuse to serve a database whose schema this build was not written for
+	migrator := migrations.NewMigrator(&migrations.Env{DB: appContainer.MongoDatabase, Files: appContainer.FileService})
+	if err := migrator.Check(context.Background()); err != nil {
+		log.Fatalf("Database is not migrated, run `migrate up` first: %v", err)
+	}
+
+	// Stop on SIGINT or SIGTERM, letting requests and background jobs finish first
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	appContainer.StartBackgroundJobs()
+
 	log.Println("Starting WeCare Holidays API server...")
 	log.Println("Swagger UI should be available at /swagger/index.html")
 
 	// Start the server
-	if err := server.StartServer(appContainer); err != nil {
+	if err := server.StartServer(ctx, appContainer); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
+
+	log.Println("Shutting down background jobs...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), backgroundShutdownTimeout)
+	defer cancel()
+	appContainer.Shutdown(shutdownCtx)
+	log.Println("Server stopped")
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bootstrap"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/migrations"
+)
+
+const usage = `Usage: migrate <command> [flags]
+
+Commands:
+  up      Apply pending migrations (-to VERSION stops after that version)
+  down    Revert applied migrations (-steps N, default 1)
+  status  List every migration and whether it is applied
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+	command := os.Args[1]
+
+	// Define per-command flags
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	to := flags.Int("to", 0, "Apply migrations up to and including this version (up only)")
+	steps := flags.Int("steps", 1, "Number of migrations to revert (down only)")
+	flags.Parse(os.Args[2:])
+
+	// Bootstrap application container
+	appContainer := bootstrap.Bootstrap()
+	migrator := migrations.NewMigrator(&migrations.Env{
+		DB:    appContainer.MongoDatabase,
+		Files: appContainer.FileService,
+	})
+	ctx := context.Background()
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx, *to)
+		if err != nil {
+			log.Fatalf("Migration failed after applying %d: %v", len(applied), err)
+		}
+		if len(applied) == 0 {
+			log.Println("Database is already up to date ✅")
+			return
+		}
+		log.Printf("Applied %d migrations successfully ✅", len(applied))
+	case "down":
+		if *steps < 1 {
+			log.Fatalf("-steps must be at least 1")
+		}
+		reverted, err := migrator.Down(ctx, *steps)
+		if err != nil {
+			log.Fatalf("Revert failed after reverting %d: %v", len(reverted), err)
+		}
+		log.Printf("Reverted %d migrations successfully ✅", len(reverted))
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		for _, status := range statuses {
+			appliedAt := "-"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%-9s %-19s %s\n", status.State, appliedAt, status.Name)
+		}
+	default:
+		fmt.Print(usage)
+		os.Exit(2)
+	}
+}
@@ -1,25 +1,89 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bootstrap"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/seeder"
 )
 
 func main() {
-	// Define --force flag
+	// "export" dumps roles and permissions instead of seeding
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		export(os.Args[2:])
+		return
+	}
+
+	// Define seeding flags
+	profile := flag.String("profile", seeder.ProfileDemo, "Seed profile: "+strings.Join(seeder.Profiles, ", "))
+	file := flag.String("file", "", "Seed file to load instead of a profile")
 	force := flag.Bool("force", false, "Force reseed: wipes existing data before seeding")
+	dryRun := flag.Bool("dry-run", false, "Print the planned changes without writing them")
 	flag.Parse()
 
+	// Load seed data from the file or profile
+	var data *seeder.SeedData
+	var err error
+	if *file != "" {
+		data, err = seeder.LoadSeedFile(*file)
+	} else {
+		data, err = seeder.LoadProfile(*profile)
+	}
+	if err != nil {
+		log.Fatalf("Failed to load seed data: %v", err)
+	}
+
 	// Bootstrap application container
 	appContainer := bootstrap.Bootstrap()
 
-	// Run seeder with force flag
-	if err := seeder.RunSeeder(appContainer, *force); err != nil {
+	// Run seeder with the selected options
+	plan, err := seeder.RunSeeder(appContainer, data, seeder.Options{Force: *force, DryRun: *dryRun})
+	if plan != nil {
+		plan.Print(os.Stdout)
+	}
+	if err != nil {
 		log.Fatalf("Seeder failed: %v", err)
 	}
 
+	if *dryRun {
+		log.Println("Seeder dry run completed, nothing was written ✅")
+		return
+	}
 	log.Println("Seeder completed successfully ✅")
 }
+
+// export writes the stored roles and permissions as seed JSON
+func export(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	out := flags.String("out", "", "File to write the seed JSON to (default stdout)")
+	flags.Parse(args)
+
+	// Bootstrap application container
+	appContainer := bootstrap.Bootstrap()
+
+	data, err := seeder.ExportSeedData(context.Background(), appContainer)
+	if err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+
+	encoded, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+	encoded = append(encoded, '\n')
+
+	if *out == "" {
+		fmt.Print(string(encoded))
+		return
+	}
+	if err := os.WriteFile(*out, encoded, 0o644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *out, err)
+	}
+	log.Printf("Exported %d permissions and %d roles to %s ✅", len(data.Permissions), len(data.Roles), *out)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bootstrap"
+)
+
+func main() {
+	// Define flags; the grace period defaults to STORAGE_GC_GRACE_PERIOD_HOURS
+	dryRun := flag.Bool("dry-run", false, "Report orphaned and missing files without deleting anything")
+	grace := flag.Duration("grace", -1, "Only delete orphans last modified longer ago than this, e.g. 72h")
+	asJSON := flag.Bool("json", false, "Print the full report as JSON")
+	flag.Parse()
+
+	// Bootstrap application container
+	appContainer := bootstrap.Bootstrap()
+
+	reconcile := *appContainer.Upload.ReconcileStorageUseCase
+	if *grace >= 0 {
+		reconcile.GracePeriod = *grace
+	}
+
+	report, err := reconcile.Execute(context.Background(), *dryRun)
+	if err != nil {
+		log.Fatalf("Storage reconciliation failed: %v", err)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+	} else {
+		for _, orphan := range report.Orphans {
+			log.Printf("orphan  %s (%d bytes, modified %s, deleted=%t)", orphan.Key, orphan.Size, orphan.LastModified.Format("2006-01-02 15:04"), orphan.Deleted)
+		}
+		for _, missing := range report.Missing {
+			log.Printf("missing %s (referenced by %s %s)", missing.Key, missing.Collection, missing.DocumentID)
+		}
+	}
+
+	log.Printf("Scanned %d files, %d referenced keys: %d orphaned (%d bytes), %d deleted, %d missing",
+		report.ScannedObjects, report.ReferencedKeys, len(report.Orphans), report.OrphanedBytes, report.DeletedCount, len(report.Missing))
+	log.Println("Storage reconciliation completed successfully ✅")
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// CacheService defines the interface for key/value caching
+type CacheService interface {
+	// Get loads the cached value for key into dest and reports whether it was found
+	Get(ctx context.Context, key string, dest interface{}) (bool, error)
+
+	// Set stores value under key for the given TTL (0 means no expiry)
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+
+	// Delete removes one or more keys
+	Delete(ctx context.Context, keys ...string) error
+}
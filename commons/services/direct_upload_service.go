@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	// ErrObjectNotFound is returned when a stored object does not exist
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+// DirectUploadService is implemented by file services that let clients upload
+// straight to storage through a presigned URL instead of proxying through the API
+type DirectUploadService interface {
+	// PresignUpload returns a URL the client can PUT exactly size bytes of contentType to
+	PresignUpload(ctx context.Context, key string, contentType string, size int64, expiry time.Duration) (*PresignedUpload, error)
+
+	// StatObject returns the stored object's metadata, or ErrObjectNotFound
+	StatObject(ctx context.Context, key string) (*ObjectInfo, error)
+
+	// OpenObject opens a stored object for reading, or returns ErrObjectNotFound
+	OpenObject(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// DeleteObject deletes an object by key. Deleting a missing object is not an error.
+	DeleteObject(ctx context.Context, key string) error
+
+	// GetFileURL returns the URL for a stored key
+	GetFileURL(key string) string
+}
+
+// MultipartUploadService is implemented by storage that supports uploading large files in parts
+type MultipartUploadService interface {
+	// CreateMultipartUpload starts a multipart upload and presigns a URL per part
+	CreateMultipartUpload(ctx context.Context, key string, contentType string, partCount int, expiry time.Duration) (*PresignedUpload, error)
+
+	// CompleteMultipartUpload assembles the uploaded parts into the final object
+	CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []CompletedPart) error
+
+	// AbortMultipartUpload discards an unfinished multipart upload and its parts
+	AbortMultipartUpload(ctx context.Context, key string, uploadID string) error
+}
+
+// ObjectLister is implemented by storage that can enumerate its objects
+type ObjectLister interface {
+	// ListObjects calls fn for every object whose key starts with prefix. An error from fn stops the listing.
+	ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error
+}
+
+// PresignedUpload describes how the client should upload a file
+type PresignedUpload struct {
+	Method    string            `json:"method" example:"PUT"`
+	URL       string            `json:"url,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	UploadID  string            `json:"uploadId,omitempty"`
+	Parts     []PresignedPart   `json:"parts,omitempty"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// PresignedPart is the upload URL of one part of a multipart upload
+type PresignedPart struct {
+	PartNumber int    `json:"partNumber" example:"1"`
+	URL        string `json:"url"`
+}
+
+// CompletedPart identifies an uploaded part by the ETag storage returned for it
+type CompletedPart struct {
+	PartNumber int    `json:"partNumber" example:"1"`
+	ETag       string `json:"etag" example:"\"a54357aff0632cce46d942af68356b38\""`
+}
+
+// ObjectInfo is the metadata of a stored object
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
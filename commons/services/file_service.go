@@ -3,16 +3,78 @@ package services
 import (
 	"context"
 	"io"
+	"net/url"
+	"strings"
 )
 
-// FileService defines the interface for file operations
+// FileService defines the interface for file operations.
+// Records store the storage key returned by UploadFile; URLs are resolved with
+// GetFileURL when building a response because signed URLs expire.
 type FileService interface {
-	// UploadFile uploads a file and returns the URL
+	// UploadFile uploads a file and returns its storage key
 	UploadFile(ctx context.Context, file io.Reader, fileName string, contentType string) (string, error)
 	
-	// DeleteFile deletes a file by URL
-	DeleteFile(ctx context.Context, fileURL string) error
+	// DeleteFile deletes a file by storage key. URLs of this storage are accepted too.
+	DeleteFile(ctx context.Context, fileRef string) error
 	
-	// GetFileURL returns the full URL for a file path
+	// GetFileURL returns a URL for a storage key, signed when the storage is private
 	GetFileURL(fileName string) string
+
+	// KeyFromURL returns the storage key of a URL served by this storage, or false for other URLs
+	KeyFromURL(fileURL string) (string, bool)
+}
+
+// isFileURL reports whether a file reference is a URL rather than a storage key
+func isFileURL(ref string) bool {
+	return strings.Contains(ref, "://")
+}
+
+// FileKey returns the value to store for a file reference. URLs of this storage, such as
+// legacy records or resolved URLs sent back by clients, become their key. Keys and
+// external URLs are returned unchanged.
+func FileKey(files FileService, ref string) string {
+	if !isFileURL(ref) {
+		return ref
+	}
+	if key, ok := files.KeyFromURL(ref); ok {
+		return key
+	}
+	return ref
+}
+
+// ResolveFileURL returns the URL to serve for a stored file reference
+func ResolveFileURL(files FileService, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	key := FileKey(files, ref)
+	if isFileURL(key) {
+		return key
+	}
+	return files.GetFileURL(key)
+}
+
+// keyFromBaseURL returns the part of fileURL's path below baseURL, ignoring any query
+// string such as a signature
+func keyFromBaseURL(fileURL, baseURL string) (string, bool) {
+	if baseURL == "" {
+		return "", false
+	}
+	parsed, err := url.Parse(fileURL)
+	if err != nil {
+		return "", false
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return "", false
+	}
+	if !strings.EqualFold(parsed.Scheme, base.Scheme) || !strings.EqualFold(parsed.Host, base.Host) {
+		return "", false
+	}
+	prefix := strings.TrimSuffix(base.Path, "/") + "/"
+	if !strings.HasPrefix(parsed.Path, prefix) {
+		return "", false
+	}
+	key := strings.TrimPrefix(parsed.Path, prefix)
+	return key, key != ""
 }
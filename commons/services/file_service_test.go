@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestInMemoryFileServiceRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	files := NewInMemoryFileService()
+
+	key, err := files.UploadFile(ctx, strings.NewReader("hello"), "greeting.txt", "text/plain")
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if isFileURL(key) {
+		t.Fatalf("UploadFile returned a URL %q, want a key", key)
+	}
+
+	stored, ok := files.Get(files.GetFileURL(key))
+	if !ok {
+		t.Fatal("Get by URL did not find the uploaded file")
+	}
+	if string(stored.Data) != "hello" || stored.ContentType != "text/plain" || stored.Name != "greeting.txt" {
+		t.Errorf("stored %+v, want the uploaded file", stored)
+	}
+
+	info, err := files.StatObject(ctx, key)
+	if err != nil || info.Size != 5 {
+		t.Errorf("StatObject = %+v, %v, want size 5", info, err)
+	}
+	reader, err := files.OpenObject(ctx, key)
+	if err != nil {
+		t.Fatalf("OpenObject: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	if string(data) != "hello" {
+		t.Errorf("OpenObject read %q, want hello", data)
+	}
+
+	if err := files.DeleteFile(ctx, files.GetFileURL(key)); err != nil {
+		t.Fatalf("DeleteFile: %v", err)
+	}
+	if files.Len() != 0 {
+		t.Errorf("Len = %d after delete, want 0", files.Len())
+	}
+	if _, err := files.StatObject(ctx, key); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("StatObject after delete = %v, want ErrObjectNotFound", err)
+	}
+}
+
+func TestInMemoryFileServiceDeleteRejectsForeignURL(t *testing.T) {
+	files := NewInMemoryFileService()
+	if err := files.DeleteFile(context.Background(), "https://elsewhere.example.com/a.png"); err == nil {
+		t.Error("DeleteFile accepted a URL of another storage")
+	}
+}
+
+func TestInMemoryFileServiceListObjects(t *testing.T) {
+	files := NewInMemoryFileService()
+	files.PutObject("uploads/b.png", []byte("b"), "image/png")
+	files.PutObject("uploads/a.png", []byte("a"), "image/png")
+	files.PutObject("exports/c.csv", []byte("c"), "text/csv")
+
+	var keys []string
+	err := files.ListObjects(context.Background(), "uploads/", func(object ObjectInfo) error {
+		keys = append(keys, object.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListObjects: %v", err)
+	}
+	if strings.Join(keys, ",") != "uploads/a.png,uploads/b.png" {
+		t.Errorf("ListObjects = %v, want the uploads in key order", keys)
+	}
+}
+
+func TestFileKeyAndResolveFileURL(t *testing.T) {
+	files := NewInMemoryFileService()
+	external := "https://cdn.example.com/logo.png"
+
+	tests := []struct {
+		ref, key, url string
+	}{
+		{"", "", ""},
+		{"uploads/logo.png", "uploads/logo.png", "memory://files/uploads/logo.png"},
+		{"memory://files/uploads/logo.png", "uploads/logo.png", "memory://files/uploads/logo.png"},
+		{external, external, external},
+	}
+	for _, tt := range tests {
+		if got := FileKey(files, tt.ref); got != tt.key {
+			t.Errorf("FileKey(%q) = %q, want %q", tt.ref, got, tt.key)
+		}
+		if got := ResolveFileURL(files, tt.ref); got != tt.url {
+			t.Errorf("ResolveFileURL(%q) = %q, want %q", tt.ref, got, tt.url)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// IdempotencyRecord is the stored first response to a request sent with an Idempotency-Key
+type IdempotencyRecord struct {
+	Fingerprint string      `json:"fingerprint"` // hash of the method, path and body of the request
+	StatusCode  int         `json:"statusCode"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
+
+// IdempotencyStore keeps the responses of idempotent requests and the locks that stop
+// two requests with the same key from running at once
+type IdempotencyStore interface {
+	// Get returns the record stored under key, or nil when there is none
+	Get(ctx context.Context, key string) (*IdempotencyRecord, error)
+
+	// Save stores record under key for the given TTL
+	Save(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error
+
+	// Lock takes the lock for key, reporting false when another request holds it. The
+	// returned token releases the lock; the TTL frees it if the holder never does.
+	Lock(ctx context.Context, key string, ttl time.Duration) (string, bool, error)
+
+	// Unlock releases the lock for key if token still holds it
+	Unlock(ctx context.Context, key, token string) error
+}
+
+// unlockScript deletes the lock only when it still holds the caller's token, so a request
+// whose lock expired cannot release the lock of the request that took it over
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisIdempotencyStore implements IdempotencyStore using Redis
+type RedisIdempotencyStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisIdempotencyStore creates a new RedisIdempotencyStore.
+// All keys are namespaced with the given prefix (e.g. "wecare:").
+func NewRedisIdempotencyStore(client *redis.Client, prefix string) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{
+		client: client,
+		prefix: prefix + "idempotency:",
+	}
+}
+
+// Get implements IdempotencyStore
+func (s *RedisIdempotencyStore) Get(ctx context.Context, key string) (*IdempotencyRecord, error) {
+	raw, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read idempotency key %s: %w", key, err)
+	}
+
+	var record IdempotencyRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotency key %s: %w", key, err)
+	}
+	return &record, nil
+}
+
+// Save implements IdempotencyStore
+func (s *RedisIdempotencyStore) Save(ctx context.Context, key string, record *IdempotencyRecord, ttl time.Duration) error {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency record for %s: %w", key, err)
+	}
+
+	if err := s.client.Set(ctx, s.prefix+key, raw, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to write idempotency key %s: %w", key, err)
+	}
+	return nil
+}
+
+// Lock implements IdempotencyStore
+func (s *RedisIdempotencyStore) Lock(ctx context.Context, key string, ttl time.Duration) (string, bool, error) {
+	token := uuid.New().String()
+	acquired, err := s.client.SetNX(ctx, s.prefix+key+":lock", token, ttl).Result()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to lock idempotency key %s: %w", key, err)
+	}
+	return token, acquired, nil
+}
+
+// Unlock implements IdempotencyStore
+func (s *RedisIdempotencyStore) Unlock(ctx context.Context, key, token string) error {
+	if err := unlockScript.Run(ctx, s.client, []string{s.prefix + key + ":lock"}, token).Err(); err != nil {
+		return fmt.Errorf("failed to unlock idempotency key %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,395 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	_ "image/png" // registers the PNG decoder
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// Variant output formats
+const (
+	ImageFormatAuto = "auto" // WebP for images with transparency, JPEG otherwise
+	ImageFormatJPEG = "jpeg"
+	ImageFormatWebP = "webp"
+)
+
+// SVG upload policies
+const (
+	SVGPolicySanitize = "sanitize"
+	SVGPolicyReject   = "reject"
+)
+
+const (
+	defaultJPEGQuality = 85
+	// originalJPEGQuality is used when re-encoding an original JPEG to drop its metadata
+	originalJPEGQuality = 92
+	defaultMaxPixels    = 40_000_000
+)
+
+var (
+	// ErrUnsupportedImage is returned when the content is not a JPEG, PNG, GIF, WebP or SVG image
+	ErrUnsupportedImage = errors.New("file content is not a supported image")
+	// ErrImageDimensionsTooLarge is returned when an image has more pixels than allowed
+	ErrImageDimensionsTooLarge = errors.New("image dimensions are too large")
+	// ErrSVGNotAllowed is returned when an SVG is uploaded where SVGs are rejected
+	ErrSVGNotAllowed = errors.New("SVG images are not allowed")
+	// ErrUnsafeSVG is returned when an SVG cannot be parsed or sanitised
+	ErrUnsafeSVG = errors.New("SVG image is malformed or unsafe")
+)
+
+// rasterImageTypes are the content types the pipeline decodes
+var rasterImageTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// ImageVariantSpec describes one resized copy of an uploaded image
+type ImageVariantSpec struct {
+	Name   string
+	Width  int
+	Height int
+	// Crop fills the box and crops the overflow; otherwise the image fits inside the box
+	Crop bool
+}
+
+// ImagePipelineConfig configures image processing
+type ImagePipelineConfig struct {
+	Variants    []ImageVariantSpec
+	Format      string // auto, jpeg or webp
+	JPEGQuality int
+	MaxPixels   int64
+	SVGPolicy   string // sanitize or reject
+}
+
+// DefaultImageVariants are used when no variants are configured
+func DefaultImageVariants() []ImageVariantSpec {
+	return []ImageVariantSpec{
+		{Name: "thumb", Width: 150, Height: 150, Crop: true},
+		{Name: "card", Width: 600, Height: 400, Crop: true},
+		{Name: "full", Width: 1600, Height: 1600},
+	}
+}
+
+// ParseImageVariants parses a variant list like "thumb:150x150:crop,card:600x400:crop,full:1600x1600"
+func ParseImageVariants(spec string) ([]ImageVariantSpec, error) {
+	var variants []ImageVariantSpec
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid image variant %q", item)
+		}
+		width, height, ok := strings.Cut(parts[1], "x")
+		if !ok {
+			return nil, fmt.Errorf("invalid image variant size %q", parts[1])
+		}
+		w, errW := strconv.Atoi(width)
+		h, errH := strconv.Atoi(height)
+		if errW != nil || errH != nil || w <= 0 || h <= 0 {
+			return nil, fmt.Errorf("invalid image variant size %q", parts[1])
+		}
+		v := ImageVariantSpec{Name: parts[0], Width: w, Height: h}
+		if len(parts) == 3 {
+			if parts[2] != "crop" {
+				return nil, fmt.Errorf("invalid image variant mode %q", parts[2])
+			}
+			v.Crop = true
+		}
+		variants = append(variants, v)
+	}
+	return variants, nil
+}
+
+// ImageOptions controls how a single upload is processed
+type ImageOptions struct {
+	// AllowSVG accepts SVG uploads, subject to the configured SVG policy
+	AllowSVG bool
+}
+
+// ImagePipeline validates uploaded images by content, strips their metadata and
+// stores the original together with resized variants
+type ImagePipeline struct {
+	files  FileService
+	config ImagePipelineConfig
+}
+
+// NewImagePipeline creates an image pipeline that stores files through the given file service
+func NewImagePipeline(files FileService, config ImagePipelineConfig) *ImagePipeline {
+	if len(config.Variants) == 0 {
+		config.Variants = DefaultImageVariants()
+	}
+	if config.Format == "" {
+		config.Format = ImageFormatAuto
+	}
+	if config.JPEGQuality <= 0 || config.JPEGQuality > 100 {
+		config.JPEGQuality = defaultJPEGQuality
+	}
+	if config.MaxPixels <= 0 {
+		config.MaxPixels = defaultMaxPixels
+	}
+	if config.SVGPolicy == "" {
+		config.SVGPolicy = SVGPolicySanitize
+	}
+	return &ImagePipeline{files: files, config: config}
+}
+
+// SniffImageType returns the content type detected from the data itself, or "" if
+// it is not an image the pipeline accepts
+func SniffImageType(data []byte) string {
+	if looksLikeSVG(data) {
+		return "image/svg+xml"
+	}
+	contentType := http.DetectContentType(data)
+	if _, ok := rasterImageTypes[contentType]; ok {
+		return contentType
+	}
+	return ""
+}
+
+// Process reads an uploaded image, validates it by content and stores the cleaned
+// original and its variants. fileName is only used to name the stored files.
+func (p *ImagePipeline) Process(ctx context.Context, r io.Reader, fileName string, opts ImageOptions) (*models.ProcessedImage, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := SniffImageType(data)
+	switch contentType {
+	case "":
+		return nil, ErrUnsupportedImage
+	case "image/svg+xml":
+		return p.processSVG(ctx, data, fileName, opts)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	// Checked before decoding so a small file cannot expand into a huge bitmap
+	if int64(config.Width)*int64(config.Height) > p.config.MaxPixels {
+		return nil, ErrImageDimensionsTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+
+	original, err := p.cleanOriginal(data, contentType, &img)
+	if err != nil {
+		return nil, err
+	}
+
+	base := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	result := &models.ProcessedImage{
+		ContentType: contentType,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Variants:    models.ImageVariants{},
+	}
+
+	result.URL, err = p.files.UploadFile(ctx, bytes.NewReader(original), base+rasterImageTypes[contentType], contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, spec := range p.config.Variants {
+		encoded, variantType, ext, err := p.encodeVariant(resizeImage(img, spec), img)
+		if err != nil {
+			p.DeleteImage(ctx, result)
+			return nil, err
+		}
+		url, err := p.files.UploadFile(ctx, bytes.NewReader(encoded), base+"-"+spec.Name+ext, variantType)
+		if err != nil {
+			p.DeleteImage(ctx, result)
+			return nil, err
+		}
+		result.Variants[spec.Name] = url
+	}
+	return result, nil
+}
+
+// ProcessStored runs an object that is already in storage, such as a confirmed direct
+// upload, through the pipeline. The raw object is left in place for the caller to delete
+// once the processed image is saved.
+func (p *ImagePipeline) ProcessStored(ctx context.Context, key string, fileName string, opts ImageOptions) (*models.ProcessedImage, error) {
+	store, ok := p.files.(DirectUploadService)
+	if !ok {
+		return nil, errors.New("file service cannot read stored objects")
+	}
+	object, err := store.OpenObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return p.Process(ctx, object, fileName, opts)
+}
+
+// DeleteImage deletes an image and its variants, ignoring failures
+func (p *ImagePipeline) DeleteImage(ctx context.Context, img *models.ProcessedImage) {
+	if img == nil {
+		return
+	}
+	for _, url := range img.URLs() {
+		if url != "" {
+			_ = p.files.DeleteFile(ctx, url)
+		}
+	}
+}
+
+// processSVG sanitises or rejects an SVG. Vector images scale, so every variant points at the original.
+func (p *ImagePipeline) processSVG(ctx context.Context, data []byte, fileName string, opts ImageOptions) (*models.ProcessedImage, error) {
+	if !opts.AllowSVG || p.config.SVGPolicy == SVGPolicyReject {
+		return nil, ErrSVGNotAllowed
+	}
+	clean, err := SanitizeSVG(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsafeSVG, err)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	url, err := p.files.UploadFile(ctx, bytes.NewReader(clean), base+".svg", "image/svg+xml")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ProcessedImage{URL: url, ContentType: "image/svg+xml", Variants: models.ImageVariants{}}
+	for _, spec := range p.config.Variants {
+		result.Variants[spec.Name] = url
+	}
+	return result, nil
+}
+
+// cleanOriginal returns the original without EXIF, XMP and text metadata. JPEGs are
+// re-encoded with their EXIF orientation applied, which also rotates img in place.
+func (p *ImagePipeline) cleanOriginal(data []byte, contentType string, img *image.Image) ([]byte, error) {
+	switch contentType {
+	case "image/jpeg":
+		*img = applyOrientation(*img, jpegOrientation(data))
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, *img, &jpeg.Options{Quality: originalJPEGQuality}); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case "image/png":
+		return stripPNGMetadata(data)
+	case "image/webp":
+		return stripWebPMetadata(data)
+	case "image/gif":
+		// Re-encoding keeps the frames and timing but drops comment and application extensions
+		anim, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, ErrUnsupportedImage
+		}
+		var buf bytes.Buffer
+		if err := gif.EncodeAll(&buf, anim); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return nil, ErrUnsupportedImage
+}
+
+// encodeVariant encodes a resized image in the configured format
+func (p *ImagePipeline) encodeVariant(img image.Image, source image.Image) ([]byte, string, string, error) {
+	format := p.config.Format
+	if format == ImageFormatAuto {
+		format = ImageFormatJPEG
+		if !isOpaque(source) {
+			format = ImageFormatWebP
+		}
+	}
+
+	var buf bytes.Buffer
+	if format == ImageFormatWebP {
+		if err := nativewebp.Encode(&buf, img, nil); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), "image/webp", ".webp", nil
+	}
+
+	if err := jpeg.Encode(&buf, flattenOnWhite(img), &jpeg.Options{Quality: p.config.JPEGQuality}); err != nil {
+		return nil, "", "", err
+	}
+	return buf.Bytes(), "image/jpeg", ".jpg", nil
+}
+
+// resizeImage scales img to the variant box without ever upscaling
+func resizeImage(img image.Image, spec ImageVariantSpec) image.Image {
+	src := img.Bounds()
+	w, h := src.Dx(), src.Dy()
+	if w == 0 || h == 0 {
+		return img
+	}
+
+	if spec.Crop {
+		// Crop the source to the target aspect ratio around its centre, then scale
+		targetRatio := float64(spec.Width) / float64(spec.Height)
+		crop := src
+		if float64(w)/float64(h) > targetRatio {
+			cw := int(float64(h) * targetRatio)
+			crop.Min.X += (w - cw) / 2
+			crop.Max.X = crop.Min.X + cw
+		} else {
+			ch := int(float64(w) / targetRatio)
+			crop.Min.Y += (h - ch) / 2
+			crop.Max.Y = crop.Min.Y + ch
+		}
+		dw, dh := spec.Width, spec.Height
+		if crop.Dx() < dw {
+			dw, dh = crop.Dx(), crop.Dy()
+		}
+		return scale(img, crop, max(dw, 1), max(dh, 1))
+	}
+
+	ratio := min(float64(spec.Width)/float64(w), float64(spec.Height)/float64(h), 1)
+	return scale(img, src, max(int(float64(w)*ratio), 1), max(int(float64(h)*ratio), 1))
+}
+
+func scale(img image.Image, src image.Rectangle, width, height int) image.Image {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+// isOpaque reports whether an image has no transparent pixels
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// flattenOnWhite composites a possibly transparent image onto white for JPEG output
+func flattenOnWhite(img image.Image) image.Image {
+	if isOpaque(img) {
+		return img
+	}
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
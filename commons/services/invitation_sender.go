@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"log"
+)
+
+// Invitation tells a user that an account was created for them
+type Invitation struct {
+	UserID         string
+	Email          string
+	FullName       string
+	Role           string
+	OrganizationID string
+	InvitedBy      string // user ID of the inviter, empty for system invitations
+}
+
+// InvitationSender delivers invitations to new users
+type InvitationSender interface {
+	SendInvitation(ctx context.Context, invitation Invitation) error
+}
+
+// LogInvitationSender writes invitations to the log instead of delivering them, for
+// development and for deployments without a mail provider
+type LogInvitationSender struct{}
+
+// NewLogInvitationSender creates a new LogInvitationSender
+func NewLogInvitationSender() *LogInvitationSender {
+	return &LogInvitationSender{}
+}
+
+func (s *LogInvitationSender) SendInvitation(ctx context.Context, invitation Invitation) error {
+	log.Printf("Invitation for %s <%s> as %s in organization %s (user %s)",
+		invitation.FullName, invitation.Email, invitation.Role, invitation.OrganizationID, invitation.UserID)
+	return nil
+}
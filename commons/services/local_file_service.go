@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidFileSignature is returned when a signed file URL is tampered with or malformed
+	ErrInvalidFileSignature = errors.New("invalid file signature")
+	// ErrFileURLExpired is returned when a signed file URL is past its expiry
+	ErrFileURLExpired = errors.New("file URL has expired")
+	// ErrInvalidFileKey is returned for keys that would escape the storage root
+	ErrInvalidFileKey = errors.New("invalid file key")
+	// ErrUploadSizeMismatch is returned when a direct upload body differs from the signed size
+	ErrUploadSizeMismatch = errors.New("upload size does not match the signed size")
+)
+
+var _ FileService = (*LocalFileService)(nil)
+
+// LocalFileService implements FileService on the local filesystem.
+// Files are served back through a signed static route, see VerifySignedURL.
+type LocalFileService struct {
+	rootDir    string
+	baseURL    string
+	signingKey []byte
+	urlExpiry  time.Duration
+}
+
+// LocalStorageConfig holds configuration for the local file service
+type LocalStorageConfig struct {
+	RootDir    string        // Directory files are written under
+	BaseURL    string        // Public URL of the file route, e.g. http://localhost:8080/api/v1/files
+	SigningKey string        // HMAC key for signed URLs
+	URLExpiry  time.Duration // Lifetime of signed URLs
+}
+
+// NewLocalFileService creates a new LocalFileService, creating the root directory if needed
+func NewLocalFileService(cfg LocalStorageConfig) (*LocalFileService, error) {
+	if cfg.SigningKey == "" {
+		return nil, errors.New("a signing key is required for local file storage")
+	}
+
+	rootDir, err := filepath.Abs(cfg.RootDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage directory: %w", err)
+	}
+	if err := os.MkdirAll(rootDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	expiry := cfg.URLExpiry
+	if expiry <= 0 {
+		expiry = time.Hour
+	}
+
+	return &LocalFileService{
+		rootDir:    rootDir,
+		baseURL:    strings.TrimSuffix(cfg.BaseURL, "/"),
+		signingKey: []byte(cfg.SigningKey),
+		urlExpiry:  expiry,
+	}, nil
+}
+
+// UploadFile writes a file under the storage root and returns its key
+func (s *LocalFileService) UploadFile(ctx context.Context, file io.Reader, originalFileName string, contentType string) (string, error) {
+	key := NewUploadKey(originalFileName)
+
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	out, err := os.Create(fullPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+	if _, err := io.Copy(out, file); err != nil {
+		out.Close()
+		os.Remove(fullPath)
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return key, nil
+}
+
+// DeleteFile deletes a file by key or URL. Deleting a file that no longer exists is not an error.
+func (s *LocalFileService) DeleteFile(ctx context.Context, fileRef string) error {
+	key := FileKey(s, fileRef)
+	if isFileURL(key) {
+		return fmt.Errorf("invalid file URL: %s", fileRef)
+	}
+
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// GetFileURL returns a signed URL for a file key, valid for the configured expiry
+func (s *LocalFileService) GetFileURL(fileName string) string {
+	expires := time.Now().Add(s.urlExpiry).Unix()
+	return fmt.Sprintf("%s/%s?expires=%d&signature=%s", s.baseURL, fileName, expires, s.sign(fileName, expires))
+}
+
+// VerifySignedURL checks the signature and expiry query parameters of a file request
+func (s *LocalFileService) VerifySignedURL(key, expiresParam, signature string) error {
+	expires, err := strconv.ParseInt(expiresParam, 10, 64)
+	if err != nil || signature == "" {
+		return ErrInvalidFileSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+		return ErrInvalidFileSignature
+	}
+	if time.Now().Unix() > expires {
+		return ErrFileURLExpired
+	}
+	return nil
+}
+
+// Open opens a stored file for reading
+func (s *LocalFileService) Open(key string) (*os.File, error) {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(fullPath)
+}
+
+func (s *LocalFileService) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	fmt.Fprintf(mac, "%s:%d", key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// resolve maps a key to a path under the storage root, rejecting traversal
+func (s *LocalFileService) resolve(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" {
+		return "", ErrInvalidFileKey
+	}
+	fullPath := filepath.Join(s.rootDir, filepath.FromSlash(cleaned))
+	if !strings.HasPrefix(fullPath, s.rootDir+string(os.PathSeparator)) {
+		return "", ErrInvalidFileKey
+	}
+	return fullPath, nil
+}
+
+// KeyFromURL extracts the file key from a (possibly signed) file URL
+func (s *LocalFileService) KeyFromURL(fileURL string) (string, bool) {
+	return keyFromBaseURL(fileURL, s.baseURL)
+}
+
+// NewUploadKey generates a unique storage key, organised by year/month like the S3 backend
+func NewUploadKey(originalFileName string) string {
+	now := time.Now()
+	return fmt.Sprintf("uploads/%d/%02d/%s%s", now.Year(), now.Month(), uuid.New().String(), filepath.Ext(originalFileName))
+}
+
+var _ DirectUploadService = (*LocalFileService)(nil)
+
+// PresignUpload returns a signed PUT URL on the file route. The size and content type
+// are part of the signature and enforced by WriteUpload.
+func (s *LocalFileService) PresignUpload(ctx context.Context, key string, contentType string, size int64, expiry time.Duration) (*PresignedUpload, error) {
+	if _, err := s.resolve(key); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(expiry)
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("size", strconv.FormatInt(size, 10))
+	query.Set("contentType", contentType)
+	query.Set("signature", s.signUpload(key, expiresAt.Unix(), size, contentType))
+
+	return &PresignedUpload{
+		Method:    http.MethodPut,
+		URL:       fmt.Sprintf("%s/%s?%s", s.baseURL, key, query.Encode()),
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// VerifyUploadURL checks a presigned upload request and returns the size it was signed for
+func (s *LocalFileService) VerifyUploadURL(key string, query url.Values, contentType string) (int64, error) {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return 0, ErrInvalidFileSignature
+	}
+	size, err := strconv.ParseInt(query.Get("size"), 10, 64)
+	if err != nil || size < 0 {
+		return 0, ErrInvalidFileSignature
+	}
+	signedType := query.Get("contentType")
+	if signedType != contentType {
+		return 0, ErrInvalidFileSignature
+	}
+	if !hmac.Equal([]byte(query.Get("signature")), []byte(s.signUpload(key, expires, size, signedType))) {
+		return 0, ErrInvalidFileSignature
+	}
+	if time.Now().Unix() > expires {
+		return 0, ErrFileURLExpired
+	}
+	return size, nil
+}
+
+// WriteUpload stores exactly size bytes from r under key. A short or long body is rejected.
+func (s *LocalFileService) WriteUpload(key string, r io.Reader, size int64) error {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	out, err := os.Create(fullPath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	written, err := io.Copy(out, io.LimitReader(r, size+1))
+	closeErr := out.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil && written != size {
+		err = fmt.Errorf("%w: expected %d bytes, received %d", ErrUploadSizeMismatch, size, written)
+	}
+	if err != nil {
+		os.Remove(fullPath)
+		return err
+	}
+	return nil
+}
+
+// StatObject returns the file's size and the content type implied by its extension
+func (s *LocalFileService) StatObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, ErrObjectNotFound
+	}
+	return &ObjectInfo{Key: key, Size: info.Size(), ContentType: mime.TypeByExtension(filepath.Ext(key)), LastModified: info.ModTime()}, nil
+}
+
+var _ ObjectLister = (*LocalFileService)(nil)
+
+// ListObjects walks the files under the storage root whose key starts with prefix
+func (s *LocalFileService) ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	err := filepath.WalkDir(s.rootDir, func(fullPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.rootDir, fullPath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if entry.IsDir() {
+			// Skip directories that cannot contain matching keys
+			if key != "." && !strings.HasPrefix(key+"/", prefix) && !strings.HasPrefix(prefix, key+"/") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return fn(ObjectInfo{Key: key, Size: info.Size(), ContentType: mime.TypeByExtension(filepath.Ext(key)), LastModified: info.ModTime()})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list files: %w", err)
+	}
+	return nil
+}
+
+// OpenObject opens a stored file for reading
+func (s *LocalFileService) OpenObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := s.Open(key)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+// DeleteObject deletes a file by key
+func (s *LocalFileService) DeleteObject(ctx context.Context, key string) error {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalFileService) signUpload(key string, expires int64, size int64, contentType string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	fmt.Fprintf(mac, "PUT:%s:%d:%d:%s", key, expires, size, contentType)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// memoryFileBaseURL prefixes URLs returned by InMemoryFileService
+const memoryFileBaseURL = "memory://files"
+
+var (
+	_ FileService         = (*InMemoryFileService)(nil)
+	_ DirectUploadService = (*InMemoryFileService)(nil)
+	_ ObjectLister        = (*InMemoryFileService)(nil)
+)
+
+// InMemoryFileService implements FileService in memory, for tests
+type InMemoryFileService struct {
+	mu    sync.RWMutex
+	files map[string]StoredFile
+}
+
+// StoredFile is a file held by InMemoryFileService
+type StoredFile struct {
+	Name        string
+	ContentType string
+	Data        []byte
+	ModifiedAt  time.Time
+}
+
+// NewInMemoryFileService creates an empty InMemoryFileService
+func NewInMemoryFileService() *InMemoryFileService {
+	return &InMemoryFileService{files: make(map[string]StoredFile)}
+}
+
+// UploadFile stores the file and returns its key
+func (s *InMemoryFileService) UploadFile(ctx context.Context, file io.Reader, originalFileName string, contentType string) (string, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	key := NewUploadKey(originalFileName)
+
+	s.mu.Lock()
+	s.files[key] = StoredFile{Name: originalFileName, ContentType: contentType, Data: data, ModifiedAt: time.Now()}
+	s.mu.Unlock()
+
+	return key, nil
+}
+
+// DeleteFile removes a file by key or URL
+func (s *InMemoryFileService) DeleteFile(ctx context.Context, fileRef string) error {
+	key := FileKey(s, fileRef)
+	if isFileURL(key) {
+		return fmt.Errorf("invalid file URL: %s", fileRef)
+	}
+
+	s.mu.Lock()
+	delete(s.files, key)
+	s.mu.Unlock()
+	return nil
+}
+
+// GetFileURL returns the URL for a file key
+func (s *InMemoryFileService) GetFileURL(fileName string) string {
+	return fmt.Sprintf("%s/%s", memoryFileBaseURL, fileName)
+}
+
+// KeyFromURL returns the key of a memory:// file URL
+func (s *InMemoryFileService) KeyFromURL(fileURL string) (string, bool) {
+	return keyFromBaseURL(fileURL, memoryFileBaseURL)
+}
+
+// Get returns a stored file by key or URL
+func (s *InMemoryFileService) Get(fileRef string) (StoredFile, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f, ok := s.files[FileKey(s, fileRef)]
+	return f, ok
+}
+
+// Len returns the number of stored files
+func (s *InMemoryFileService) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.files)
+}
+
+// PresignUpload returns the file's URL; tests complete the upload with PutObject
+func (s *InMemoryFileService) PresignUpload(ctx context.Context, key string, contentType string, size int64, expiry time.Duration) (*PresignedUpload, error) {
+	return &PresignedUpload{
+		Method:    "PUT",
+		URL:       s.GetFileURL(key),
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: time.Now().Add(expiry),
+	}, nil
+}
+
+// PutObject stores data under key, standing in for a client's direct upload
+func (s *InMemoryFileService) PutObject(key string, data []byte, contentType string) {
+	s.mu.Lock()
+	s.files[key] = StoredFile{Name: key, ContentType: contentType, Data: data, ModifiedAt: time.Now()}
+	s.mu.Unlock()
+}
+
+// StatObject returns a stored file's metadata
+func (s *InMemoryFileService) StatObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f, ok := s.files[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	return &ObjectInfo{Key: key, Size: int64(len(f.Data)), ContentType: f.ContentType, LastModified: f.ModifiedAt}, nil
+}
+
+// ListObjects calls fn for every stored file whose key starts with prefix, in key order
+func (s *InMemoryFileService) ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	s.mu.RLock()
+	objects := make([]ObjectInfo, 0, len(s.files))
+	for key, f := range s.files {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, ObjectInfo{Key: key, Size: int64(len(f.Data)), ContentType: f.ContentType, LastModified: f.ModifiedAt})
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	for _, object := range objects {
+		if err := fn(object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OpenObject returns a reader over a stored file's content
+func (s *InMemoryFileService) OpenObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f, ok := s.files[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(f.Data)), nil
+}
+
+// DeleteObject removes a file by key
+func (s *InMemoryFileService) DeleteObject(ctx context.Context, key string) error {
+	s.mu.Lock()
+	delete(s.files, key)
+	s.mu.Unlock()
+	return nil
+}
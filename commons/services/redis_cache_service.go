@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisCacheService implements CacheService using Redis with JSON-encoded values
+type RedisCacheService struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisCacheService creates a new RedisCacheService.
+// All keys are namespaced with the given prefix (e.g. "wecare:").
+func NewRedisCacheService(client *redis.Client, prefix string) *RedisCacheService {
+	return &RedisCacheService{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+// Get loads the cached value for key into dest
+func (s *RedisCacheService) Get(ctx context.Context, key string, dest interface{}) (bool, error) {
+	raw, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read cache key %s: %w", key, err)
+	}
+
+	if err := json.Unmarshal(raw, dest); err != nil {
+		// Treat undecodable entries as a miss so callers fall back to the source of truth
+		_ = s.client.Del(ctx, s.prefix+key).Err()
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Set stores value under key for the given TTL
+func (s *RedisCacheService) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache value for %s: %w", key, err)
+	}
+
+	if err := s.client.Set(ctx, s.prefix+key, raw, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to write cache key %s: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes one or more keys
+func (s *RedisCacheService) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = s.prefix + key
+	}
+
+	if err := s.client.Del(ctx, prefixed...).Err(); err != nil {
+		return fmt.Errorf("failed to delete cache keys: %w", err)
+	}
+	return nil
+}
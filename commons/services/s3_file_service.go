@@ -3,8 +3,10 @@ package services
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
@@ -13,14 +15,18 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 )
 
 // S3FileService implements FileService using AWS S3
 type S3FileService struct {
-	client     *s3.Client
-	bucketName string
-	baseURL    string
+	client        *s3.Client
+	bucketName    string
+	baseURL       string
+	bucketURL     string
+	publicBaseURL string
+	urlExpiry     time.Duration
 }
 
 // S3Config holds configuration for the S3 service
@@ -30,6 +36,10 @@ type S3Config struct {
 	Region     string
 	BucketName string
 	BaseURL    string // Optional, can be empty if using the default S3 URL format
+
+	// PublicBaseURL serves files unsigned, e.g. from a CDN. When empty, URLs are presigned.
+	PublicBaseURL string
+	URLExpiry     time.Duration // Lifetime of presigned URLs, one hour when zero
 }
 
 // NewS3FileService creates a new S3FileService
@@ -55,20 +65,29 @@ func NewS3FileService(cfg S3Config) (*S3FileService, error) {
 	client := s3.NewFromConfig(awsCfg)
 
 	// Determine base URL
-	baseURL := cfg.BaseURL
+	bucketURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.BucketName, cfg.Region)
+	baseURL := strings.TrimSuffix(cfg.BaseURL, "/")
 	if baseURL == "" {
 		// Use default S3 URL format if not provided
-		baseURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.BucketName, cfg.Region)
+		baseURL = bucketURL
+	}
+
+	expiry := cfg.URLExpiry
+	if expiry <= 0 {
+		expiry = time.Hour
 	}
 
 	return &S3FileService{
-		client:     client,
-		bucketName: cfg.BucketName,
-		baseURL:    baseURL,
+		client:        client,
+		bucketName:    cfg.BucketName,
+		baseURL:       baseURL,
+		bucketURL:     bucketURL,
+		publicBaseURL: strings.TrimSuffix(cfg.PublicBaseURL, "/"),
+		urlExpiry:     expiry,
 	}, nil
 }
 
-// UploadFile uploads a file to S3 and returns the URL
+// UploadFile uploads a file to S3 and returns its key
 func (s *S3FileService) UploadFile(ctx context.Context, file io.Reader, originalFileName string, contentType string) (string, error) {
 	// Read file content
 	buffer, err := io.ReadAll(file)
@@ -97,20 +116,19 @@ func (s *S3FileService) UploadFile(ctx context.Context, file io.Reader, original
 		return "", fmt.Errorf("failed to upload file to S3: %w", err)
 	}
 
-	// Return the URL to the uploaded file
-	return s.GetFileURL(s3Path), nil
+	// Return the key; URLs are resolved when responding
+	return s3Path, nil
 }
 
-// DeleteFile deletes a file from S3
-func (s *S3FileService) DeleteFile(ctx context.Context, fileURL string) error {
-	// Extract the key from the URL
-	key, err := s.extractKeyFromURL(fileURL)
-	if err != nil {
-		return err
+// DeleteFile deletes a file from S3 by key or URL
+func (s *S3FileService) DeleteFile(ctx context.Context, fileRef string) error {
+	key := FileKey(s, fileRef)
+	if isFileURL(key) {
+		return fmt.Errorf("invalid file URL: %s", fileRef)
 	}
 
 	// Delete the file from S3
-	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(key),
 	})
@@ -127,15 +145,14 @@ func (s *S3FileService) DeleteFile(ctx context.Context, fileURL string) error {
 // 	return fmt.Sprintf("%s/%s", s.baseURL, fileName)
 // }
 
-// extractKeyFromURL extracts the S3 key from a file URL
-func (s *S3FileService) extractKeyFromURL(fileURL string) (string, error) {
-	if !strings.HasPrefix(fileURL, s.baseURL) {
-		return "", fmt.Errorf("invalid file URL: %s", fileURL)
+// KeyFromURL extracts the S3 key from a direct, presigned or public base URL
+func (s *S3FileService) KeyFromURL(fileURL string) (string, bool) {
+	for _, base := range []string{s.publicBaseURL, s.baseURL, s.bucketURL} {
+		if key, ok := keyFromBaseURL(fileURL, base); ok {
+			return key, true
+		}
 	}
-
-	// Remove base URL to get the key
-	key := strings.TrimPrefix(fileURL, s.baseURL+"/")
-	return key, nil
+	return "", false
 }
 
 
@@ -156,12 +173,205 @@ func (s *S3FileService) GetSignedURL(ctx context.Context, objectKey string, expi
     return presignedURL.URL, nil
 }
 
+// GetFileURL returns the public base URL of a key when one is configured, otherwise a
+// freshly presigned URL
 func (s *S3FileService) GetFileURL(fileName string) string {
-    // Generate a signed URL valid for 1 hour
-    signedURL, err := s.GetSignedURL(context.Background(), fileName, time.Hour)
+    if s.publicBaseURL != "" {
+        return fmt.Sprintf("%s/%s", s.publicBaseURL, fileName)
+    }
+
+    // Generate a signed URL valid for the configured expiry
+    signedURL, err := s.GetSignedURL(context.Background(), fileName, s.urlExpiry)
     if err != nil {
         // Fall back to direct URL if signing fails, but this won't work with private buckets
         return fmt.Sprintf("%s/%s", s.baseURL, fileName)
     }
     return signedURL
-}
\ No newline at end of file
+}
+var (
+	_ DirectUploadService    = (*S3FileService)(nil)
+	_ MultipartUploadService = (*S3FileService)(nil)
+	_ ObjectLister           = (*S3FileService)(nil)
+)
+
+// PresignUpload presigns a PUT for the key. Content type and length are part of the
+// signature, so S3 rejects uploads that differ from what was requested.
+func (s *S3FileService) PresignUpload(ctx context.Context, key string, contentType string, size int64, expiry time.Duration) (*PresignedUpload, error) {
+	presignClient := s3.NewPresignClient(s.client)
+
+	req, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucketName),
+		Key:           aws.String(key),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(size),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expiry
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign upload: %w", err)
+	}
+
+	return &PresignedUpload{
+		Method:    req.Method,
+		URL:       req.URL,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: time.Now().Add(expiry),
+	}, nil
+}
+
+// CreateMultipartUpload starts a multipart upload and presigns one URL per part
+func (s *S3FileService) CreateMultipartUpload(ctx context.Context, key string, contentType string, partCount int, expiry time.Duration) (*PresignedUpload, error) {
+	created, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+
+	presignClient := s3.NewPresignClient(s.client)
+	parts := make([]PresignedPart, 0, partCount)
+	for partNumber := 1; partNumber <= partCount; partNumber++ {
+		req, err := presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(s.bucketName),
+			Key:        aws.String(key),
+			UploadId:   created.UploadId,
+			PartNumber: aws.Int32(int32(partNumber)),
+		}, func(opts *s3.PresignOptions) {
+			opts.Expires = expiry
+		})
+		if err != nil {
+			_ = s.AbortMultipartUpload(ctx, key, aws.ToString(created.UploadId))
+			return nil, fmt.Errorf("failed to presign upload part: %w", err)
+		}
+		parts = append(parts, PresignedPart{PartNumber: partNumber, URL: req.URL})
+	}
+
+	return &PresignedUpload{
+		Method:    http.MethodPut,
+		UploadID:  aws.ToString(created.UploadId),
+		Parts:     parts,
+		ExpiresAt: time.Now().Add(expiry),
+	}, nil
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the final object
+func (s *S3FileService) CompleteMultipartUpload(ctx context.Context, key string, uploadID string, parts []CompletedPart) error {
+	completed := make([]types.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, types.CompletedPart{
+			PartNumber: aws.Int32(int32(part.PartNumber)),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+
+	_, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.bucketName),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	return nil
+}
+
+// AbortMultipartUpload discards an unfinished multipart upload
+func (s *S3FileService) AbortMultipartUpload(ctx context.Context, key string, uploadID string) error {
+	_, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(s.bucketName),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil && !isS3NotFound(err) {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	return nil
+}
+
+// StatObject returns the object's size and content type
+func (s *S3FileService) StatObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	head, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         aws.ToInt64(head.ContentLength),
+		ContentType:  aws.ToString(head.ContentType),
+		LastModified: aws.ToTime(head.LastModified),
+	}, nil
+}
+
+// ListObjects pages through the bucket's objects under prefix
+func (s *S3FileService) ListObjects(ctx context.Context, prefix string, fn func(ObjectInfo) error) error {
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list objects: %w", err)
+		}
+		for _, object := range page.Contents {
+			info := ObjectInfo{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			}
+			if err := fn(info); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// OpenObject streams an object's content
+func (s *S3FileService) OpenObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+	return object.Body, nil
+}
+
+// DeleteObject deletes an object by key
+func (s *S3FileService) DeleteObject(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil && !isS3NotFound(err) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// isS3NotFound reports whether err is a 404 from S3
+func isS3NotFound(err error) bool {
+	var notFound *types.NotFound
+	var noSuchKey *types.NoSuchKey
+	var noSuchUpload *types.NoSuchUpload
+	if errors.As(err, &notFound) || errors.As(err, &noSuchKey) || errors.As(err, &noSuchUpload) {
+		return true
+	}
+	var respErr interface{ HTTPStatusCode() int }
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound
+}
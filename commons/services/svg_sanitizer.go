@@ -0,0 +1,172 @@
+package services
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	svgNamespace   = "http://www.w3.org/2000/svg"
+	xlinkNamespace = "http://www.w3.org/1999/xlink"
+	xmlNamespace   = "http://www.w3.org/XML/1998/namespace"
+)
+
+// svgAllowedElements are the SVG elements kept by SanitizeSVG. Anything else, including
+// script, foreignObject, style, iframe and animation elements, is dropped with its children.
+var svgAllowedElements = map[string]bool{
+	"svg": true, "g": true, "defs": true, "symbol": true, "use": true, "title": true, "desc": true,
+	"path": true, "rect": true, "circle": true, "ellipse": true, "line": true, "polyline": true, "polygon": true,
+	"text": true, "tspan": true, "textPath": true,
+	"linearGradient": true, "radialGradient": true, "stop": true, "pattern": true,
+	"clipPath": true, "mask": true, "marker": true,
+	"filter": true, "feGaussianBlur": true, "feOffset": true, "feBlend": true, "feColorMatrix": true,
+	"feComposite": true, "feFlood": true, "feMerge": true, "feMergeNode": true,
+}
+
+// looksLikeSVG reports whether data is an XML document whose root element is <svg>
+func looksLikeSVG(data []byte) bool {
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	head = bytes.TrimPrefix(head, []byte("\xef\xbb\xbf"))
+	text := strings.TrimSpace(string(head))
+	if !strings.HasPrefix(text, "<") {
+		return false
+	}
+	return strings.Contains(strings.ToLower(text), "<svg")
+}
+
+// SanitizeSVG rebuilds an SVG from an allowlist of elements and attributes. Scripts, event
+// handlers, external references and DTDs (which enable entity expansion attacks) are removed
+// or rejected, so the result is safe to serve to browsers.
+func SanitizeSVG(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = true
+
+	var out bytes.Buffer
+	depth, skipDepth := 0, 0
+	rootSeen := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.Directive:
+			return nil, errors.New("DOCTYPE and entity declarations are not allowed")
+		case xml.ProcInst, xml.Comment:
+			// The XML declaration, processing instructions and comments are dropped
+		case xml.StartElement:
+			depth++
+			if skipDepth > 0 {
+				continue
+			}
+			if depth == 1 {
+				if t.Name.Local != "svg" || (t.Name.Space != "" && t.Name.Space != svgNamespace) {
+					return nil, errors.New("root element must be <svg>")
+				}
+				rootSeen = true
+			}
+			if (t.Name.Space != "" && t.Name.Space != svgNamespace) || !svgAllowedElements[t.Name.Local] {
+				skipDepth = depth
+				continue
+			}
+			writeSVGStart(&out, t, depth == 1)
+		case xml.EndElement:
+			if skipDepth > 0 {
+				if depth == skipDepth {
+					skipDepth = 0
+				}
+				depth--
+				continue
+			}
+			depth--
+			fmt.Fprintf(&out, "</%s>", t.Name.Local)
+		case xml.CharData:
+			if skipDepth == 0 && depth > 0 {
+				_ = xml.EscapeText(&out, t)
+			}
+		}
+	}
+	if !rootSeen {
+		return nil, errors.New("document has no <svg> element")
+	}
+	return out.Bytes(), nil
+}
+
+// writeSVGStart writes a start tag with only safe attributes, re-declaring the namespaces on the root
+func writeSVGStart(out *bytes.Buffer, el xml.StartElement, root bool) {
+	out.WriteString("<" + el.Name.Local)
+	if root {
+		out.WriteString(` xmlns="` + svgNamespace + `" xmlns:xlink="` + xlinkNamespace + `"`)
+	}
+	for _, attr := range el.Attr {
+		name, ok := svgAttributeName(attr.Name)
+		if !ok || !svgAttributeValueSafe(name, attr.Value) {
+			continue
+		}
+		out.WriteString(" " + name + `="`)
+		_ = xml.EscapeText(out, []byte(attr.Value))
+		out.WriteString(`"`)
+	}
+	out.WriteString(">")
+}
+
+// svgAttributeName returns the serialised name of an attribute, or false if it is dropped
+func svgAttributeName(name xml.Name) (string, bool) {
+	local := name.Local
+	switch name.Space {
+	case "":
+	case xlinkNamespace, "xlink":
+		if local != "href" && local != "title" {
+			return "", false
+		}
+		return "xlink:" + local, true
+	case xmlNamespace, "xml":
+		if local != "space" && local != "lang" {
+			return "", false
+		}
+		return "xml:" + local, true
+	default:
+		// Namespace declarations are re-emitted on the root; other namespaces are dropped
+		return "", false
+	}
+	lower := strings.ToLower(local)
+	if lower == "xmlns" || strings.HasPrefix(lower, "on") {
+		return "", false
+	}
+	return local, true
+}
+
+// svgAttributeValueSafe rejects references that could load external content or run script
+func svgAttributeValueSafe(name, value string) bool {
+	compact := strings.ToLower(strings.Join(strings.Fields(value), ""))
+	switch name {
+	case "href", "xlink:href":
+		// Only references to elements inside the same document are kept
+		return strings.HasPrefix(compact, "#")
+	}
+	if strings.Contains(compact, "javascript:") || strings.Contains(compact, "expression(") || strings.Contains(compact, "@import") {
+		return false
+	}
+	// url() may only point inside the document, e.g. fill="url(#gradient)"
+	for rest := compact; ; {
+		i := strings.Index(rest, "url(")
+		if i < 0 {
+			return true
+		}
+		rest = strings.TrimLeft(rest[i+4:], `'"`)
+		if !strings.HasPrefix(rest, "#") {
+			return false
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Quarantine keeps rejected uploads out of storage for later inspection
+type Quarantine interface {
+	// Quarantine stores the content with the reason it was rejected and returns its ID
+	Quarantine(ctx context.Context, content io.Reader, rejection *ScanError) (string, error)
+}
+
+// QuarantineRecord is written next to each quarantined file
+type QuarantineRecord struct {
+	ID            string     `json:"id"`
+	QuarantinedAt time.Time  `json:"quarantinedAt"`
+	Size          int64      `json:"size"`
+	Rejection     *ScanError `json:"rejection"`
+}
+
+// LocalQuarantine stores rejected uploads on local disk. Files are written without
+// their extension and without read permission for others, so they are never served
+// or opened by accident.
+type LocalQuarantine struct {
+	dir string
+}
+
+// NewLocalQuarantine creates a quarantine under dir, creating it if needed
+func NewLocalQuarantine(dir string) (*LocalQuarantine, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create quarantine directory: %w", err)
+	}
+	return &LocalQuarantine{dir: dir}, nil
+}
+
+// Quarantine writes <id>.bin and <id>.json under a directory per day
+func (q *LocalQuarantine) Quarantine(ctx context.Context, content io.Reader, rejection *ScanError) (string, error) {
+	now := time.Now().UTC()
+	id := now.Format("2006-01-02") + "/" + uuid.New().String()
+	path := filepath.Join(q.dir, filepath.FromSlash(id))
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", err
+	}
+
+	file, err := os.OpenFile(path+".bin", os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", err
+	}
+	size, err := io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path + ".bin")
+		return "", err
+	}
+
+	record, err := json.MarshalIndent(QuarantineRecord{ID: id, QuarantinedAt: now, Size: size, Rejection: rejection}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path+".json", record, 0o600); err != nil {
+		return "", err
+	}
+	return id, nil
+}
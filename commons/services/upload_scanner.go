@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrUploadRejected is matched by every ScanError
+	ErrUploadRejected = errors.New("upload rejected")
+	// ErrScannerUnavailable is returned when a scanner could not reach a verdict, e.g. the
+	// antivirus daemon is down. Uploads are not accepted without a verdict.
+	ErrScannerUnavailable = errors.New("upload scanner unavailable")
+)
+
+// Content types accepted by the upload endpoints
+var (
+	PhotoUploadTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+	ImageUploadTypes = append(slices.Clone(PhotoUploadTypes), "image/svg+xml")
+	VideoUploadTypes = []string{"video/mp4", "video/quicktime"}
+)
+
+// ScanInput describes an upload to scan
+type ScanInput struct {
+	FileName     string
+	DeclaredType string   // content type sent by the client, may be empty
+	AllowedTypes []string // accepted content types, supports wildcards like "image/*"; empty accepts any
+	Size         int64
+	Content      io.ReaderAt
+
+	// DetectedType is the type found from the content, set before any scanner runs
+	DetectedType string
+}
+
+// NewMultipartScanInput describes a file of a multipart form
+func NewMultipartScanInput(file multipart.File, header *multipart.FileHeader, allowedTypes []string) *ScanInput {
+	return &ScanInput{
+		FileName:     header.Filename,
+		DeclaredType: header.Header.Get("Content-Type"),
+		AllowedTypes: allowedTypes,
+		Size:         header.Size,
+		Content:      file,
+	}
+}
+
+// reader returns a reader over the whole upload
+func (in *ScanInput) reader() io.Reader {
+	return io.NewSectionReader(in.Content, 0, in.Size)
+}
+
+// detectType sets DetectedType from the first bytes of the content
+func (in *ScanInput) detectType() error {
+	if in.DetectedType != "" {
+		return nil
+	}
+	head := make([]byte, 1024)
+	n, err := in.Content.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	in.DetectedType = DetectUploadType(head[:n])
+	return nil
+}
+
+// UploadScanner checks an upload before it is stored. It returns a *ScanError to
+// reject the upload, or another error if it could not check it.
+type UploadScanner interface {
+	Name() string
+	Scan(ctx context.Context, in *ScanInput) error
+}
+
+// ScanError is a structured rejection of an upload
+type ScanError struct {
+	FileName     string                 `json:"fileName,omitempty"`
+	Scanner      string                 `json:"scanner"`
+	Rule         string                 `json:"rule"`
+	Message      string                 `json:"message"`
+	Details      map[string]interface{} `json:"details,omitempty"`
+	QuarantineID string                 `json:"quarantineId,omitempty"`
+}
+
+// Error implements the error interface
+func (e *ScanError) Error() string {
+	if e.FileName != "" {
+		return fmt.Sprintf("%s: %s", e.FileName, e.Message)
+	}
+	return e.Message
+}
+
+// Is makes errors.Is(err, ErrUploadRejected) match every ScanError
+func (e *ScanError) Is(target error) bool {
+	return target == ErrUploadRejected
+}
+
+// ScanErrors collects the rejections of a multi-file upload
+type ScanErrors []*ScanError
+
+// Error implements the error interface
+func (e ScanErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is makes errors.Is(err, ErrUploadRejected) match ScanErrors
+func (e ScanErrors) Is(target error) bool {
+	return target == ErrUploadRejected
+}
+
+func reject(scanner, rule, message string, details map[string]interface{}) *ScanError {
+	return &ScanError{Scanner: scanner, Rule: rule, Message: message, Details: details}
+}
+
+// UploadScanPipeline runs scanners in order and quarantines rejected uploads
+type UploadScanPipeline struct {
+	scanners   []UploadScanner
+	quarantine Quarantine
+}
+
+// NewUploadScanPipeline creates a pipeline. quarantine may be nil to drop rejected uploads.
+func NewUploadScanPipeline(quarantine Quarantine, scanners ...UploadScanner) *UploadScanPipeline {
+	return &UploadScanPipeline{scanners: scanners, quarantine: quarantine}
+}
+
+// Scan detects the content type, then runs every scanner and stops at the first
+// rejection, which is quarantined and returned as a *ScanError. A nil pipeline only
+// detects the content type.
+func (p *UploadScanPipeline) Scan(ctx context.Context, in *ScanInput) error {
+	if err := in.detectType(); err != nil {
+		return err
+	}
+	if p == nil {
+		return nil
+	}
+	for _, scanner := range p.scanners {
+		err := scanner.Scan(ctx, in)
+		if err == nil {
+			continue
+		}
+
+		var scanErr *ScanError
+		if !errors.As(err, &scanErr) {
+			return fmt.Errorf("%w: %s: %v", ErrScannerUnavailable, scanner.Name(), err)
+		}
+		scanErr.FileName = in.FileName
+		if scanErr.Scanner == "" {
+			scanErr.Scanner = scanner.Name()
+		}
+		p.quarantineUpload(ctx, in, scanErr)
+		return scanErr
+	}
+	return nil
+}
+
+// ScanAll scans every upload and returns all rejections together as ScanErrors
+func (p *UploadScanPipeline) ScanAll(ctx context.Context, inputs []*ScanInput) error {
+	var rejected ScanErrors
+	for _, in := range inputs {
+		err := p.Scan(ctx, in)
+		var scanErr *ScanError
+		switch {
+		case errors.As(err, &scanErr):
+			rejected = append(rejected, scanErr)
+		case err != nil:
+			return err
+		}
+	}
+	if len(rejected) > 0 {
+		return rejected
+	}
+	return nil
+}
+
+func (p *UploadScanPipeline) quarantineUpload(ctx context.Context, in *ScanInput, scanErr *ScanError) {
+	if p.quarantine == nil {
+		return
+	}
+	id, err := p.quarantine.Quarantine(ctx, in.reader(), scanErr)
+	if err != nil {
+		log.Printf("Failed to quarantine rejected upload %s: %v", in.FileName, err)
+		return
+	}
+	scanErr.QuarantineID = id
+	log.Printf("Quarantined rejected upload %s as %s: %s", in.FileName, id, scanErr.Message)
+}
+
+// typeAllowed reports whether contentType matches one of allowed, which may hold wildcards like "video/*"
+func typeAllowed(contentType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, pattern := range allowed {
+		pattern = normalizeContentType(pattern)
+		if pattern == contentType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(contentType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// contentTypeAliases maps non-standard types clients commonly send to the detected type
+var contentTypeAliases = map[string]string{
+	"image/jpg":       "image/jpeg",
+	"image/pjpeg":     "image/jpeg",
+	"image/x-png":     "image/png",
+	"video/x-m4v":     "video/mp4",
+	"application/mp4": "video/mp4",
+}
+
+// normalizeContentType lower-cases a content type, drops its parameters and resolves aliases
+func normalizeContentType(contentType string) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		contentType = mediaType
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	if alias, ok := contentTypeAliases[contentType]; ok {
+		return alias
+	}
+	return contentType
+}
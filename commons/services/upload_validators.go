@@ -0,0 +1,138 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ContentTypeScanner rejects uploads whose type, detected from their magic bytes, is not
+// allowed or contradicts the declared type or file extension
+type ContentTypeScanner struct{}
+
+// Name implements UploadScanner
+func (ContentTypeScanner) Name() string { return "content_type" }
+
+// Scan implements UploadScanner
+func (s ContentTypeScanner) Scan(ctx context.Context, in *ScanInput) error {
+	if err := in.detectType(); err != nil {
+		return err
+	}
+	if !typeAllowed(in.DetectedType, in.AllowedTypes) {
+		return reject(s.Name(), "type_not_allowed", fmt.Sprintf("file content is %s, which is not allowed here", in.DetectedType),
+			map[string]interface{}{"detectedType": in.DetectedType, "allowedTypes": in.AllowedTypes})
+	}
+
+	// Only the family is compared, as clients disagree on exact types like image/jpg
+	declared := normalizeContentType(in.DeclaredType)
+	if declared != "" && declared != "application/octet-stream" && majorType(declared) != majorType(in.DetectedType) {
+		return reject(s.Name(), "type_mismatch", fmt.Sprintf("file was sent as %s but its content is %s", declared, in.DetectedType),
+			map[string]interface{}{"declaredType": declared, "detectedType": in.DetectedType})
+	}
+	if byExt := normalizeContentType(mime.TypeByExtension(strings.ToLower(filepath.Ext(in.FileName)))); byExt != "" && majorType(byExt) != majorType(in.DetectedType) {
+		return reject(s.Name(), "extension_mismatch", fmt.Sprintf("file extension suggests %s but its content is %s", byExt, in.DetectedType),
+			map[string]interface{}{"extension": filepath.Ext(in.FileName), "detectedType": in.DetectedType})
+	}
+	return nil
+}
+
+// DetectUploadType returns the content type of an upload from its first bytes
+func DetectUploadType(head []byte) string {
+	if contentType := SniffImageType(head); contentType != "" {
+		return contentType
+	}
+	if contentType := isoBMFFType(head); contentType != "" {
+		return contentType
+	}
+	if bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3}) {
+		return "video/webm"
+	}
+	return normalizeContentType(http.DetectContentType(head))
+}
+
+func majorType(contentType string) string {
+	major, _, _ := strings.Cut(contentType, "/")
+	return major
+}
+
+// ImageDimensionScanner rejects raster images that are larger than the limits or whose
+// header cannot be read. Zero limits are not enforced.
+type ImageDimensionScanner struct {
+	MaxWidth  int
+	MaxHeight int
+	MaxPixels int64
+}
+
+// Name implements UploadScanner
+func (ImageDimensionScanner) Name() string { return "image_dimensions" }
+
+// Scan implements UploadScanner
+func (s ImageDimensionScanner) Scan(ctx context.Context, in *ScanInput) error {
+	if _, ok := rasterImageTypes[in.DetectedType]; !ok {
+		return nil
+	}
+	config, _, err := image.DecodeConfig(in.reader())
+	if err != nil {
+		return reject(s.Name(), "corrupt_image", "image header could not be read", nil)
+	}
+
+	details := map[string]interface{}{"width": config.Width, "height": config.Height}
+	switch {
+	case s.MaxWidth > 0 && config.Width > s.MaxWidth,
+		s.MaxHeight > 0 && config.Height > s.MaxHeight:
+		details["maxWidth"], details["maxHeight"] = s.MaxWidth, s.MaxHeight
+		return reject(s.Name(), "dimensions_exceeded",
+			fmt.Sprintf("image is %dx%d, the maximum is %dx%d", config.Width, config.Height, s.MaxWidth, s.MaxHeight), details)
+	case s.MaxPixels > 0 && int64(config.Width)*int64(config.Height) > s.MaxPixels:
+		details["maxPixels"] = s.MaxPixels
+		return reject(s.Name(), "dimensions_exceeded",
+			fmt.Sprintf("image has more than %d pixels", s.MaxPixels), details)
+	}
+	return nil
+}
+
+// VideoScanner reads MP4 and MOV metadata and rejects videos that are too long or use
+// codecs outside AllowedCodecs. A zero MaxDuration or empty AllowedCodecs is not enforced.
+type VideoScanner struct {
+	MaxDuration   time.Duration
+	AllowedCodecs []string // sample entry fourccs, e.g. avc1, hvc1, mp4a
+}
+
+// Name implements UploadScanner
+func (VideoScanner) Name() string { return "video" }
+
+// Scan implements UploadScanner
+func (s VideoScanner) Scan(ctx context.Context, in *ScanInput) error {
+	if in.DetectedType != "video/mp4" && in.DetectedType != "video/quicktime" {
+		return nil
+	}
+	meta, err := ReadVideoMetadata(in.Content, in.Size)
+	if err != nil {
+		return reject(s.Name(), "unreadable_video", "video metadata could not be read", nil)
+	}
+	if len(meta.VideoCodecs) == 0 {
+		return reject(s.Name(), "no_video_track", "file has no video track", nil)
+	}
+
+	if s.MaxDuration > 0 && meta.Duration > s.MaxDuration {
+		return reject(s.Name(), "duration_exceeded",
+			fmt.Sprintf("video is %s long, the maximum is %s", meta.Duration.Round(time.Second), s.MaxDuration),
+			map[string]interface{}{"durationSeconds": meta.Duration.Seconds(), "maxDurationSeconds": s.MaxDuration.Seconds()})
+	}
+	if len(s.AllowedCodecs) > 0 {
+		for _, codec := range append(meta.VideoCodecs, meta.AudioCodecs...) {
+			if codec != "" && !slices.Contains(s.AllowedCodecs, codec) {
+				return reject(s.Name(), "codec_not_allowed", fmt.Sprintf("codec %s is not allowed", codec),
+					map[string]interface{}{"codec": codec, "allowedCodecs": s.AllowedCodecs})
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// errInvalidVideo is returned when an MP4/MOV container cannot be parsed
+var errInvalidVideo = errors.New("invalid video container")
+
+// maxBoxesPerLevel bounds the work done on crafted files with many tiny boxes
+const maxBoxesPerLevel = 4096
+
+// VideoMetadata is read from the container without decoding any frames
+type VideoMetadata struct {
+	Duration    time.Duration
+	VideoCodecs []string // sample entry fourccs of video tracks, e.g. avc1, hvc1
+	AudioCodecs []string // sample entry fourccs of audio tracks, e.g. mp4a
+}
+
+// box is an ISO BMFF box located within a ReaderAt
+type box struct {
+	kind  string
+	start int64 // payload start
+	end   int64
+}
+
+// readBoxes calls fn for each box between start and end, stopping when fn returns false
+func readBoxes(r io.ReaderAt, start, end int64, fn func(box) (bool, error)) error {
+	header := make([]byte, 16)
+	for i, pos := 0, start; pos+8 <= end; i++ {
+		if i >= maxBoxesPerLevel {
+			return errInvalidVideo
+		}
+		if _, err := r.ReadAt(header[:8], pos); err != nil {
+			return errInvalidVideo
+		}
+		size := int64(binary.BigEndian.Uint32(header))
+		headerSize := int64(8)
+		switch size {
+		case 0:
+			size = end - pos
+		case 1:
+			if _, err := r.ReadAt(header[8:16], pos+8); err != nil {
+				return errInvalidVideo
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:]))
+			headerSize = 16
+		}
+		if size < headerSize || pos+size > end || pos+size < pos {
+			return errInvalidVideo
+		}
+
+		next, err := fn(box{kind: string(header[4:8]), start: pos + headerSize, end: pos + size})
+		if err != nil || !next {
+			return err
+		}
+		pos += size
+	}
+	return nil
+}
+
+// findBox returns the first child of kind between start and end
+func findBox(r io.ReaderAt, start, end int64, kind string) (box, bool, error) {
+	var found box
+	ok := false
+	err := readBoxes(r, start, end, func(b box) (bool, error) {
+		if b.kind == kind {
+			found, ok = b, true
+			return false, nil
+		}
+		return true, nil
+	})
+	return found, ok, err
+}
+
+// findPath follows nested box kinds from the given parent
+func findPath(r io.ReaderAt, parent box, kinds ...string) (box, bool, error) {
+	current := parent
+	for _, kind := range kinds {
+		next, ok, err := findBox(r, current.start, current.end, kind)
+		if err != nil || !ok {
+			return box{}, false, err
+		}
+		current = next
+	}
+	return current, true, nil
+}
+
+// isoBMFFType returns the content type of an MP4 or QuickTime file from its ftyp box,
+// or "" if head is not one
+func isoBMFFType(head []byte) string {
+	if len(head) < 12 || string(head[4:8]) != "ftyp" {
+		return ""
+	}
+	if string(head[8:12]) == "qt  " {
+		return "video/quicktime"
+	}
+	return "video/mp4"
+}
+
+// ReadVideoMetadata reads the duration and track codecs of an MP4 or MOV file
+func ReadVideoMetadata(r io.ReaderAt, size int64) (*VideoMetadata, error) {
+	moov, ok, err := findBox(r, 0, size, "moov")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errInvalidVideo
+	}
+
+	meta := &VideoMetadata{}
+	if mvhd, ok, err := findBox(r, moov.start, moov.end, "mvhd"); err != nil {
+		return nil, err
+	} else if ok {
+		if meta.Duration, err = readMovieDuration(r, mvhd); err != nil {
+			return nil, err
+		}
+	}
+
+	err = readBoxes(r, moov.start, moov.end, func(trak box) (bool, error) {
+		if trak.kind != "trak" {
+			return true, nil
+		}
+		handler, codec, err := readTrack(r, trak)
+		if err != nil {
+			return false, err
+		}
+		switch handler {
+		case "vide":
+			meta.VideoCodecs = append(meta.VideoCodecs, codec)
+		case "soun":
+			meta.AudioCodecs = append(meta.AudioCodecs, codec)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// readMovieDuration reads the duration and timescale of an mvhd box
+func readMovieDuration(r io.ReaderAt, mvhd box) (time.Duration, error) {
+	buf := make([]byte, 32)
+	n, _ := r.ReadAt(buf[:min(int64(len(buf)), mvhd.end-mvhd.start)], mvhd.start)
+	buf = buf[:n]
+
+	var timescale, duration uint64
+	switch {
+	case len(buf) >= 20 && buf[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(buf[12:]))
+		duration = uint64(binary.BigEndian.Uint32(buf[16:]))
+	case len(buf) >= 32 && buf[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(buf[20:]))
+		duration = binary.BigEndian.Uint64(buf[24:])
+	default:
+		return 0, errInvalidVideo
+	}
+	if timescale == 0 {
+		return 0, errInvalidVideo
+	}
+	seconds := float64(duration) / float64(timescale)
+	if seconds > float64(1<<62)/float64(time.Second) {
+		return time.Duration(1<<63 - 1), nil
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// readTrack returns the handler type and first sample entry fourcc of a trak box
+func readTrack(r io.ReaderAt, trak box) (string, string, error) {
+	hdlr, ok, err := findPath(r, trak, "mdia", "hdlr")
+	if err != nil || !ok {
+		return "", "", err
+	}
+	handler := make([]byte, 12)
+	if hdlr.end-hdlr.start < 12 {
+		return "", "", errInvalidVideo
+	}
+	if _, err := r.ReadAt(handler, hdlr.start); err != nil {
+		return "", "", errInvalidVideo
+	}
+
+	stsd, ok, err := findPath(r, trak, "mdia", "minf", "stbl", "stsd")
+	if err != nil || !ok {
+		return string(handler[8:12]), "", err
+	}
+	entry := make([]byte, 16)
+	if stsd.end-stsd.start < 16 {
+		return string(handler[8:12]), "", nil
+	}
+	if _, err := r.ReadAt(entry, stsd.start); err != nil {
+		return "", "", errInvalidVideo
+	}
+	return string(handler[8:12]), strings.TrimSpace(string(entry[12:16])), nil
+}
@@ -1,10 +1,15 @@
 package configs
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -25,6 +30,54 @@ type Config struct {
 	S3Bucket    string
 	S3BaseURL   string
 
+	// Storage Configuration
+	StorageDriver   string // s3, local or memory
+	LocalStorageDir string
+	PublicBaseURL   string
+	CDNBaseURL      string // Serves stored files unsigned when set, S3 only
+	FileSigningKey  string
+	FileURLExpiry   time.Duration
+
+	// Image Processing
+	ImageVariants      string // name:WIDTHxHEIGHT[:crop], comma separated
+	ImageVariantFormat string // auto, jpeg or webp
+	ImageJPEGQuality   int
+	ImageMaxPixels     int64
+	ImageSVGPolicy     string // sanitize or reject
+
+	// Upload Scanning
+	UploadScanMaxImageWidth  int
+	UploadScanMaxImageHeight int
+	UploadScanMaxVideoLength time.Duration
+	UploadScanVideoCodecs    []string
+	ClamAVAddress            string // host:port of clamd, "stub" for the local stub, empty to disable
+	ClamAVTimeout            time.Duration
+	QuarantineDir            string
+
+	// Background Jobs
+	UploadCleanupInterval time.Duration
+	StorageGCInterval     time.Duration
+	StorageGCGracePeriod  time.Duration
+	StorageGCDryRun       bool
+
+	// Idempotency Keys
+	IdempotencyKeyTTL  time.Duration
+	IdempotencyLockTTL time.Duration
+
+	// Pagination
+	CursorSigningKey string // signs list cursors so clients cannot forge their values
+
+	// Exports
+	ExportSyncMaxRows int64         // larger exports run as background jobs
+	ExportTTL         time.Duration // how long export jobs and their files are kept
+
+	// Bulk operations
+	BulkMaxItems int // most records one bulk request may change
+
+	// Trash
+	TrashRetention     map[string]time.Duration // how long deleted records are kept, by resource; unlisted are kept until purged by hand
+	TrashPurgeInterval time.Duration
+
 	// File Upload Limits
 	MaxFileSize int64
 }
@@ -81,13 +134,118 @@ func LoadConfig() (*Config, error) {
 	}
 	maxFileSize = maxFileSize * 1024 * 1024 // Convert to bytes
 
+	// Parse signed file URL expiry (default 60 minutes)
+	fileURLExpiry, err := strconv.Atoi(GetEnv("FILE_URL_EXPIRY_MINUTES", "60"))
+	if err != nil || fileURLExpiry <= 0 {
+		fileURLExpiry = 60
+	}
+
+	// Parse how often unconfirmed uploads are cleaned up (default 15 minutes)
+	uploadCleanupInterval, err := strconv.Atoi(GetEnv("UPLOAD_CLEANUP_INTERVAL_MINUTES", "15"))
+	if err != nil || uploadCleanupInterval <= 0 {
+		uploadCleanupInterval = 15
+	}
+
+	// Parse orphaned file collection schedule (default daily, deleting orphans older than 72 hours)
+	storageGCInterval, err := strconv.Atoi(GetEnv("STORAGE_GC_INTERVAL_HOURS", "24"))
+	if err != nil || storageGCInterval <= 0 {
+		storageGCInterval = 24
+	}
+	storageGCGracePeriod, err := strconv.Atoi(GetEnv("STORAGE_GC_GRACE_PERIOD_HOURS", "72"))
+	if err != nil || storageGCGracePeriod < 0 {
+		storageGCGracePeriod = 72
+	}
+
+	// Parse how long idempotent responses are replayed (default 24 hours) and how long a
+	// request holds its key before a retry may run it again (default 60 seconds)
+	idempotencyKeyTTL, err := strconv.Atoi(GetEnv("IDEMPOTENCY_KEY_TTL_HOURS", "24"))
+	if err != nil || idempotencyKeyTTL <= 0 {
+		idempotencyKeyTTL = 24
+	}
+	idempotencyLockTTL, err := strconv.Atoi(GetEnv("IDEMPOTENCY_LOCK_TTL_SECONDS", "60"))
+	if err != nil || idempotencyLockTTL <= 0 {
+		idempotencyLockTTL = 60
+	}
+
+	// Parse how many rows an export streams in the response before it becomes a background
+	// job (default 5000) and how long finished exports can be downloaded (default 24 hours)
+	exportSyncMaxRows, err := strconv.ParseInt(GetEnv("EXPORT_SYNC_MAX_ROWS", "5000"), 10, 64)
+	if err != nil || exportSyncMaxRows < 0 {
+		exportSyncMaxRows = 5000
+	}
+	exportTTL, err := strconv.Atoi(GetEnv("EXPORT_TTL_HOURS", "24"))
+	if err != nil || exportTTL <= 0 {
+		exportTTL = 24
+	}
+
+	// Parse how many records one bulk request may change (default 100)
+	bulkMaxItems, err := strconv.Atoi(GetEnv("BULK_MAX_ITEMS", "100"))
+	if err != nil || bulkMaxItems <= 0 {
+		bulkMaxItems = 100
+	}
+
+	// Parse how many days deleted records stay in the trash, as resource:days pairs
+	// (default 30 days, 90 for organizations; 0 keeps them until purged by hand), and how
+	// often expired ones are purged (default daily)
+	trashRetention := make(map[string]time.Duration)
+	for _, pair := range strings.Split(GetEnv("TRASH_RETENTION_DAYS", "users:30,organizations:90,roles:30,locations:30"), ",") {
+		resource, days, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(days)); err == nil && n > 0 {
+			trashRetention[strings.TrimSpace(resource)] = time.Duration(n) * 24 * time.Hour
+		}
+	}
+	trashPurgeInterval, err := strconv.Atoi(GetEnv("TRASH_PURGE_INTERVAL_HOURS", "24"))
+	if err != nil || trashPurgeInterval <= 0 {
+		trashPurgeInterval = 24
+	}
+
+	// Parse image processing limits
+	imageJPEGQuality, err := strconv.Atoi(GetEnv("IMAGE_JPEG_QUALITY", "85"))
+	if err != nil || imageJPEGQuality <= 0 || imageJPEGQuality > 100 {
+		imageJPEGQuality = 85
+	}
+	imageMaxPixels, err := strconv.ParseInt(GetEnv("IMAGE_MAX_PIXELS", "40000000"), 10, 64)
+	if err != nil || imageMaxPixels <= 0 {
+		imageMaxPixels = 40000000
+	}
+
+	// Parse upload scanning limits
+	uploadScanMaxImageWidth, err := strconv.Atoi(GetEnv("UPLOAD_SCAN_MAX_IMAGE_WIDTH", "12000"))
+	if err != nil || uploadScanMaxImageWidth < 0 {
+		uploadScanMaxImageWidth = 12000
+	}
+	uploadScanMaxImageHeight, err := strconv.Atoi(GetEnv("UPLOAD_SCAN_MAX_IMAGE_HEIGHT", "12000"))
+	if err != nil || uploadScanMaxImageHeight < 0 {
+		uploadScanMaxImageHeight = 12000
+	}
+	uploadScanMaxVideoSeconds, err := strconv.Atoi(GetEnv("UPLOAD_SCAN_MAX_VIDEO_SECONDS", "600"))
+	if err != nil || uploadScanMaxVideoSeconds < 0 {
+		uploadScanMaxVideoSeconds = 600
+	}
+	var uploadScanVideoCodecs []string
+	for _, codec := range strings.Split(GetEnv("UPLOAD_SCAN_VIDEO_CODECS", "avc1,avc3,hvc1,hev1,av01,vp09,mp4a,ac-3,ec-3"), ",") {
+		if codec = strings.TrimSpace(codec); codec != "" {
+			uploadScanVideoCodecs = append(uploadScanVideoCodecs, codec)
+		}
+	}
+	clamAVTimeout, err := strconv.Atoi(GetEnv("CLAMAV_TIMEOUT_SECONDS", "30"))
+	if err != nil || clamAVTimeout <= 0 {
+		clamAVTimeout = 30
+	}
+
+	port := GetEnv("PORT", "8080")
+	jwtSecret := GetEnv("JWT_SECRET", "")
+
 	AppConfig = &Config{
-		Port:         GetEnv("PORT", "8080"),
+		Port:         port,
 		Env:          GetEnv("ENV", "development"),
 		MongoURI:     GetEnv("MONGODB_URI", "mongodb://mongo:27017"),
 		DBName:       GetEnv("DB_NAME", "wecare_holidays"),
 		RedisURI:     GetEnv("REDIS_URI", "redis://redis:6379"),
-		JWTSecret:    GetEnv("JWT_SECRET", ""),
+		JWTSecret:    jwtSecret,
 		JWTExpiresIn: jwtExpires,
 
 		// S3 Configuration
@@ -97,6 +255,54 @@ func LoadConfig() (*Config, error) {
 		S3Bucket:    GetEnv("S3_BUCKET", ""),
 		S3BaseURL:   GetEnv("S3_BASE_URL", ""),
 
+		// Storage Configuration
+		StorageDriver:   strings.ToLower(GetEnv("STORAGE_DRIVER", "")),
+		LocalStorageDir: GetEnv("LOCAL_STORAGE_DIR", "./storage"),
+		PublicBaseURL:   strings.TrimSuffix(GetEnv("PUBLIC_BASE_URL", "http://localhost:"+port), "/"),
+		CDNBaseURL:      strings.TrimSuffix(GetEnv("CDN_BASE_URL", ""), "/"),
+		FileSigningKey:  GetEnv("FILE_SIGNING_KEY", jwtSecret),
+		FileURLExpiry:   time.Duration(fileURLExpiry) * time.Minute,
+
+		// Image Processing
+		ImageVariants:      GetEnv("IMAGE_VARIANTS", "thumb:150x150:crop,card:600x400:crop,full:1600x1600"),
+		ImageVariantFormat: strings.ToLower(GetEnv("IMAGE_VARIANT_FORMAT", "auto")),
+		ImageJPEGQuality:   imageJPEGQuality,
+		ImageMaxPixels:     imageMaxPixels,
+		ImageSVGPolicy:     strings.ToLower(GetEnv("IMAGE_SVG_POLICY", "sanitize")),
+
+		// Upload Scanning
+		UploadScanMaxImageWidth:  uploadScanMaxImageWidth,
+		UploadScanMaxImageHeight: uploadScanMaxImageHeight,
+		UploadScanMaxVideoLength: time.Duration(uploadScanMaxVideoSeconds) * time.Second,
+		UploadScanVideoCodecs:    uploadScanVideoCodecs,
+		ClamAVAddress:            GetEnv("CLAMAV_ADDRESS", ""),
+		ClamAVTimeout:            time.Duration(clamAVTimeout) * time.Second,
+		QuarantineDir:            GetEnv("QUARANTINE_DIR", "./quarantine"),
+
+		// Background Jobs
+		UploadCleanupInterval: time.Duration(uploadCleanupInterval) * time.Minute,
+		StorageGCInterval:     time.Duration(storageGCInterval) * time.Hour,
+		StorageGCGracePeriod:  time.Duration(storageGCGracePeriod) * time.Hour,
+		StorageGCDryRun:       GetEnv("STORAGE_GC_DRY_RUN", "false") == "true",
+
+		// Idempotency Keys
+		IdempotencyKeyTTL:  time.Duration(idempotencyKeyTTL) * time.Hour,
+		IdempotencyLockTTL: time.Duration(idempotencyLockTTL) * time.Second,
+
+		// Pagination
+		CursorSigningKey: GetEnv("CURSOR_SIGNING_KEY", deriveKey(jwtSecret, "list-cursor")),
+
+		// Exports
+		ExportSyncMaxRows: exportSyncMaxRows,
+		ExportTTL:         time.Duration(exportTTL) * time.Hour,
+
+		// Bulk operations
+		BulkMaxItems: bulkMaxItems,
+
+		// Trash
+		TrashRetention:     trashRetention,
+		TrashPurgeInterval: time.Duration(trashPurgeInterval) * time.Hour,
+
 		// File Upload Limits
 		MaxFileSize: maxFileSize,
 	}
@@ -109,3 +315,14 @@ func GetEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// deriveKey derives the key for purpose from secret, so a secret can back several keys
+// without one use signing values for another. It returns "" for an empty secret.
+func deriveKey(secret, purpose string) string {
+	if secret == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -2,11 +2,16 @@ package container
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/configs"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/jobs"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -18,9 +23,15 @@ type AppContainer struct {
 	MongoClient         *mongo.Client
 	MongoDatabase       *mongo.Database
 	RedisClient         *redis.Client
+	CacheService        services.CacheService
+	IdempotencyStore    services.IdempotencyStore
 	FileService         services.FileService
+	ImagePipeline       *services.ImagePipeline
+	UploadScanner       *services.UploadScanPipeline
+	InvitationSender    services.InvitationSender
 	RBACService         middleware.RBACService
 	PermissionValidator *middleware.PermissionValidator
+	Background          *jobs.Group // Work that outlives a request, stopped by Shutdown
 
 	// Module containers
 	Permission   *PermissionContainer
@@ -28,22 +39,56 @@ type AppContainer struct {
 	User         *UserContainer
 	Organization *OrganizationContainer
 	Location     *LocationContainer
+	Upload       *UploadContainer
+	Export       *ExportContainer
+	Trash        *TrashContainer
 }
 
 func BuildAppContainer(cfg *configs.Config) *AppContainer {
 	mongoClient, mongoDatabase := initMongo(cfg)
 	redisClient := initRedis(cfg)
 	fileService := initFileService(cfg)
+	initCursorSigning(cfg)
 
 	return &AppContainer{
-		Config:        cfg,
-		MongoClient:   mongoClient,
-		MongoDatabase: mongoDatabase,
-		RedisClient:   redisClient,
-		FileService:   fileService,
+		Config:           cfg,
+		MongoClient:      mongoClient,
+		MongoDatabase:    mongoDatabase,
+		RedisClient:      redisClient,
+		CacheService:     services.NewRedisCacheService(redisClient, "wecare:"),
+		IdempotencyStore: services.NewRedisIdempotencyStore(redisClient, "wecare:"),
+		FileService:      fileService,
+		ImagePipeline:    initImagePipeline(cfg, fileService),
+		UploadScanner:    initUploadScanner(cfg),
+		InvitationSender: services.NewLogInvitationSender(),
+		Background:       jobs.NewGroup(),
 	}
 }
 
+// Shutdown stops the background work, waiting for it until ctx ends, then closes the
+// database and cache connections it may still be using
+func (c *AppContainer) Shutdown(ctx context.Context) {
+	if err := c.Background.Shutdown(ctx); err != nil {
+		log.Printf("Background work did not stop in time: %v", err)
+	}
+	if err := c.MongoClient.Disconnect(ctx); err != nil {
+		log.Printf("Failed to disconnect from MongoDB: %v", err)
+	}
+	if err := c.RedisClient.Close(); err != nil {
+		log.Printf("Failed to close Redis: %v", err)
+	}
+}
+
+// Transact runs fn in a transaction. A standalone server, as used in development, cannot
+// run one, so there fn runs on its own and a failure part way keeps the earlier writes.
+func (c *AppContainer) Transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := database.WithTransaction(ctx, c.MongoClient, fn)
+	if errors.Is(err, database.ErrTransactionsUnsupported) {
+		return fn(ctx)
+	}
+	return err
+}
+
 func initMongo(cfg *configs.Config) (*mongo.Client, *mongo.Database) {
 	clientOptions := options.Client().ApplyURI(cfg.MongoURI)
 	client, err := mongo.Connect(context.Background(), clientOptions)
@@ -76,31 +121,139 @@ func initRedis(cfg *configs.Config) *redis.Client {
 	return client
 }
 
-// initFileService initializes file service (S3)
+// initFileService initializes the file service selected by STORAGE_DRIVER.
+// Without a driver it uses S3 when credentials are configured and local storage otherwise.
 func initFileService(cfg *configs.Config) services.FileService {
-	if cfg.S3AccessKey != "" && cfg.S3Bucket != "" {
+	driver := cfg.StorageDriver
+	if driver == "" {
+		if cfg.S3AccessKey != "" && cfg.S3Bucket != "" {
+			driver = constants.StorageDriverS3
+		} else {
+			driver = constants.StorageDriverLocal
+		}
+	}
+
+	switch driver {
+	case constants.StorageDriverS3:
 		s3Config := services.S3Config{
 			AccessKey:  cfg.S3AccessKey,
 			SecretKey:  cfg.S3SecretKey,
 			Region:     cfg.S3Region,
 			BucketName: cfg.S3Bucket,
 			BaseURL:    cfg.S3BaseURL,
+
+			PublicBaseURL: cfg.CDNBaseURL,
+			URLExpiry:     cfg.FileURLExpiry,
 		}
 
 		fileService, err := services.NewS3FileService(s3Config)
 		if err != nil {
-			log.Printf("Warning: Failed to initialize S3 file service: %v", err)
-			log.Println("File upload functionality will be disabled")
-			return nil
+			log.Fatalf("failed to initialize S3 file service: %v", err)
 		}
 
 		log.Println("S3 file service initialized successfully")
 		return fileService
+
+	case constants.StorageDriverLocal:
+		signingKey := cfg.FileSigningKey
+		if signingKey == "" {
+			// Signed URLs will not survive a restart, acceptable for local development only
+			signingKey = uuid.New().String()
+			log.Println("Warning: FILE_SIGNING_KEY not set, using a random key")
+		}
+
+		fileService, err := services.NewLocalFileService(services.LocalStorageConfig{
+			RootDir:    cfg.LocalStorageDir,
+			BaseURL:    cfg.PublicBaseURL + constants.AppBasePath + constants.FilesBasePath,
+			SigningKey: signingKey,
+			URLExpiry:  cfg.FileURLExpiry,
+		})
+		if err != nil {
+			log.Fatalf("failed to initialize local file service: %v", err)
+		}
+
+		log.Printf("Local file service initialized at %s", cfg.LocalStorageDir)
+		return fileService
+
+	case constants.StorageDriverMemory:
+		log.Println("In-memory file service initialized, files are lost on restart")
+		return services.NewInMemoryFileService()
+
+	default:
+		log.Fatalf("unknown STORAGE_DRIVER %q, expected s3, local or memory", driver)
+		return nil
+	}
+}
+
+// initCursorSigning sets the key list cursors are signed with
+func initCursorSigning(cfg *configs.Config) {
+	if cfg.CursorSigningKey == "" {
+		// Cursors will not survive a restart or work across instances, acceptable for local development only
+		log.Println("Warning: CURSOR_SIGNING_KEY not set, using a random key")
+		return
+	}
+	database.SetCursorSigningKey([]byte(cfg.CursorSigningKey))
+}
+
+// initImagePipeline builds the image pipeline from the IMAGE_* settings
+func initImagePipeline(cfg *configs.Config, fileService services.FileService) *services.ImagePipeline {
+	variants, err := services.ParseImageVariants(cfg.ImageVariants)
+	if err != nil {
+		log.Fatalf("invalid IMAGE_VARIANTS: %v", err)
+	}
+
+	switch cfg.ImageVariantFormat {
+	case services.ImageFormatAuto, services.ImageFormatJPEG, services.ImageFormatWebP:
+	default:
+		log.Fatalf("unknown IMAGE_VARIANT_FORMAT %q, expected auto, jpeg or webp", cfg.ImageVariantFormat)
+	}
+	switch cfg.ImageSVGPolicy {
+	case services.SVGPolicySanitize, services.SVGPolicyReject:
+	default:
+		log.Fatalf("unknown IMAGE_SVG_POLICY %q, expected sanitize or reject", cfg.ImageSVGPolicy)
+	}
+
+	return services.NewImagePipeline(fileService, services.ImagePipelineConfig{
+		Variants:    variants,
+		Format:      cfg.ImageVariantFormat,
+		JPEGQuality: cfg.ImageJPEGQuality,
+		MaxPixels:   cfg.ImageMaxPixels,
+		SVGPolicy:   cfg.ImageSVGPolicy,
+	})
+}
+
+// initUploadScanner builds the scanners every upload passes before it is stored:
+// content type, image dimensions, video metadata and, when configured, ClamAV
+func initUploadScanner(cfg *configs.Config) *services.UploadScanPipeline {
+	quarantine, err := services.NewLocalQuarantine(cfg.QuarantineDir)
+	if err != nil {
+		log.Fatalf("failed to initialize upload quarantine: %v", err)
+	}
+
+	scanners := []services.UploadScanner{
+		services.ContentTypeScanner{},
+		services.ImageDimensionScanner{
+			MaxWidth:  cfg.UploadScanMaxImageWidth,
+			MaxHeight: cfg.UploadScanMaxImageHeight,
+			MaxPixels: cfg.ImageMaxPixels,
+		},
+		services.VideoScanner{
+			MaxDuration:   cfg.UploadScanMaxVideoLength,
+			AllowedCodecs: cfg.UploadScanVideoCodecs,
+		},
+	}
+
+	switch cfg.ClamAVAddress {
+	case "":
+		log.Println("Warning: CLAMAV_ADDRESS not set, uploads are not scanned for malware")
+	case services.ClamAVStubAddress:
+		log.Println("Using the stub virus scanner, only the EICAR test file is detected")
+		scanners = append(scanners, services.StubAntivirusScanner{})
+	default:
+		scanners = append(scanners, services.NewClamAVScanner(cfg.ClamAVAddress, cfg.ClamAVTimeout))
 	}
 
-	// Return nil when S3 configuration is not provided
-	log.Println("S3 configuration not provided, file service will be disabled")
-	return nil
+	return services.NewUploadScanPipeline(quarantine, scanners...)
 }
 
 func (ac *AppContainer) InjectRBACServices() {
@@ -0,0 +1,28 @@
+package container
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/data/mongodb/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/usecases"
+)
+
+type ExportContainer struct {
+	Repository          *repository.ExportJobRepositoryMongo
+	StartExportUseCase  *usecases.StartExportUseCase
+	GetExportJobUseCase *usecases.GetExportJobUseCase
+}
+
+func (c *AppContainer) InjectExportContainer() {
+	// Datasource
+	jobDS := datasource.NewMongoExportJobDatasource(c.MongoDatabase)
+
+	// Repository
+	jobRepo := repository.NewExportJobRepositoryMongo(jobDS)
+
+	// Assign to container
+	c.Export = &ExportContainer{
+		Repository:          jobRepo,
+		StartExportUseCase:  usecases.NewStartExportUseCase(jobRepo, c.FileService, c.Config.ExportTTL, c.Background),
+		GetExportJobUseCase: usecases.NewGetExportJobUseCase(jobRepo),
+	}
+}
@@ -0,0 +1,62 @@
+package container
+
+import (
+	"context"
+	"errors"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/jobs"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"go.uber.org/zap"
+)
+
+// StartBackgroundJobs fails the jobs a crash interrupted, then schedules periodic
+// maintenance until Shutdown.
+// Jobs claim their work atomically, so running them on every instance is safe.
+func (c *AppContainer) StartBackgroundJobs() {
+	// A crash leaves the jobs it was running unfinished forever
+	if failed, err := c.Location.ImportLocationsUseCase.FailInterrupted(context.Background()); err != nil {
+		logger.Log.Error("Failed to fail interrupted location imports", zap.Error(err))
+	} else if failed > 0 {
+		logger.Log.Info("Failed interrupted location imports", zap.Int64("count", failed))
+	}
+	if failed, err := c.Export.StartExportUseCase.FailInterrupted(context.Background()); err != nil {
+		logger.Log.Error("Failed to fail interrupted exports", zap.Error(err))
+	} else if failed > 0 {
+		logger.Log.Info("Failed interrupted exports", zap.Int64("count", failed))
+	}
+
+	jobs.Every(c.Background, "upload_cleanup", c.Config.UploadCleanupInterval, func(ctx context.Context) error {
+		cleaned, err := c.Upload.CleanupExpiredUploadsUseCase.Execute(ctx)
+		if cleaned > 0 {
+			logger.Log.Info("Cleaned up unconfirmed uploads", zap.Int("count", cleaned))
+		}
+		return err
+	})
+
+	jobs.Every(c.Background, "storage_gc", c.Config.StorageGCInterval, func(ctx context.Context) error {
+		report, err := c.Upload.ReconcileStorageUseCase.Execute(ctx, c.Config.StorageGCDryRun)
+		if errors.Is(err, uploadEntity.ErrStorageListingUnsupported) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(report.Orphans) > 0 || len(report.Missing) > 0 {
+			logger.Log.Info("Reconciled file storage",
+				zap.Int("orphans", len(report.Orphans)),
+				zap.Int("deleted", report.DeletedCount),
+				zap.Int("missing", len(report.Missing)),
+				zap.Bool("dry_run", report.DryRun))
+		}
+		return nil
+	})
+
+	jobs.Every(c.Background, "trash_purge", c.Config.TrashPurgeInterval, func(ctx context.Context) error {
+		purged, err := c.Trash.PurgeExpiredTrashUseCase.Execute(ctx)
+		if purged > 0 {
+			logger.Log.Info("Purged expired trash", zap.Int("count", purged))
+		}
+		return err
+	})
+}
@@ -11,32 +11,64 @@ type LocationContainer struct {
 	CreateLocationUseCase       *usecases.CreateLocationUseCase
 	ListLocationsUseCase        *usecases.ListLocationsUseCase
 	UpdateLocationUseCase       *usecases.UpdateLocationUseCase
+	PatchLocationUseCase        *usecases.PatchLocationUseCase
 	DeleteLocationUseCase       *usecases.DeleteLocationUseCase
 	BulkSoftDeleteLocationsUseCase  *usecases.BulkSoftDeleteLocationsUseCase
 	UploadLocationMediaUseCase  *usecases.UploadLocationMediaUseCase
 	RestoreLocationUseCase      *usecases.RestoreLocationUseCase
 	BulkRestoreLocationsUseCase *usecases.BulkRestoreLocationsUseCase
 	HardDeleteLocationUseCase   *usecases.HardDeleteLocationUseCase
+	GetLocationChildrenUseCase  *usecases.GetLocationChildrenUseCase
+	GetLocationAncestorsUseCase *usecases.GetLocationAncestorsUseCase
+	MoveLocationUseCase         *usecases.MoveLocationUseCase
+	ListLocationsByDistanceUseCase *usecases.ListLocationsByDistanceUseCase
+	SearchLocationsUseCase       *usecases.SearchLocationsUseCase
+	AutocompleteLocationsUseCase *usecases.AutocompleteLocationsUseCase
+	RecordLocationViewUseCase    *usecases.RecordLocationViewUseCase
+	ImportLocationsUseCase       *usecases.ImportLocationsUseCase
+	GetLocationImportJobUseCase  *usecases.GetLocationImportJobUseCase
+	ExportLocationsUseCase       *usecases.ExportLocationsUseCase
+	ReorderLocationMediaUseCase  *usecases.ReorderLocationMediaUseCase
+	UpdateLocationMediaUseCase   *usecases.UpdateLocationMediaUseCase
+	SetLocationCoverMediaUseCase *usecases.SetLocationCoverMediaUseCase
+	DeleteLocationMediaUseCase   *usecases.DeleteLocationMediaUseCase
 }
 
 func (c *AppContainer) InjectLocationContainer() {
 	// Datasource
 	locationDS := datasource.NewMongoLocationDatasource(c.MongoDatabase)
+	importJobDS := datasource.NewMongoLocationImportJobDatasource(c.MongoDatabase)
 
 	// Repository
 	locationRepo := repository.NewLocationRepositoryMongo(locationDS)
+	importJobRepo := repository.NewLocationImportJobRepositoryMongo(importJobDS)
 
 	// Use cases
 	getLocationUC := usecases.NewGetLocationUseCase(locationRepo)
 	createLocationUC := usecases.NewCreateLocationUseCase(locationRepo)
 	listLocationsUC := usecases.NewListLocationsUseCase(locationRepo)
 	updateLocationUC := usecases.NewUpdateLocationUseCase(locationRepo)
+	patchLocationUC := usecases.NewPatchLocationUseCase(locationRepo)
 	deleteLocationUC := usecases.NewDeleteLocationUseCase(locationRepo)
 	bulkSoftDeleteLocationsUC := usecases.NewBulkSoftDeleteLocationsUseCase(locationRepo)
 	uploadLocationMediaUC := usecases.NewUploadLocationMediaUseCase(locationRepo)
 	restoreUC := usecases.NewRestoreLocationUseCase(locationRepo)
 	bulkRestoreUC := usecases.NewBulkRestoreLocationsUseCase(locationRepo)
-	hardDeleteUC := usecases.NewHardDeleteLocationUseCase(locationRepo)
+	hardDeleteUC := usecases.NewHardDeleteLocationUseCase(locationRepo, c.Transact)
+	childrenUC := usecases.NewGetLocationChildrenUseCase(locationRepo)
+	ancestorsUC := usecases.NewGetLocationAncestorsUseCase(locationRepo)
+	moveUC := usecases.NewMoveLocationUseCase(locationRepo, c.Transact)
+	byDistanceUC := usecases.NewListLocationsByDistanceUseCase(locationRepo)
+	searchUC := usecases.NewSearchLocationsUseCase(locationRepo)
+	autocompleteUC := usecases.NewAutocompleteLocationsUseCase(locationRepo)
+	recordViewUC := usecases.NewRecordLocationViewUseCase(locationRepo)
+	importUC := usecases.NewImportLocationsUseCase(locationRepo, importJobRepo, c.Background)
+	importJobUC := usecases.NewGetLocationImportJobUseCase(importJobRepo)
+	exportUC := usecases.NewExportLocationsUseCase(locationRepo)
+	reorderMediaUC := usecases.NewReorderLocationMediaUseCase(locationRepo)
+	updateMediaUC := usecases.NewUpdateLocationMediaUseCase(locationRepo)
+	setCoverMediaUC := usecases.NewSetLocationCoverMediaUseCase(locationRepo)
+	deleteMediaUC := usecases.NewDeleteLocationMediaUseCase(locationRepo, c.FileService)
 
 	// Assign to container
 	c.Location = &LocationContainer{
@@ -44,11 +76,26 @@ func (c *AppContainer) InjectLocationContainer() {
 		CreateLocationUseCase:      createLocationUC,
 		ListLocationsUseCase:       listLocationsUC,
 		UpdateLocationUseCase:      updateLocationUC,
+		PatchLocationUseCase:       patchLocationUC,
 		DeleteLocationUseCase:      deleteLocationUC,
 		BulkSoftDeleteLocationsUseCase: bulkSoftDeleteLocationsUC,
 		UploadLocationMediaUseCase: uploadLocationMediaUC,
 		RestoreLocationUseCase:      restoreUC,
 		BulkRestoreLocationsUseCase: bulkRestoreUC,
 		HardDeleteLocationUseCase:   hardDeleteUC,
+		GetLocationChildrenUseCase:  childrenUC,
+		GetLocationAncestorsUseCase: ancestorsUC,
+		MoveLocationUseCase:         moveUC,
+		ListLocationsByDistanceUseCase: byDistanceUC,
+		SearchLocationsUseCase:       searchUC,
+		AutocompleteLocationsUseCase: autocompleteUC,
+		RecordLocationViewUseCase:    recordViewUC,
+		ImportLocationsUseCase:       importUC,
+		GetLocationImportJobUseCase:  importJobUC,
+		ExportLocationsUseCase:       exportUC,
+		ReorderLocationMediaUseCase:  reorderMediaUC,
+		UpdateLocationMediaUseCase:   updateMediaUC,
+		SetLocationCoverMediaUseCase: setCoverMediaUC,
+		DeleteLocationMediaUseCase:   deleteMediaUC,
 	}
 }
@@ -13,12 +13,22 @@ type OrganizationContainer struct {
 	CreateOrganizationUseCase          *usecases.CreateOrganizationUseCase
 	ListOrganizationUseCase            *usecases.ListOrganizationUseCase
 	UpdateOrganizationUseCase          *usecases.UpdateOrganizationUseCase
+	PatchOrganizationUseCase           *usecases.PatchOrganizationUseCase
 	UpdateOrganizationStatusUseCase    *usecases.UpdateOrganizationStatusUseCase
 	SoftDeleteOrganizationUseCase      *usecases.SoftDeleteOrganizationUseCase
 	RestoreOrganizationUseCase         *usecases.RestoreOrganizationUseCase
 	BulkSoftDeleteOrganizationsUseCase *usecases.BulkSoftDeleteOrganizationsUseCase
 	HardDeleteOrganizationUseCase      *usecases.HardDeleteOrganizationUseCase
 	BulkRestoreOrganizationsUseCase 	*usecases.BulkRestoreOrganizationsUseCase
+	ExportOrganizationsUseCase         *usecases.ExportOrganizationsUseCase
+
+	SettingsRepository                *repository.OrganizationSettingsRepositoryMongo
+	GetOrganizationSettingsUseCase    *usecases.GetOrganizationSettingsUseCase
+	UpdateOrganizationSettingsUseCase *usecases.UpdateOrganizationSettingsUseCase
+	ListFeatureFlagOverridesUseCase   *usecases.ListFeatureFlagOverridesUseCase
+	SetFeatureFlagOverrideUseCase     *usecases.SetFeatureFlagOverrideUseCase
+	DeleteFeatureFlagOverrideUseCase  *usecases.DeleteFeatureFlagOverrideUseCase
+	FeatureFlagEvaluator              *usecases.FeatureFlagEvaluator
 }
 
 
@@ -33,12 +43,23 @@ func (c *AppContainer) InjectOrganizationContainer() {
 	createOrganizationUC := usecases.NewCreateOrganizationUseCase(organizationRepo)
 	listOrganizationUC := usecases.NewListOrganizationUseCase(organizationRepo)
 	updateOrganizationUC := usecases.NewUpdateOrganizationUseCase(organizationRepo)
+	patchOrganizationUC := usecases.NewPatchOrganizationUseCase(organizationRepo)
 	updateOrganizationStatusUC := usecases.NewUpdateOrganizationStatusUseCase(organizationRepo)
 	softDeleteOrganizationUC := usecases.NewSoftDeleteOrganizationUseCase(organizationRepo)
 	restoreOrganizationUC := usecases.NewRestoreOrganizationUseCase(organizationRepo)
 	bulkSoftDeleteOrganizationsUC := usecases.NewBulkSoftDeleteOrganizationsUseCase(organizationRepo)
-	hardDeleteOrganizationUC := usecases.NewHardDeleteOrganizationUseCase(organizationRepo)
 	bulkRestoreOrganizationsUC 	:= usecases.NewBulkRestoreOrganizationsUseCase(organizationRepo)
+	exportOrganizationsUC := usecases.NewExportOrganizationsUseCase(organizationRepo)
+
+	// Settings and feature flags
+	settingsRepo := repository.NewOrganizationSettingsRepositoryMongo(datasource.NewMongoOrganizationSettingsDatasource(c.MongoDatabase))
+	flagOverrideRepo := repository.NewFeatureFlagOverrideRepositoryMongo(datasource.NewMongoFeatureFlagOverrideDatasource(c.MongoDatabase))
+	getSettingsUC := usecases.NewGetOrganizationSettingsUseCase(settingsRepo, c.CacheService)
+	updateSettingsUC := usecases.NewUpdateOrganizationSettingsUseCase(settingsRepo, c.CacheService)
+	listOverridesUC := usecases.NewListFeatureFlagOverridesUseCase(flagOverrideRepo, c.CacheService)
+	setOverrideUC := usecases.NewSetFeatureFlagOverrideUseCase(flagOverrideRepo, c.CacheService)
+	deleteOverrideUC := usecases.NewDeleteFeatureFlagOverrideUseCase(flagOverrideRepo, c.CacheService)
+	hardDeleteOrganizationUC := usecases.NewHardDeleteOrganizationUseCase(organizationRepo, settingsRepo, c.CacheService)
 
 	// Assign to container
 	c.Organization = &OrganizationContainer{
@@ -47,11 +68,21 @@ func (c *AppContainer) InjectOrganizationContainer() {
 		CreateOrganizationUseCase:          createOrganizationUC,
 		ListOrganizationUseCase:            listOrganizationUC,
 		UpdateOrganizationUseCase:          updateOrganizationUC,
+		PatchOrganizationUseCase:           patchOrganizationUC,
 		UpdateOrganizationStatusUseCase:    updateOrganizationStatusUC,
 		SoftDeleteOrganizationUseCase:      softDeleteOrganizationUC,
 		RestoreOrganizationUseCase:         restoreOrganizationUC,
 		BulkSoftDeleteOrganizationsUseCase: bulkSoftDeleteOrganizationsUC,
 		HardDeleteOrganizationUseCase:      hardDeleteOrganizationUC,
 		BulkRestoreOrganizationsUseCase: 	bulkRestoreOrganizationsUC,
+		ExportOrganizationsUseCase:         exportOrganizationsUC,
+
+		SettingsRepository:                settingsRepo,
+		GetOrganizationSettingsUseCase:    getSettingsUC,
+		UpdateOrganizationSettingsUseCase: updateSettingsUC,
+		ListFeatureFlagOverridesUseCase:   listOverridesUC,
+		SetFeatureFlagOverrideUseCase:     setOverrideUC,
+		DeleteFeatureFlagOverrideUseCase:  deleteOverrideUC,
+		FeatureFlagEvaluator:              usecases.NewFeatureFlagEvaluator(getSettingsUC, listOverridesUC),
 	}
 }
\ No newline at end of file
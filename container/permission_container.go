@@ -12,6 +12,7 @@ type PermissionContainer struct {
 	CreatePermissionUseCase *usecases.CreatePermissionUseCase
 	ListPermissionsUseCase  *usecases.ListPermissionsUseCase
 	UpdatePermissionUseCase *usecases.UpdatePermissionUseCase
+	PatchPermissionUseCase  *usecases.PatchPermissionUseCase
 
 	HardDeletePermissionUseCase *usecases.HardDeletePermissionUseCase
 }
@@ -28,6 +29,7 @@ func (c *AppContainer) InjectPermissionContainer() {
 	createPermissionUC := usecases.NewCreatePermissionUseCase(permissionRepo)
 	listPermissionUC := usecases.NewListPermissionUseCase(permissionRepo)
 	updatePermissionUC := usecases.NewUpdatePermissionUseCase(permissionRepo)
+	patchPermissionUC := usecases.NewPatchPermissionUseCase(permissionRepo)
 	hardDeletePermissionUC := usecases.NewHardDeletePermissionUseCase(permissionRepo)
 
 	c.Permission = &PermissionContainer{
@@ -36,6 +38,7 @@ func (c *AppContainer) InjectPermissionContainer() {
 		CreatePermissionUseCase:     createPermissionUC,
 		ListPermissionsUseCase:      listPermissionUC,
 		UpdatePermissionUseCase:     updatePermissionUC,
+		PatchPermissionUseCase:      patchPermissionUC,
 		HardDeletePermissionUseCase: hardDeletePermissionUC,
 	}
 }
@@ -12,11 +12,13 @@ type RoleContainer struct {
 	CreateRoleUseCase          *usecases.CreateRoleUseCase
 	ListRolesUseCase           *usecases.ListRolesUseCase
 	UpdateRoleUseCase          *usecases.UpdateRoleUseCase
+	PatchRoleUseCase           *usecases.PatchRoleUseCase
 	SoftDeleteRoleUseCase      *usecases.SoftDeleteRoleUseCase
 	RestoreRoleUseCase         *usecases.RestoreRoleUseCase
 	BulkSoftDeleteRolesUseCase *usecases.BulkSoftDeleteRolesUseCase
 	HardDeleteRoleUseCase      *usecases.HardDeleteRoleUseCase
 	BulkRestoreRolesUseCase    *usecases.BulkRestoreRolesUseCase
+	ExportRolesUseCase         *usecases.ExportRolesUseCase
 }
 
 func (c *AppContainer) InjectRoleContainer() {
@@ -36,11 +38,13 @@ func (c *AppContainer) InjectRoleContainer() {
 	createRoleUC := usecases.NewCreateRoleUseCase(roleRepo, permissionRepo)
 	listRolesUC := usecases.NewListRolesUseCase(roleRepo)
 	updateRoleUC := usecases.NewUpdateRoleUseCase(roleRepo, permissionRepo)
+	patchRoleUC := usecases.NewPatchRoleUseCase(roleRepo, permissionRepo)
 	softDeleteRoleUC := usecases.NewSoftDeleteRoleUseCase(roleRepo)
 	restoreRoleUC := usecases.NewRestoreRoleUseCase(roleRepo)
 	bulkSoftDeleteRolesUC := usecases.NewBulkSoftDeleteRolesUseCase(roleRepo)
 	hardDeleteRoleUC := usecases.NewHardDeleteRoleUseCase(roleRepo)
 	bulkRestoreRolesUC := usecases.NewBulkRestoreRolesUseCase(roleRepo)
+	exportRolesUC := usecases.NewExportRolesUseCase(roleRepo)
 
 	// Assign to container
 	c.Role = &RoleContainer{
@@ -49,10 +53,12 @@ func (c *AppContainer) InjectRoleContainer() {
 		CreateRoleUseCase:          createRoleUC,
 		ListRolesUseCase:           listRolesUC,
 		UpdateRoleUseCase:          updateRoleUC,
+		PatchRoleUseCase:           patchRoleUC,
 		SoftDeleteRoleUseCase:      softDeleteRoleUC,
 		RestoreRoleUseCase:         restoreRoleUC,
 		BulkSoftDeleteRolesUseCase: bulkSoftDeleteRolesUC,
 		HardDeleteRoleUseCase:      hardDeleteRoleUC,
 		BulkRestoreRolesUseCase:    bulkRestoreRolesUC,
+		ExportRolesUseCase:         exportRolesUC,
 	}
 }
@@ -0,0 +1,50 @@
+package container
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/data/mongodb/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/usecases"
+)
+
+type TrashContainer struct {
+	Repository               *repository.TrashRepositoryMongo
+	ListTrashUseCase         *usecases.ListTrashUseCase
+	RestoreTrashItemUseCase  *usecases.RestoreTrashItemUseCase
+	PurgeTrashItemUseCase    *usecases.PurgeTrashItemUseCase
+	PurgeExpiredTrashUseCase *usecases.PurgeExpiredTrashUseCase
+}
+
+// InjectTrashContainer wires the trash to the restore and hard delete use cases of each
+// resource, so it must run after their containers and the upload container
+func (c *AppContainer) InjectTrashContainer() {
+	// Datasource
+	trashDS := datasource.NewMongoTrashDatasource(c.MongoDatabase)
+
+	// Repository
+	trashRepo := repository.NewTrashRepositoryMongo(trashDS)
+
+	// Use cases
+	restorers := map[string]usecases.ItemAction{
+		entity.ResourceUsers:         c.User.RestoreUserUseCase.Execute,
+		entity.ResourceOrganizations: c.Organization.RestoreOrganizationUseCase.Execute,
+		entity.ResourceRoles:         c.Role.RestoreRoleUseCase.Execute,
+		entity.ResourceLocations:     c.Location.RestoreLocationUseCase.Execute,
+	}
+	deleters := map[string]usecases.ItemAction{
+		entity.ResourceUsers:         c.User.HardDeleteUserUseCase.Execute,
+		entity.ResourceOrganizations: c.Organization.HardDeleteOrganizationUseCase.Execute,
+		entity.ResourceRoles:         c.Role.HardDeleteRoleUseCase.Execute,
+		entity.ResourceLocations:     c.Location.HardDeleteLocationUseCase.Execute,
+	}
+	purgeUC := usecases.NewPurgeTrashItemUseCase(trashRepo, deleters, c.Upload.FileReferences, c.FileService)
+
+	// Assign to container
+	c.Trash = &TrashContainer{
+		Repository:               trashRepo,
+		ListTrashUseCase:         usecases.NewListTrashUseCase(trashRepo, c.Config.TrashRetention),
+		RestoreTrashItemUseCase:  usecases.NewRestoreTrashItemUseCase(trashRepo, restorers),
+		PurgeTrashItemUseCase:    purgeUC,
+		PurgeExpiredTrashUseCase: usecases.NewPurgeExpiredTrashUseCase(trashRepo, purgeUC, c.Config.TrashRetention),
+	}
+}
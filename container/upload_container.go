@@ -0,0 +1,40 @@
+package container
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/data/mongodb/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/usecases"
+)
+
+type UploadContainer struct {
+	Repository                   *repository.UploadSessionRepositoryMongo
+	FileReferences               *repository.FileReferenceRepositoryMongo
+	CreateUploadSessionUseCase   *usecases.CreateUploadSessionUseCase
+	ConfirmUploadSessionUseCase  *usecases.ConfirmUploadSessionUseCase
+	CleanupExpiredUploadsUseCase *usecases.CleanupExpiredUploadsUseCase
+	ReconcileStorageUseCase      *usecases.ReconcileStorageUseCase
+}
+
+func (c *AppContainer) InjectUploadContainer() {
+	// Direct uploads need storage the client can reach; nil disables them
+	storage, _ := c.FileService.(services.DirectUploadService)
+
+	// Datasource
+	sessionDS := datasource.NewMongoUploadSessionDatasource(c.MongoDatabase)
+	fileRefDS := datasource.NewMongoFileReferenceDatasource(c.MongoDatabase)
+
+	// Repository
+	sessionRepo := repository.NewUploadSessionRepositoryMongo(sessionDS)
+	fileRefRepo := repository.NewFileReferenceRepositoryMongo(fileRefDS)
+
+	// Assign to container
+	c.Upload = &UploadContainer{
+		Repository:                   sessionRepo,
+		FileReferences:               fileRefRepo,
+		CreateUploadSessionUseCase:   usecases.NewCreateUploadSessionUseCase(sessionRepo, storage, c.Organization.GetOrganizationSettingsUseCase),
+		ConfirmUploadSessionUseCase:  usecases.NewConfirmUploadSessionUseCase(sessionRepo, storage, c.UploadScanner),
+		CleanupExpiredUploadsUseCase: usecases.NewCleanupExpiredUploadsUseCase(sessionRepo, storage),
+		ReconcileStorageUseCase:      usecases.NewReconcileStorageUseCase(fileRefRepo, c.FileService, c.Config.StorageGCGracePeriod),
+	}
+}
@@ -12,6 +12,7 @@ type UserContainer struct {
 	CreateUserUseCase          *usecases.CreateUserUseCase
 	ListUsersUseCase           *usecases.ListUsersUseCase
 	UpdateUserUseCase          *usecases.UpdateUserUseCase
+	PatchUserUseCase           *usecases.PatchUserUseCase
 	UpdateUserStatusUseCase    *usecases.UpdateUserStatusUseCase
 	SoftDeleteUserUseCase      *usecases.SoftDeleteUserUseCase
 	RestoreUserUseCase         *usecases.RestoreUserUseCase
@@ -19,6 +20,10 @@ type UserContainer struct {
 	BulkRestoreUsersUseCase    *usecases.BulkRestoreUsersUseCase
 	HardDeleteUserUseCase      *usecases.HardDeleteUserUseCase
 	FindUserByEmailUsecase     *usecases.FindUserByEmailUsecase
+	ExpandUsersUseCase         *usecases.ExpandUsersUseCase
+	ExportUsersUseCase         *usecases.ExportUsersUseCase
+	ImportUsersUseCase         *usecases.ImportUsersUseCase
+	AssignUserRoleUseCase      *usecases.AssignUserRoleUseCase
 }
 
 func (c *AppContainer) InjectUserContainer() {
@@ -29,6 +34,10 @@ func (c *AppContainer) InjectUserContainer() {
 	if c.Organization == nil {
 		panic("Organization container must be injected before User container")
 	}
+
+	if c.Permission == nil {
+		panic("Permission container must be injected before User container")
+	}
 	// Datasource
 	userDS := datasource.NewMongoUserDatasource(c.MongoDatabase)
 	// Repository
@@ -41,6 +50,7 @@ func (c *AppContainer) InjectUserContainer() {
 	createUserUC := usecases.NewCreateUserUseCase(userRepo, roleRepo, orgRepo)
 	listUserUC := usecases.NewListUsersUseCase(userRepo)
 	updateUserUC := usecases.NewUpdateUserUseCase(userRepo)
+	patchUserUC := usecases.NewPatchUserUseCase(userRepo)
 	updateUserStatusUC := usecases.NewUpdateUserStatusUseCase(userRepo)
 	softDeleteUserUC := usecases.NewSoftDeleteUserUseCase(userRepo)
 	restoreUserUC := usecases.NewRestoreUserUseCase(userRepo)
@@ -48,6 +58,10 @@ func (c *AppContainer) InjectUserContainer() {
 	hardDeleteUserUC := usecases.NewHardDeleteUserUseCase(userRepo)
 	bulkRestoreUsersUC := usecases.NewBulkRestoreUsersUseCase(userRepo)
 	findUserByEmailUC := usecases.NewFindUserByEmailUsecase(userRepo)
+	expandUsersUC := usecases.NewExpandUsersUseCase(roleRepo, orgRepo, c.Permission.Repository)
+	exportUsersUC := usecases.NewExportUsersUseCase(userRepo)
+	importUsersUC := usecases.NewImportUsersUseCase(userRepo, roleRepo, orgRepo, createUserUC, c.InvitationSender, c.Background)
+	assignUserRoleUC := usecases.NewAssignUserRoleUseCase(userRepo, roleRepo)
 
 	// Assign to container
 	c.User = &UserContainer{
@@ -55,6 +69,7 @@ func (c *AppContainer) InjectUserContainer() {
 		CreateUserUseCase:          createUserUC,
 		ListUsersUseCase:           listUserUC,
 		UpdateUserUseCase:          updateUserUC,
+		PatchUserUseCase:           patchUserUC,
 		UpdateUserStatusUseCase:    updateUserStatusUC,
 		SoftDeleteUserUseCase:      softDeleteUserUC,
 		RestoreUserUseCase:         restoreUserUC,
@@ -62,6 +77,10 @@ func (c *AppContainer) InjectUserContainer() {
 		HardDeleteUserUseCase:      hardDeleteUserUC,
 		BulkRestoreUsersUseCase:    bulkRestoreUsersUC,
 		FindUserByEmailUsecase:     findUserByEmailUC,
+		ExpandUsersUseCase:         expandUsersUC,
+		ExportUsersUseCase:         exportUsersUC,
+		ImportUsersUseCase:         importUsersUC,
+		AssignUserRoleUseCase:      assignUserRoleUC,
 		Repository:                 userRepo,
 	}
 }
@@ -28,6 +28,9 @@ func Bootstrap() *container.AppContainer {
 	appContainer.InjectRoleContainer()
 	appContainer.InjectUserContainer()
 	appContainer.InjectLocationContainer()
+	appContainer.InjectUploadContainer()
+	appContainer.InjectExportContainer()
+	appContainer.InjectTrashContainer()
 
 	appContainer.InjectRBACServices()
 
@@ -0,0 +1,289 @@
+// Package bulk runs a list of operations against records of one resource and reports the
+// outcome of every record. Each resource declares the operations it supports, built on
+// its existing use cases; this package validates the request, applies the operations in
+// order, best effort or in a single transaction, and collects the results.
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+)
+
+// Mode is how failures affect the other items of a request
+type Mode string
+
+const (
+	// ModeBestEffort applies every item independently, failed items do not stop the rest
+	ModeBestEffort Mode = "bestEffort"
+	// ModeTransactional applies every item in one transaction and rolls all of them back
+	// when one fails
+	ModeTransactional Mode = "transactional"
+)
+
+// Item statuses
+const (
+	StatusSucceeded  = "succeeded"
+	StatusFailed     = "failed"
+	StatusRolledBack = "rolledBack" // applied, then undone because another item failed
+	StatusSkipped    = "skipped"    // not attempted because an earlier item failed
+)
+
+// ErrInvalidRequest is returned for requests that cannot run at all: unknown modes or
+// operations, missing or malformed IDs and data, or too many items
+var ErrInvalidRequest = errors.New("invalid bulk request")
+
+// Operation applies one change to a list of records
+type Operation struct {
+	Op   string          `json:"op" example:"status"`
+	IDs  []string        `json:"ids" example:"6835bf49c62fee1db6585e9f,683467a32bf5a05aefe43cb1"`
+	Data json.RawMessage `json:"data,omitempty" swaggertype:"object"`
+}
+
+// Request is the body of a bulk endpoint
+type Request struct {
+	Mode       Mode        `json:"mode,omitempty" enums:"bestEffort,transactional" example:"bestEffort"`
+	Operations []Operation `json:"operations"`
+}
+
+// ItemResult is the outcome of one operation on one record
+type ItemResult struct {
+	Operation int    `json:"operation"` // index of the operation in the request
+	Op        string `json:"op"`
+	ID        string `json:"id"`
+	Status    string `json:"status" enums:"succeeded,failed,rolledBack,skipped"`
+	Code      string `json:"code,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Response reports the outcome of a bulk request, one result per operation and record
+type Response struct {
+	Mode       Mode         `json:"mode"`
+	Total      int          `json:"total"`
+	Succeeded  int          `json:"succeeded"`
+	Failed     int          `json:"failed"`
+	RolledBack bool         `json:"rolledBack"`
+	Results    []ItemResult `json:"results"`
+}
+
+// Apply applies a validated operation to the record with id. Failures should be
+// *middleware.AppError so items report a code; other errors report only their message.
+type Apply func(ctx context.Context, id primitive.ObjectID) error
+
+// Op is an operation a resource supports
+type Op struct {
+	// Action is the permission the operation needs on the resource, e.g. update or delete
+	Action string
+	// Prepare validates the data of an operation and returns how to apply it
+	Prepare func(data json.RawMessage) (Apply, error)
+}
+
+// Ops are the operations a resource supports, by name
+type Ops map[string]Op
+
+// Names returns the sorted names of the operations
+func (o Ops) Names() []string {
+	names := make([]string, 0, len(o))
+	for name := range o {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Restrict returns the operations limited to the records check allows. check returns
+// nil for a record the caller may change and the error to report for the others; it runs
+// before the operation, so a refused record is never touched.
+func (o Ops) Restrict(check Apply) Ops {
+	restricted := make(Ops, len(o))
+	for name, op := range o {
+		prepare := op.Prepare
+		restricted[name] = Op{Action: op.Action, Prepare: func(data json.RawMessage) (Apply, error) {
+			apply, err := prepare(data)
+			if err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, id primitive.ObjectID) error {
+				if err := check(ctx, id); err != nil {
+					return err
+				}
+				return apply(ctx, id)
+			}, nil
+		}}
+	}
+	return restricted
+}
+
+// Actions returns the permissions the operations of req need, in request order. Unknown
+// operations are left to Run to report.
+func (o Ops) Actions(req *Request) []string {
+	var actions []string
+	seen := map[string]bool{}
+	for _, operation := range req.Operations {
+		op, ok := o[operation.Op]
+		if !ok || seen[op.Action] {
+			continue
+		}
+		seen[op.Action] = true
+		actions = append(actions, op.Action)
+	}
+	return actions
+}
+
+// DecodeData decodes the data of an operation into v, rejecting missing data and
+// unknown fields
+func DecodeData(data json.RawMessage, v interface{}) error {
+	if len(data) == 0 || string(data) == "null" {
+		return errors.New("data is required")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid data: %v", err)
+	}
+	return nil
+}
+
+// Transactor runs fn in a transaction, committing when it returns nil. fn may be called
+// again when the transaction hits a transient error.
+type Transactor func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// item is one operation on one record, ready to apply
+type item struct {
+	operation int
+	op        string
+	id        primitive.ObjectID
+	apply     Apply
+}
+
+// plan validates a request and expands it into items, in request order
+func plan(req *Request, ops Ops, maxItems int) ([]item, error) {
+	if len(req.Operations) == 0 {
+		return nil, fmt.Errorf("%w: at least one operation is required", ErrInvalidRequest)
+	}
+
+	total := 0
+	for _, operation := range req.Operations {
+		total += len(operation.IDs)
+	}
+	if total > maxItems {
+		return nil, fmt.Errorf("%w: %d items exceed the limit of %d per request", ErrInvalidRequest, total, maxItems)
+	}
+
+	items := make([]item, 0, total)
+	for i, operation := range req.Operations {
+		op, ok := ops[operation.Op]
+		if !ok {
+			return nil, fmt.Errorf("%w: operations[%d]: unknown op %q, use one of %s", ErrInvalidRequest, i, operation.Op, strings.Join(ops.Names(), ", "))
+		}
+		if len(operation.IDs) == 0 {
+			return nil, fmt.Errorf("%w: operations[%d]: at least one ID is required", ErrInvalidRequest, i)
+		}
+		apply, err := op.Prepare(operation.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: operations[%d]: %v", ErrInvalidRequest, i, err)
+		}
+		for _, hex := range operation.IDs {
+			id, err := primitive.ObjectIDFromHex(hex)
+			if err != nil {
+				return nil, fmt.Errorf("%w: operations[%d]: invalid ID %q", ErrInvalidRequest, i, hex)
+			}
+			items = append(items, item{operation: i, op: operation.Op, id: id, apply: apply})
+		}
+	}
+	return items, nil
+}
+
+// Run validates req against ops and applies its items. Invalid requests return an error
+// wrapping ErrInvalidRequest before anything is applied. In transactional mode the first
+// failing item aborts the transaction; the response then reports which item failed and
+// every other item as rolled back or skipped.
+func Run(ctx context.Context, req *Request, ops Ops, maxItems int, transact Transactor) (*Response, error) {
+	mode := req.Mode
+	if mode == "" {
+		mode = ModeBestEffort
+	}
+	if mode != ModeBestEffort && mode != ModeTransactional {
+		return nil, fmt.Errorf("%w: unknown mode %q, use %s or %s", ErrInvalidRequest, mode, ModeBestEffort, ModeTransactional)
+	}
+
+	items, err := plan(req, ops, maxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{Mode: mode, Total: len(items)}
+	if mode == ModeBestEffort {
+		response.Results = make([]ItemResult, len(items))
+		for i, it := range items {
+			response.Results[i] = result(it, it.apply(ctx, it.id))
+		}
+		response.count()
+		return response, nil
+	}
+
+	var failed error
+	err = transact(ctx, func(ctx context.Context) error {
+		// A retried transaction starts over, so results are rebuilt on every attempt
+		response.Results = make([]ItemResult, len(items))
+		for i, it := range items {
+			if err := it.apply(ctx, it.id); err != nil {
+				response.Results[i] = result(it, err)
+				for j := range items[:i] {
+					response.Results[j].Status = StatusRolledBack
+				}
+				for j := i + 1; j < len(items); j++ {
+					response.Results[j] = ItemResult{Operation: items[j].operation, Op: items[j].op, ID: items[j].id.Hex(), Status: StatusSkipped}
+				}
+				failed = err
+				return err
+			}
+			response.Results[i] = result(it, nil)
+		}
+		failed = nil
+		return nil
+	})
+	if err != nil && failed == nil {
+		// The transaction itself failed, not one of the items
+		return nil, err
+	}
+	response.RolledBack = failed != nil
+	response.count()
+	return response, nil
+}
+
+func result(it item, err error) ItemResult {
+	r := ItemResult{Operation: it.operation, Op: it.op, ID: it.id.Hex(), Status: StatusSucceeded}
+	if err == nil {
+		return r
+	}
+	r.Status = StatusFailed
+	var appErr *middleware.AppError
+	if errors.As(err, &appErr) {
+		r.Code = string(appErr.Code)
+		r.Message = appErr.Message
+	} else {
+		r.Message = err.Error()
+	}
+	return r
+}
+
+func (r *Response) count() {
+	r.Succeeded, r.Failed = 0, 0
+	for _, item := range r.Results {
+		switch item.Status {
+		case StatusSucceeded:
+			r.Succeeded++
+		case StatusFailed:
+			r.Failed++
+		}
+	}
+}
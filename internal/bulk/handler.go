@@ -0,0 +1,87 @@
+package bulk
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+)
+
+// OpsBuilder returns the operations of a resource available to the caller of a request
+type OpsBuilder func(c *gin.Context) Ops
+
+// Handler serves the bulk endpoint mounted under each resource
+type Handler struct {
+	RBACService middleware.RBACService
+	MaxItems    int // most records one request may change
+	Transact    Transactor
+}
+
+// NewHandler creates a new Handler
+func NewHandler(rbac middleware.RBACService, maxItems int, transact Transactor) *Handler {
+	return &Handler{
+		RBACService: rbac,
+		MaxItems:    maxItems,
+		Transact:    transact,
+	}
+}
+
+// Bulk godoc
+//
+//	@Summary		Apply operations to many records
+//	@Description	Apply a list of operations, each to a list of IDs, in request order. In bestEffort mode (the default) every item is applied independently; in transactional mode the first failure rolls back every item. The caller needs the permission of every operation, e.g. update for update and status, delete for delete. Requests may change up to BULK_MAX_ITEMS records. The response has a result per operation and ID.
+//	@Description	Operations: users support update, status, assignRole, delete and restore; organizations update, status, delete and restore; roles update, delete and restore; locations update, addTags, removeTags, delete and restore. update takes the fields of the PATCH endpoint as data, status takes {"status": "..."}, assignRole takes {"roleId": "..."} and addTags and removeTags take {"tags": [...]}.
+//	@Tags			bulk
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		Request	true	"Operations to apply"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=Response}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		403		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/users/bulk [post]
+//	@Router			/organizations/bulk [post]
+//	@Router			/roles/bulk [post]
+//	@Router			/locations/bulk [post]
+func (h *Handler) Bulk(resource string, build OpsBuilder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req Request
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid request body", err, http.StatusBadRequest))
+			return
+		}
+
+		ops := build(c)
+		for _, action := range ops.Actions(&req) {
+			if !h.allowed(c, resource, action) {
+				middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeForbidden, "Insufficient permissions: "+resource+":"+action, nil, http.StatusForbidden))
+				return
+			}
+		}
+
+		response, err := Run(c.Request.Context(), &req, ops, h.MaxItems, h.Transact)
+		switch {
+		case err == nil:
+			c.JSON(http.StatusOK, response)
+		case errors.Is(err, ErrInvalidRequest):
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		case errors.Is(err, database.ErrTransactionsUnsupported):
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Transactional mode is not available on this deployment, use bestEffort", err, http.StatusBadRequest))
+		default:
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to apply bulk operations to "+resource, err, http.StatusInternalServerError))
+		}
+	}
+}
+
+// allowed reports whether the caller may apply the action to the resource, under the
+// scoped RBAC context when the route group set one and the auth guard's otherwise
+func (h *Handler) allowed(c *gin.Context, resource, action string) bool {
+	if scoped, err := middleware.GetScopedRBACContext(c); err == nil {
+		return scoped.HasPermission(resource, action)
+	}
+	authCtx := middleware.GetAuthContext(c.Request.Context())
+	return authCtx != nil && h.RBACService.ValidatePermission(c.Request.Context(), authCtx, resource, action)
+}
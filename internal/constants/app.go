@@ -9,6 +9,11 @@ const (
 	AppHost     = "localhost:" + ServerPort
 	AppBasePath = "/api/v1"
 
+	// Storage drivers
+	StorageDriverS3     = "s3"
+	StorageDriverLocal  = "local"
+	StorageDriverMemory = "memory"
+
 	// Environment names
 	EnvDev     = "development"
 	EnvStaging = "staging"
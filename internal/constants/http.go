@@ -7,6 +7,12 @@ const (
 	HealthCheckRoute = "/health"
 )
 
+// Files served by the local storage driver
+const (
+	FilesBasePath = "/files"
+	ServeFilePath = "/*key"
+)
+
 const (
 	HTTPOk                  = http.StatusOK                  // 200
 	HTTPCreated             = http.StatusCreated             // 201
@@ -40,11 +46,13 @@ const (
 	CreateRolePath       = ""
 	BulkDeleteRolesPath  = "/bulk-delete"
 	BulkRestoreRolesPath = "/bulk-restore"
+	BulkRolesPath        = "/bulk"
 	GetRolePath          = "/:id"
 	UpdateRolePath       = "/:id"
 	DeleteRolePath       = "/:id"
 	RestoreRolePath      = "/:id/restore"
 	HardDeleteRolePath   = "/:id/hard-delete"
+	ExportRolesPath      = "/export"
 )
 
 const (
@@ -53,13 +61,19 @@ const (
 	CreateUserPath       = "/invite"
 	BulkDeleteUsersPath  = "/bulk-delete"
 	BulkRestoreUsersPath = "/bulk-restore"
+	BulkUsersPath        = "/bulk"
 	GetUserPath          = "/:id"
 	UpdateUserPath       = "/:id"
 	DeleteUserPath       = "/:id"
 	UpdateUserStatusPath = "/:id/status"
 	UploadUserAvatarPath = "/:id/profile-photo"
-	RestoreUserPath      = "/:id/restore"
-	HardDeleteUserPath   = "/:id/hard-delete"
+
+	UserProfilePhotoUploadsPath       = "/:id/profile-photo/uploads"
+	ConfirmUserProfilePhotoUploadPath = "/:id/profile-photo/uploads/:uploadId/confirm"
+	RestoreUserPath                   = "/:id/restore"
+	HardDeleteUserPath                = "/:id/hard-delete"
+	ExportUsersPath                   = "/export"
+	ImportUsersPath                   = "/import"
 )
 
 const (
@@ -69,14 +83,39 @@ const (
 
 	BulkDeleteOrganizationsPath  = "/bulk-delete"
 	BulkRestoreOrganizationsPath = "/bulk-restore"
+	BulkOrganizationsPath        = "/bulk"
+	ExportOrganizationsPath      = "/export"
 
 	GetOrganizationPath        = "/:id"
 	UpdateOrganizationPath     = "/:id"
 	DeleteOrganizationPath     = "/:id"
 	UpdateStatusPath           = "/:id/status"
 	UploadOrgLogoPath          = "/:id/logo"
+	OrgLogoUploadsPath         = "/:id/logo/uploads"
+	ConfirmOrgLogoUploadPath   = "/:id/logo/uploads/:uploadId/confirm"
 	RestoreOrganizationPath    = "/:id/restore"
 	HardDeleteOrganizationPath = "/:id/hard-delete"
+
+	OrganizationSettingsPath     = "/:id/settings"
+	OrganizationFeatureFlagsPath = "/:id/feature-flags"
+)
+
+const (
+	FeatureFlagBasePath          = "/feature-flags"
+	ListFeatureFlagOverridesPath = "/overrides"
+	FeatureFlagOverridePath      = "/overrides/:flag"
+)
+
+const (
+	ExportBasePath   = "/exports"
+	GetExportJobPath = "/:id"
+)
+
+const (
+	TrashBasePath        = "/trash"
+	ListTrashPath        = ""
+	RestoreTrashItemPath = "/:resource/:id/restore"
+	PurgeTrashItemPath   = "/:resource/:id"
 )
 
 const (
@@ -86,11 +125,29 @@ const (
 
 	BulkDeleteLocationsPath  = "/bulk-delete"
 	BulkRestoreLocationsPath = "/bulk-restore"
+	BulkLocationsPath        = "/bulk"
+
+	GetLocationPath                = "/:id"
+	UpdateLocationPath             = "/:id"
+	DeleteLocationPath             = "/:id"
+	UploadLocationMediaPath        = "/:id/media"
+	LocationMediaUploadsPath       = "/:id/media/uploads"
+	ConfirmLocationMediaUploadPath = "/:id/media/uploads/:uploadId/confirm"
+	ReorderLocationMediaPath       = "/:id/media/order"
+	LocationMediaItemPath          = "/:id/media/:mediaId"
+	LocationCoverMediaPath         = "/:id/media/:mediaId/cover"
+	RestoreLocationPath            = "/:id/restore"
+	HardDeleteLocationPath         = "/:id/hard-delete"
+
+	SearchLocationsPath       = "/search"
+	AutocompleteLocationsPath = "/autocomplete"
+	LocationSelectionPath     = "/:id/select"
+
+	ImportLocationsPath   = "/import"
+	LocationImportJobPath = "/import/:jobId"
+	ExportLocationsPath   = "/export"
 
-	GetLocationPath         = "/:id"
-	UpdateLocationPath      = "/:id"
-	DeleteLocationPath      = "/:id"
-	UploadLocationMediaPath = "/:id/media"
-	RestoreLocationPath     = "/:id/restore"
-	HardDeleteLocationPath  = "/:id/hard-delete"
+	LocationChildrenPath  = "/:id/children"
+	LocationAncestorsPath = "/:id/ancestors"
+	MoveLocationPath      = "/:id/parent"
 )
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	locModel "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/model"
+)
+
+// FileKeyMigration rewrites file URLs saved before records stored storage keys. Expiring
+// presigned URLs and other URLs of the configured storage become their key; external
+// URLs are left alone. Running it again is a no-op.
+type FileKeyMigration struct {
+	db    *mongo.Database
+	files services.FileService
+}
+
+// FileKeyMigrationResult counts the documents rewritten, or that would be rewritten, per collection
+type FileKeyMigrationResult map[string]int
+
+// NewFileKeyMigration creates a migration over db for URLs served by files
+func NewFileKeyMigration(db *mongo.Database, files services.FileService) *FileKeyMigration {
+	return &FileKeyMigration{db: db, files: files}
+}
+
+// Run migrates organizations, users and locations. With dryRun nothing is written.
+func (m *FileKeyMigration) Run(ctx context.Context, dryRun bool) (FileKeyMigrationResult, error) {
+	result := FileKeyMigrationResult{}
+
+	steps := []struct {
+		collection string
+		migrate    func(context.Context, bool) (int, error)
+	}{
+		{"organizations", m.migrateImageFields("organizations", "logo", "logoVariants")},
+		{"users", m.migrateImageFields("users", "profilePhotoUrl", "profilePhotoVariants")},
+		{"locations", m.migrateLocations},
+	}
+	for _, step := range steps {
+		count, err := step.migrate(ctx, dryRun)
+		if err != nil {
+			return result, fmt.Errorf("failed to migrate %s: %w", step.collection, err)
+		}
+		result[step.collection] = count
+	}
+	return result, nil
+}
+
+// migrateImageFields migrates a single image field and its variants map
+func (m *FileKeyMigration) migrateImageFields(collection, urlField, variantsField string) func(context.Context, bool) (int, error) {
+	return func(ctx context.Context, dryRun bool) (int, error) {
+		filter := bson.M{"$or": bson.A{
+			bson.M{urlField: bson.M{"$regex": "://"}},
+			bson.M{variantsField: bson.M{"$type": "object"}},
+		}}
+		cursor, err := m.db.Collection(collection).Find(ctx, filter)
+		if err != nil {
+			return 0, err
+		}
+		defer cursor.Close(ctx)
+
+		count := 0
+		for cursor.Next(ctx) {
+			doc := cursor.Current
+
+			set := bson.M{}
+			if ref, ok := doc.Lookup(urlField).StringValueOK(); ok {
+				if key := m.fileKey(ref); key != ref {
+					set[urlField] = key
+				}
+			}
+			if raw, ok := doc.Lookup(variantsField).DocumentOK(); ok {
+				var variants models.ImageVariants
+				if err := bson.Unmarshal(raw, &variants); err != nil {
+					return count, err
+				}
+				if keys := variants.Map(m.fileKey); !maps.Equal(keys, variants) {
+					set[variantsField] = keys
+				}
+			}
+			if len(set) == 0 {
+				continue
+			}
+
+			count++
+			if dryRun {
+				continue
+			}
+			if _, err := m.db.Collection(collection).UpdateByID(ctx, doc.Lookup("_id"), bson.M{"$set": set}); err != nil {
+				return count, err
+			}
+		}
+		return count, cursor.Err()
+	}
+}
+
+// migrateLocations migrates media items. Locations saved before media items existed get
+// their items materialised, so legacy item IDs derived from the old URLs are kept.
+func (m *FileKeyMigration) migrateLocations(ctx context.Context, dryRun bool) (int, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"media": nil},
+		bson.M{"media.url": bson.M{"$regex": "://"}},
+		bson.M{"media.variants": bson.M{"$type": "object"}},
+	}}
+	cursor, err := m.db.Collection("locations").Find(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	count := 0
+	for cursor.Next(ctx) {
+		var doc locModel.LocationModel
+		if err := cursor.Decode(&doc); err != nil {
+			return count, err
+		}
+
+		location := doc.ToEntity()
+		changed := doc.Media == nil && len(location.Media) > 0
+		for i := range location.Media {
+			item := &location.Media[i]
+			key := m.fileKey(item.URL)
+			variants := item.Variants.Map(m.fileKey)
+			if key != item.URL || !maps.Equal(variants, item.Variants) {
+				item.URL, item.Variants = key, variants
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		location.NormalizeMedia()
+
+		count++
+		if dryRun {
+			continue
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"media":     locModel.NewMediaItemModels(location.Media),
+				"mediaUrls": bson.M{"photos": location.MediaURLs.Photos, "videos": location.MediaURLs.Videos},
+			},
+		}
+		if _, err := m.db.Collection("locations").UpdateByID(ctx, doc.ID, update); err != nil {
+			return count, err
+		}
+	}
+	return count, cursor.Err()
+}
+
+func (m *FileKeyMigration) fileKey(ref string) string {
+	return services.FileKey(m.files, ref)
+}
@@ -0,0 +1,289 @@
+package database
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// EstimatedCountCap is where CountEstimated stops counting
+const EstimatedCountCap = 10000
+
+// DefaultSort is the list order when none is requested: newest first
+var DefaultSort = []models.SortField{{Field: "createdAt", Descending: true}}
+
+// cursorSigningKey signs cursors so clients cannot forge the values they hold. Without
+// SetCursorSigningKey it is random, and cursors do not survive a restart.
+var cursorSigningKey = randomKey()
+
+// SetCursorSigningKey sets the key cursors are signed with, which must be the same on
+// every instance serving the same lists
+func SetCursorSigningKey(key []byte) {
+	cursorSigningKey = key
+}
+
+func randomKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// pageCursor is the decoded form of a cursor: the sort it was issued for and the sort
+// key values of the document it points at
+type pageCursor struct {
+	Sort   string          `bson:"s"`
+	Values []bson.RawValue `bson:"v"`
+}
+
+// FindPage loads the page of documents matching filter selected by opts into results,
+// which must point to a slice. Pages are read by cursor (keyset) when opts has one, so
+// they stay consistent while documents are inserted and deep pages cost the same as the
+// first; otherwise by page number. _id is added to the sort to make the order total.
+func FindPage(ctx context.Context, collection *mongo.Collection, filter interface{}, opts models.ListOptions, results interface{}) (*models.PageInfo, error) {
+	sort := sortKeys(opts.Sort)
+	signature := sortSignature(sort)
+	info := &models.PageInfo{Limit: opts.Limit}
+
+	query := filter
+	backward := opts.Before != "" && opts.After == ""
+	findOpts := options.Find().SetLimit(int64(opts.Limit) + 1)
+	if opts.UsesCursor() {
+		encoded := opts.After
+		if backward {
+			encoded = opts.Before
+		}
+		cursor, err := decodeCursor(encoded, signature, len(sort))
+		if err != nil {
+			return nil, err
+		}
+		query = bson.M{"$and": bson.A{filter, keysetFilter(sort, cursor.Values, backward)}}
+	} else {
+		info.Page = opts.Page
+		findOpts.SetSkip(int64((opts.Page - 1) * opts.Limit))
+	}
+	findOpts.SetSort(sortDocument(sort, backward))
+
+	found, err := collection.Find(ctx, query, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer found.Close(ctx)
+
+	var docs []bson.Raw
+	if err := found.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	more := len(docs) > opts.Limit
+	if more {
+		docs = docs[:opts.Limit]
+	}
+	if backward {
+		for i, j := 0, len(docs)-1; i < j; i, j = i+1, j-1 {
+			docs[i], docs[j] = docs[j], docs[i]
+		}
+		info.HasPrev, info.HasNext = more, true
+	} else {
+		info.HasNext = more
+		info.HasPrev = opts.After != "" || opts.Page > 1
+	}
+	if len(docs) > 0 {
+		if info.HasNext {
+			info.NextCursor = encodeCursor(signature, sort, docs[len(docs)-1])
+		}
+		if info.HasPrev {
+			info.PrevCursor = encodeCursor(signature, sort, docs[0])
+		}
+	}
+
+	if err := decodeAll(docs, results); err != nil {
+		return nil, err
+	}
+
+	count := opts.Count
+	if count == "" {
+		count = models.CountExact
+		if opts.UsesCursor() {
+			count = models.CountNone
+		}
+	}
+	switch count {
+	case models.CountExact:
+		total, err := collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		info.Total = &total
+	case models.CountEstimated:
+		total, err := collection.CountDocuments(ctx, filter, options.Count().SetLimit(EstimatedCountCap))
+		if err != nil {
+			return nil, err
+		}
+		info.Total = &total
+		info.TotalEstimated = total >= EstimatedCountCap
+	}
+	return info, nil
+}
+
+// sortKeys returns the requested sort, or DefaultSort, ending with _id
+func sortKeys(requested []models.SortField) []models.SortField {
+	if len(requested) == 0 {
+		requested = DefaultSort
+	}
+	sort := make([]models.SortField, 0, len(requested)+1)
+	for _, field := range requested {
+		sort = append(sort, field)
+		if field.Field == "_id" {
+			return sort
+		}
+	}
+	return append(sort, models.SortField{Field: "_id", Descending: requested[len(requested)-1].Descending})
+}
+
+func sortSignature(sort []models.SortField) string {
+	parts := make([]string, len(sort))
+	for i, field := range sort {
+		parts[i] = field.Field
+		if field.Descending {
+			parts[i] = "-" + field.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// sortDocument builds the $sort for sort, reversed when reading backwards
+func sortDocument(sort []models.SortField, backward bool) bson.D {
+	doc := make(bson.D, len(sort))
+	for i, field := range sort {
+		direction := 1
+		if field.Descending != backward {
+			direction = -1
+		}
+		doc[i] = bson.E{Key: field.Field, Value: direction}
+	}
+	return doc
+}
+
+// keysetFilter matches the documents after the cursor values in sort order, or before
+// them when reading backwards: (a > x) or (a = x and b > y) and so on. Null and missing
+// values sort before all others, which $gt and $lt do not match, so they get their own
+// branches.
+func keysetFilter(sort []models.SortField, values []bson.RawValue, backward bool) bson.M {
+	clauses := make(bson.A, 0, len(sort))
+	for i, field := range sort {
+		clause := bson.M{}
+		for j := 0; j < i; j++ {
+			clause[sort[j].Field] = values[j]
+		}
+		value := values[i]
+		isNull := value.Type == bson.TypeNull
+		if field.Descending != backward {
+			// Nothing sorts before null; everything else is followed by the nulls
+			if isNull {
+				continue
+			}
+			clause["$or"] = bson.A{
+				bson.M{field.Field: bson.M{"$lt": value}},
+				bson.M{field.Field: nil},
+			}
+		} else if isNull {
+			clause[field.Field] = bson.M{"$ne": nil}
+		} else {
+			clause[field.Field] = bson.M{"$gt": value}
+		}
+		clauses = append(clauses, clause)
+	}
+	if len(clauses) == 0 {
+		// Only reachable with a null _id, which no document has
+		return bson.M{"_id": bson.M{"$exists": false}}
+	}
+	return bson.M{"$or": clauses}
+}
+
+func encodeCursor(signature string, sort []models.SortField, doc bson.Raw) string {
+	cursor := pageCursor{Sort: signature, Values: make([]bson.RawValue, len(sort))}
+	for i, field := range sort {
+		value, err := doc.LookupErr(strings.Split(field.Field, ".")...)
+		if err != nil {
+			value = bson.RawValue{Type: bson.TypeNull}
+		}
+		cursor.Values[i] = value
+	}
+	raw, err := bson.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw) + "." + base64.RawURLEncoding.EncodeToString(signCursor(raw))
+}
+
+func decodeCursor(encoded, signature string, keys int) (*pageCursor, error) {
+	payload, mac, found := strings.Cut(encoded, ".")
+	if !found {
+		return nil, models.ErrInvalidCursor
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+	sum, err := base64.RawURLEncoding.DecodeString(mac)
+	if err != nil || !hmac.Equal(sum, signCursor(raw)) {
+		return nil, models.ErrInvalidCursor
+	}
+	var cursor pageCursor
+	if err := bson.Unmarshal(raw, &cursor); err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+	if cursor.Sort != signature || len(cursor.Values) != keys {
+		return nil, fmt.Errorf("%w: it was issued for a different sort order", models.ErrInvalidCursor)
+	}
+	for _, value := range cursor.Values {
+		if !isScalar(value) {
+			return nil, models.ErrInvalidCursor
+		}
+	}
+	return &cursor, nil
+}
+
+func signCursor(raw []byte) []byte {
+	mac := hmac.New(sha256.New, cursorSigningKey)
+	mac.Write(raw)
+	return mac.Sum(nil)
+}
+
+// isScalar reports whether value can be matched by equality in a query. A document
+// would be read as query operators and a regular expression as a pattern.
+func isScalar(value bson.RawValue) bool {
+	switch value.Type {
+	case bson.TypeDouble, bson.TypeString, bson.TypeObjectID, bson.TypeBoolean,
+		bson.TypeDateTime, bson.TypeNull, bson.TypeInt32, bson.TypeTimestamp,
+		bson.TypeInt64, bson.TypeDecimal128:
+		return true
+	}
+	return false
+}
+
+// decodeAll decodes docs into the slice results points to
+func decodeAll(docs []bson.Raw, results interface{}) error {
+	slice := reflect.ValueOf(results).Elem()
+	decoded := reflect.MakeSlice(slice.Type(), len(docs), len(docs))
+	for i, doc := range docs {
+		if err := bson.Unmarshal(doc, decoded.Index(i).Addr().Interface()); err != nil {
+			return err
+		}
+	}
+	slice.Set(decoded)
+	return nil
+}
@@ -0,0 +1,206 @@
+package database
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+func mustRawValue(t *testing.T, value interface{}) bson.RawValue {
+	t.Helper()
+	doc, err := bson.Marshal(bson.M{"v": value})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bson.Raw(doc).Lookup("v")
+}
+
+func TestSortKeysEndsWithID(t *testing.T) {
+	got := sortKeys([]models.SortField{{Field: "name"}, {Field: "createdAt", Descending: true}})
+	want := []models.SortField{{Field: "name"}, {Field: "createdAt", Descending: true}, {Field: "_id", Descending: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sortKeys = %v, want %v", got, want)
+	}
+
+	got = sortKeys(nil)
+	want = []models.SortField{{Field: "createdAt", Descending: true}, {Field: "_id", Descending: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sortKeys(nil) = %v, want %v", got, want)
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	sort := sortKeys([]models.SortField{{Field: "address.city"}})
+	signature := sortSignature(sort)
+	id := primitive.NewObjectID()
+	doc, err := bson.Marshal(bson.M{"_id": id, "address": bson.M{"city": "Goa"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cursor, err := decodeCursor(encodeCursor(signature, sort, doc), signature, len(sort))
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if city := cursor.Values[0].StringValue(); city != "Goa" {
+		t.Errorf("city = %q, want Goa", city)
+	}
+	if got := cursor.Values[1].ObjectID(); got != id {
+		t.Errorf("_id = %s, want %s", got.Hex(), id.Hex())
+	}
+}
+
+func TestCursorMissingFieldIsNull(t *testing.T) {
+	sort := sortKeys([]models.SortField{{Field: "name"}})
+	signature := sortSignature(sort)
+	doc, err := bson.Marshal(bson.M{"_id": primitive.NewObjectID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cursor, err := decodeCursor(encodeCursor(signature, sort, doc), signature, len(sort))
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if cursor.Values[0].Type != bson.TypeNull {
+		t.Errorf("missing field encoded as %s, want null", cursor.Values[0].Type)
+	}
+}
+
+func TestDecodeCursorRejectsInvalid(t *testing.T) {
+	sort := sortKeys(nil)
+	signature := sortSignature(sort)
+	doc, err := bson.Marshal(bson.M{"_id": primitive.NewObjectID(), "createdAt": time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := encodeCursor(signature, sort, doc)
+	payload, mac, _ := strings.Cut(valid, ".")
+
+	forged, err := bson.Marshal(pageCursor{Sort: signature, Values: []bson.RawValue{
+		mustRawValue(t, time.Now()),
+		mustRawValue(t, primitive.NewObjectID()),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"garbage":        "not a cursor",
+		"unsigned":       payload,
+		"bad signature":  payload + "." + base64.RawURLEncoding.EncodeToString([]byte("signature")),
+		"forged payload": base64.RawURLEncoding.EncodeToString(forged) + "." + mac,
+	}
+	for name, encoded := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := decodeCursor(encoded, signature, len(sort)); !errors.Is(err, models.ErrInvalidCursor) {
+				t.Errorf("decodeCursor = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsOtherSort(t *testing.T) {
+	sort := sortKeys(nil)
+	doc, err := bson.Marshal(bson.M{"_id": primitive.NewObjectID(), "createdAt": time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := encodeCursor(sortSignature(sort), sort, doc)
+
+	other := sortKeys([]models.SortField{{Field: "createdAt"}})
+	if _, err := decodeCursor(encoded, sortSignature(other), len(other)); !errors.Is(err, models.ErrInvalidCursor) {
+		t.Errorf("decodeCursor = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestDecodeCursorRejectsNonScalarValues(t *testing.T) {
+	sort := sortKeys([]models.SortField{{Field: "name"}})
+	signature := sortSignature(sort)
+
+	values := map[string]interface{}{
+		"document": bson.M{"$ne": nil},
+		"array":    bson.A{"a", "b"},
+		"regex":    primitive.Regex{Pattern: ".*"},
+	}
+	for name, value := range values {
+		t.Run(name, func(t *testing.T) {
+			doc, err := bson.Marshal(bson.M{"_id": primitive.NewObjectID(), "name": value})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// A correctly signed cursor, as if issued for a document holding value
+			encoded := encodeCursor(signature, sort, doc)
+			if _, err := decodeCursor(encoded, signature, len(sort)); !errors.Is(err, models.ErrInvalidCursor) {
+				t.Errorf("decodeCursor = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func TestKeysetFilter(t *testing.T) {
+	id := primitive.NewObjectID()
+	idValue := mustRawValue(t, id)
+	name := mustRawValue(t, "Goa")
+	null := bson.RawValue{Type: bson.TypeNull}
+
+	tests := []struct {
+		name     string
+		sort     []models.SortField
+		values   []bson.RawValue
+		backward bool
+		want     bson.M
+	}{
+		{
+			name:   "ascending",
+			sort:   []models.SortField{{Field: "name"}, {Field: "_id"}},
+			values: []bson.RawValue{name, idValue},
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$gt": name}},
+				bson.M{"name": name, "_id": bson.M{"$gt": idValue}},
+			}},
+		},
+		{
+			name:   "descending includes nulls",
+			sort:   []models.SortField{{Field: "name", Descending: true}, {Field: "_id", Descending: true}},
+			values: []bson.RawValue{name, idValue},
+			want: bson.M{"$or": bson.A{
+				bson.M{"$or": bson.A{bson.M{"name": bson.M{"$lt": name}}, bson.M{"name": nil}}},
+				bson.M{"name": name, "$or": bson.A{bson.M{"_id": bson.M{"$lt": idValue}}, bson.M{"_id": nil}}},
+			}},
+		},
+		{
+			name:   "ascending after null",
+			sort:   []models.SortField{{Field: "name"}, {Field: "_id"}},
+			values: []bson.RawValue{null, idValue},
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$ne": nil}},
+				bson.M{"name": null, "_id": bson.M{"$gt": idValue}},
+			}},
+		},
+		{
+			name:     "backward before null",
+			sort:     []models.SortField{{Field: "name"}, {Field: "_id"}},
+			values:   []bson.RawValue{null, idValue},
+			backward: true,
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": null, "$or": bson.A{bson.M{"_id": bson.M{"$lt": idValue}}, bson.M{"_id": nil}}},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keysetFilter(tt.sort, tt.values, tt.backward); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keysetFilter =\n%v\nwant\n%v", got, tt.want)
+			}
+		})
+	}
+}
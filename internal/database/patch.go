@@ -0,0 +1,94 @@
+package database
+
+import (
+	"bytes"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// ChangedFields compares the BSON form of two versions of a document. It returns the
+// dotted paths whose values differ with their new values, and the paths that were
+// removed. Embedded documents are compared field by field; arrays are replaced whole.
+// The version field is left to PatchDocument.
+func ChangedFields(original, updated interface{}) (bson.M, bson.M, error) {
+	before, err := bson.Marshal(original)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err := bson.Marshal(updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	set, unset := bson.M{}, bson.M{}
+	if err := diffDocuments("", before, after, set, unset); err != nil {
+		return nil, nil, err
+	}
+	delete(set, VersionField)
+	delete(unset, VersionField)
+	return set, unset, nil
+}
+
+func diffDocuments(prefix string, before, after bson.Raw, set, unset bson.M) error {
+	afterElements, err := after.Elements()
+	if err != nil {
+		return err
+	}
+	for _, element := range afterElements {
+		key := element.Key()
+		path := prefix + key
+		value := element.Value()
+
+		old, err := before.LookupErr(key)
+		if err != nil {
+			set[path] = value
+			continue
+		}
+		if old.Type == bson.TypeEmbeddedDocument && value.Type == bson.TypeEmbeddedDocument {
+			if err := diffDocuments(path+".", old.Document(), value.Document(), set, unset); err != nil {
+				return err
+			}
+			continue
+		}
+		if old.Type != value.Type || !bytes.Equal(old.Value, value.Value) {
+			set[path] = value
+		}
+	}
+
+	beforeElements, err := before.Elements()
+	if err != nil {
+		return err
+	}
+	for _, element := range beforeElements {
+		if _, err := after.LookupErr(element.Key()); err != nil {
+			unset[prefix+element.Key()] = ""
+		}
+	}
+	return nil
+}
+
+// PatchDocument applies set and unset from ChangedFields to the document matched by
+// filter, normally a VersionedFilter, and increments its version. It returns
+// models.ErrVersionConflict when no document matched.
+func PatchDocument(ctx context.Context, collection *mongo.Collection, filter bson.M, set, unset bson.M) error {
+	update := bson.M{"$inc": IncrementVersion()}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrVersionConflict
+	}
+	return nil
+}
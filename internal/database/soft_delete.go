@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// Soft delete fields shared by every collection
+const (
+	DeletedAtField = "deletedAt"
+	DeletedByField = "deletedBy" // ID of the user who deleted the document, unset for system deletes
+)
+
+// SoftDeleteUpdate marks documents deleted now, by the user ctx carries
+func SoftDeleteUpdate(ctx context.Context) bson.M {
+	now := time.Now()
+	set := bson.M{DeletedAtField: now, "updatedAt": now}
+	if actor := models.ActorFromContext(ctx); actor != "" {
+		set[DeletedByField] = actor
+	}
+	return bson.M{"$set": set, "$inc": IncrementVersion()}
+}
+
+// RestoreUpdate clears the deletion of documents
+func RestoreUpdate() bson.M {
+	return bson.M{
+		"$set":   bson.M{DeletedAtField: nil, "updatedAt": time.Now()},
+		"$unset": bson.M{DeletedByField: ""},
+		"$inc":   IncrementVersion(),
+	}
+}
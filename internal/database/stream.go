@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// StreamBatchSize is how many documents Stream fetches per round trip
+const StreamBatchSize = 500
+
+// Stream calls fn with a cursor positioned on each document matching filter, in the
+// order of sort or DefaultSort, fetching StreamBatchSize documents at a time. Only the
+// current batch is held in memory however many documents match.
+func Stream(ctx context.Context, collection *mongo.Collection, filter interface{}, sort []models.SortField, fn func(cursor *mongo.Cursor) error) error {
+	opts := options.Find().
+		SetSort(sortDocument(sortKeys(sort), false)).
+		SetBatchSize(StreamBatchSize)
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		if err := fn(cursor); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
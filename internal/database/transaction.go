@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrTransactionsUnsupported is returned by WithTransaction on a standalone server, which
+// cannot run multi-document transactions
+var ErrTransactionsUnsupported = errors.New("the database does not support transactions, it must be a replica set or sharded cluster")
+
+// Transactor runs fn in a transaction, committing when it returns nil. fn may be called
+// again when the transaction hits a transient error.
+type Transactor func(ctx context.Context, fn func(ctx context.Context) error) error
+
+// WithTransaction runs fn in a transaction on client, committing when fn returns nil and
+// aborting otherwise. Repositories join the transaction through the context fn receives.
+// fn is run again when the transaction hits a transient error.
+func WithTransaction(ctx context.Context, client *mongo.Client, fn func(ctx context.Context) error) error {
+	supported, err := supportsTransactions(ctx, client)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return ErrTransactionsUnsupported
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
+
+// supportsTransactions reports whether the deployment is a replica set member or mongos
+func supportsTransactions(ctx context.Context, client *mongo.Client) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return false, err
+	}
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VersionField is the optimistic concurrency counter stored on every versioned document
+const VersionField = "version"
+
+// VersionedFilter matches the document with id only while it is still at version.
+// Documents saved before versioning have no version field and count as version 0.
+func VersionedFilter(id primitive.ObjectID, version int64) bson.M {
+	if version == 0 {
+		return bson.M{"_id": id, VersionField: bson.M{"$in": bson.A{0, nil}}}
+	}
+	return bson.M{"_id": id, VersionField: version}
+}
+
+// IncrementVersion is used as the $inc of updates that change a document outside of
+// its versioned Update, so clients holding the old version see it changed
+func IncrementVersion() bson.M {
+	return bson.M{VersionField: 1}
+}
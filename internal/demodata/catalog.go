@@ -0,0 +1,325 @@
+package demodata
+
+// Terrains describe what a city is known for and decide which attractions and tags it gets
+const (
+	terrainHill       = "hill station"
+	terrainBeach      = "beach"
+	terrainHeritage   = "heritage"
+	terrainPilgrimage = "pilgrimage"
+	terrainWildlife   = "wildlife"
+	terrainDesert     = "desert"
+	terrainBackwaters = "backwaters"
+	terrainAdventure  = "adventure"
+	terrainMetro      = "metro"
+)
+
+type stateInfo struct {
+	Name          string
+	PincodePrefix string // first two digits of the state's PIN codes
+	GSTCode       string // state code GSTINs start with
+	Cities        []cityInfo
+}
+
+type cityInfo struct {
+	Name     string
+	Lat, Lng float64
+	Aliases  []string
+	Terrains []string
+	Airport  bool
+}
+
+// states is a sample of Indian tourism destinations with approximate city-centre coordinates
+var states = []stateInfo{
+	{"West Bengal", "70", "19", []cityInfo{
+		{"Kolkata", 22.5726, 88.3639, []string{"Calcutta", "City of Joy"}, []string{terrainMetro, terrainHeritage}, true},
+		{"Darjeeling", 27.0410, 88.2663, []string{"Darjiling", "Queen of the Hills"}, []string{terrainHill}, false},
+		{"Siliguri", 26.7271, 88.3953, nil, []string{terrainMetro}, true},
+		{"Kalimpong", 27.0594, 88.4695, nil, []string{terrainHill}, false},
+		{"Digha", 21.6266, 87.5074, nil, []string{terrainBeach}, false},
+		{"Gosaba", 22.1650, 88.8070, []string{"Sundarbans"}, []string{terrainWildlife}, false},
+	}},
+	{"Sikkim", "73", "11", []cityInfo{
+		{"Gangtok", 27.3389, 88.6065, nil, []string{terrainHill}, false},
+		{"Pelling", 27.3000, 88.2370, nil, []string{terrainHill}, false},
+		{"Lachung", 27.6890, 88.7440, nil, []string{terrainHill, terrainAdventure}, false},
+		{"Namchi", 27.1650, 88.3640, nil, []string{terrainHill, terrainPilgrimage}, false},
+	}},
+	{"Himachal Pradesh", "17", "02", []cityInfo{
+		{"Shimla", 31.1048, 77.1734, []string{"Simla"}, []string{terrainHill, terrainHeritage}, true},
+		{"Manali", 32.2432, 77.1892, nil, []string{terrainHill, terrainAdventure}, false},
+		{"Dharamshala", 32.2190, 76.3234, []string{"Dharamsala", "McLeod Ganj"}, []string{terrainHill, terrainPilgrimage}, true},
+		{"Kasol", 32.0100, 77.3150, nil, []string{terrainHill, terrainAdventure}, false},
+		{"Dalhousie", 32.5387, 75.9710, nil, []string{terrainHill}, false},
+		{"Kaza", 32.2276, 78.0710, []string{"Spiti"}, []string{terrainHill, terrainAdventure}, false},
+	}},
+	{"Uttarakhand", "24", "05", []cityInfo{
+		{"Rishikesh", 30.0869, 78.2676, []string{"Yoga Capital of the World"}, []string{terrainPilgrimage, terrainAdventure}, false},
+		{"Haridwar", 29.9457, 78.1642, []string{"Hardwar"}, []string{terrainPilgrimage}, false},
+		{"Nainital", 29.3919, 79.4542, []string{"Lake District of India"}, []string{terrainHill}, false},
+		{"Mussoorie", 30.4598, 78.0644, []string{"Queen of the Hills"}, []string{terrainHill}, false},
+		{"Dehradun", 30.3165, 78.0322, nil, []string{terrainMetro}, true},
+		{"Auli", 30.5280, 79.5670, nil, []string{terrainHill, terrainAdventure}, false},
+	}},
+	{"Jammu and Kashmir", "19", "01", []cityInfo{
+		{"Srinagar", 34.0837, 74.7973, nil, []string{terrainHill, terrainHeritage}, true},
+		{"Gulmarg", 34.0484, 74.3805, nil, []string{terrainHill, terrainAdventure}, false},
+		{"Pahalgam", 34.0161, 75.3150, nil, []string{terrainHill}, false},
+		{"Sonamarg", 34.3030, 75.2930, nil, []string{terrainHill, terrainAdventure}, false},
+	}},
+	{"Ladakh", "19", "38", []cityInfo{
+		{"Leh", 34.1526, 77.5771, nil, []string{terrainHill, terrainAdventure}, true},
+		{"Kargil", 34.5539, 76.1349, nil, []string{terrainHill}, false},
+		{"Diskit", 34.5430, 77.5590, []string{"Nubra Valley"}, []string{terrainDesert, terrainAdventure}, false},
+	}},
+	{"Rajasthan", "30", "08", []cityInfo{
+		{"Jaipur", 26.9124, 75.7873, []string{"Pink City"}, []string{terrainHeritage, terrainMetro}, true},
+		{"Udaipur", 24.5854, 73.7125, []string{"City of Lakes"}, []string{terrainHeritage}, true},
+		{"Jodhpur", 26.2389, 73.0243, []string{"Blue City", "Sun City"}, []string{terrainHeritage, terrainDesert}, true},
+		{"Jaisalmer", 26.9157, 70.9083, []string{"Golden City"}, []string{terrainDesert, terrainHeritage}, true},
+		{"Pushkar", 26.4897, 74.5511, nil, []string{terrainPilgrimage, terrainDesert}, false},
+		{"Mount Abu", 24.5926, 72.7156, nil, []string{terrainHill}, false},
+		{"Bikaner", 28.0229, 73.3119, nil, []string{terrainDesert, terrainHeritage}, false},
+	}},
+	{"Uttar Pradesh", "28", "09", []cityInfo{
+		{"Agra", 27.1767, 78.0081, nil, []string{terrainHeritage}, true},
+		{"Varanasi", 25.3176, 82.9739, []string{"Banaras", "Kashi"}, []string{terrainPilgrimage, terrainHeritage}, true},
+		{"Lucknow", 26.8467, 80.9462, []string{"City of Nawabs"}, []string{terrainHeritage, terrainMetro}, true},
+		{"Mathura", 27.4924, 77.6737, nil, []string{terrainPilgrimage}, false},
+		{"Prayagraj", 25.4358, 81.8463, []string{"Allahabad"}, []string{terrainPilgrimage}, false},
+		{"Ayodhya", 26.7922, 82.1998, nil, []string{terrainPilgrimage}, false},
+	}},
+	{"Delhi", "11", "07", []cityInfo{
+		{"New Delhi", 28.6139, 77.2090, []string{"Dilli"}, []string{terrainMetro, terrainHeritage}, true},
+	}},
+	{"Punjab", "14", "03", []cityInfo{
+		{"Amritsar", 31.6340, 74.8723, nil, []string{terrainPilgrimage, terrainHeritage}, true},
+		{"Patiala", 30.3398, 76.3869, nil, []string{terrainHeritage}, false},
+	}},
+	{"Goa", "40", "30", []cityInfo{
+		{"Panaji", 15.4909, 73.8278, []string{"Panjim"}, []string{terrainBeach, terrainHeritage}, true},
+		{"Calangute", 15.5440, 73.7550, nil, []string{terrainBeach}, false},
+		{"Margao", 15.2832, 73.9862, []string{"Madgaon"}, []string{terrainBeach}, false},
+		{"Palolem", 15.0100, 74.0230, nil, []string{terrainBeach}, false},
+	}},
+	{"Maharashtra", "41", "27", []cityInfo{
+		{"Mumbai", 19.0760, 72.8777, []string{"Bombay"}, []string{terrainMetro, terrainBeach}, true},
+		{"Pune", 18.5204, 73.8567, []string{"Poona"}, []string{terrainMetro, terrainHeritage}, true},
+		{"Aurangabad", 19.8762, 75.3433, []string{"Chhatrapati Sambhajinagar"}, []string{terrainHeritage}, true},
+		{"Lonavala", 18.7546, 73.4062, nil, []string{terrainHill}, false},
+		{"Mahabaleshwar", 17.9307, 73.6477, nil, []string{terrainHill}, false},
+		{"Nashik", 19.9975, 73.7898, nil, []string{terrainPilgrimage}, false},
+	}},
+	{"Gujarat", "38", "24", []cityInfo{
+		{"Ahmedabad", 23.0225, 72.5714, []string{"Amdavad"}, []string{terrainMetro, terrainHeritage}, true},
+		{"Dwarka", 22.2394, 68.9678, nil, []string{terrainPilgrimage, terrainBeach}, false},
+		{"Somnath", 20.8880, 70.4010, nil, []string{terrainPilgrimage, terrainBeach}, false},
+		{"Bhuj", 23.2420, 69.6669, []string{"Kutch"}, []string{terrainDesert, terrainHeritage}, true},
+		{"Vadodara", 22.3072, 73.1812, []string{"Baroda"}, []string{terrainHeritage}, true},
+	}},
+	{"Madhya Pradesh", "46", "23", []cityInfo{
+		{"Khajuraho", 24.8318, 79.9199, nil, []string{terrainHeritage}, true},
+		{"Bhopal", 23.2599, 77.4126, []string{"City of Lakes"}, []string{terrainMetro}, true},
+		{"Indore", 22.7196, 75.8577, nil, []string{terrainMetro}, true},
+		{"Ujjain", 23.1765, 75.7885, []string{"Avantika"}, []string{terrainPilgrimage}, false},
+		{"Pachmarhi", 22.4676, 78.4336, []string{"Queen of Satpura"}, []string{terrainHill, terrainWildlife}, false},
+		{"Gwalior", 26.2183, 78.1828, nil, []string{terrainHeritage}, true},
+	}},
+	{"Karnataka", "56", "29", []cityInfo{
+		{"Bengaluru", 12.9716, 77.5946, []string{"Bangalore", "Garden City"}, []string{terrainMetro}, true},
+		{"Mysuru", 12.2958, 76.6394, []string{"Mysore"}, []string{terrainHeritage}, true},
+		{"Hampi", 15.3350, 76.4600, nil, []string{terrainHeritage}, false},
+		{"Madikeri", 12.4244, 75.7382, []string{"Coorg", "Kodagu"}, []string{terrainHill}, false},
+		{"Gokarna", 14.5479, 74.3188, nil, []string{terrainBeach, terrainPilgrimage}, false},
+		{"Chikkamagaluru", 13.3153, 75.7754, []string{"Chikmagalur"}, []string{terrainHill}, false},
+	}},
+	{"Kerala", "68", "32", []cityInfo{
+		{"Kochi", 9.9312, 76.2673, []string{"Cochin"}, []string{terrainMetro, terrainHeritage}, true},
+		{"Munnar", 10.0889, 77.0595, nil, []string{terrainHill}, false},
+		{"Alappuzha", 9.4981, 76.3388, []string{"Alleppey", "Venice of the East"}, []string{terrainBackwaters, terrainBeach}, false},
+		{"Thiruvananthapuram", 8.5241, 76.9366, []string{"Trivandrum"}, []string{terrainMetro, terrainBeach}, true},
+		{"Varkala", 8.7379, 76.7163, nil, []string{terrainBeach}, false},
+		{"Thekkady", 9.6031, 77.1615, []string{"Periyar"}, []string{terrainWildlife, terrainHill}, false},
+		{"Kalpetta", 11.6085, 76.0830, []string{"Wayanad"}, []string{terrainHill, terrainWildlife}, false},
+		{"Kumarakom", 9.6175, 76.4301, nil, []string{terrainBackwaters}, false},
+	}},
+	{"Tamil Nadu", "60", "33", []cityInfo{
+		{"Chennai", 13.0827, 80.2707, []string{"Madras"}, []string{terrainMetro, terrainBeach}, true},
+		{"Madurai", 9.9252, 78.1198, []string{"Temple City"}, []string{terrainPilgrimage, terrainHeritage}, true},
+		{"Ooty", 11.4102, 76.6950, []string{"Udhagamandalam", "Queen of Hill Stations"}, []string{terrainHill}, false},
+		{"Kodaikanal", 10.2381, 77.4892, []string{"Princess of Hill Stations"}, []string{terrainHill}, false},
+		{"Mahabalipuram", 12.6208, 80.1945, []string{"Mamallapuram"}, []string{terrainHeritage, terrainBeach}, false},
+		{"Kanyakumari", 8.0883, 77.5385, []string{"Cape Comorin"}, []string{terrainBeach, terrainPilgrimage}, false},
+		{"Rameswaram", 9.2876, 79.3129, nil, []string{terrainPilgrimage, terrainBeach}, false},
+	}},
+	{"Puducherry", "60", "34", []cityInfo{
+		{"Puducherry", 11.9416, 79.8083, []string{"Pondicherry", "Pondy"}, []string{terrainBeach, terrainHeritage}, true},
+	}},
+	{"Andhra Pradesh", "53", "37", []cityInfo{
+		{"Visakhapatnam", 17.6868, 83.2185, []string{"Vizag"}, []string{terrainBeach, terrainMetro}, true},
+		{"Tirupati", 13.6288, 79.4192, nil, []string{terrainPilgrimage}, true},
+		{"Araku Valley", 18.3273, 82.8775, nil, []string{terrainHill}, false},
+	}},
+	{"Telangana", "50", "36", []cityInfo{
+		{"Hyderabad", 17.3850, 78.4867, []string{"City of Pearls"}, []string{terrainMetro, terrainHeritage}, true},
+		{"Warangal", 17.9689, 79.5941, nil, []string{terrainHeritage}, false},
+	}},
+	{"Odisha", "75", "21", []cityInfo{
+		{"Puri", 19.8135, 85.8312, []string{"Jagannath Puri"}, []string{terrainPilgrimage, terrainBeach}, false},
+		{"Bhubaneswar", 20.2961, 85.8245, []string{"Temple City of India"}, []string{terrainHeritage, terrainMetro}, true},
+		{"Konark", 19.8876, 86.0945, nil, []string{terrainHeritage, terrainBeach}, false},
+	}},
+	{"Assam", "78", "18", []cityInfo{
+		{"Guwahati", 26.1445, 91.7362, nil, []string{terrainMetro, terrainPilgrimage}, true},
+		{"Kohora", 26.5775, 93.1711, []string{"Kaziranga"}, []string{terrainWildlife}, false},
+		{"Jorhat", 26.7509, 94.2037, nil, []string{terrainHeritage}, true},
+		{"Garamur", 26.9520, 94.1670, []string{"Majuli"}, []string{terrainPilgrimage}, false},
+	}},
+	{"Meghalaya", "79", "17", []cityInfo{
+		{"Shillong", 25.5788, 91.8933, []string{"Scotland of the East"}, []string{terrainHill}, true},
+		{"Sohra", 25.2840, 91.7210, []string{"Cherrapunji"}, []string{terrainHill, terrainAdventure}, false},
+		{"Dawki", 25.1830, 92.0170, nil, []string{terrainHill, terrainAdventure}, false},
+	}},
+	{"Arunachal Pradesh", "79", "12", []cityInfo{
+		{"Tawang", 27.5860, 91.8590, nil, []string{terrainHill, terrainPilgrimage}, false},
+		{"Ziro", 27.5440, 93.8310, nil, []string{terrainHill}, false},
+	}},
+	{"Andaman and Nicobar Islands", "74", "35", []cityInfo{
+		{"Port Blair", 11.6234, 92.7265, []string{"Sri Vijaya Puram"}, []string{terrainBeach, terrainHeritage}, true},
+		{"Havelock Island", 11.9960, 92.9880, []string{"Swaraj Dweep"}, []string{terrainBeach, terrainAdventure}, false},
+		{"Neil Island", 11.8320, 93.0200, []string{"Shaheed Dweep"}, []string{terrainBeach}, false},
+	}},
+	{"Bihar", "82", "10", []cityInfo{
+		{"Bodh Gaya", 24.6961, 84.9869, nil, []string{terrainPilgrimage, terrainHeritage}, false},
+		{"Patna", 25.5941, 85.1376, []string{"Pataliputra"}, []string{terrainMetro, terrainHeritage}, true},
+		{"Rajgir", 25.0280, 85.4210, nil, []string{terrainPilgrimage, terrainHeritage}, false},
+	}},
+}
+
+type attraction struct {
+	Feature  string   // e.g. "Lake", the last word(s) of the spot's name
+	Hindi    string   // Hindi name of the feature used for aliases, if any
+	Tags     []string // tags every spot of this kind gets
+	Terrains []string // cities it fits, any city when empty
+}
+
+var attractions = []attraction{
+	{"Lake", "Jheel", []string{"lake", "nature", "boating"}, nil},
+	{"Waterfalls", "Jharna", []string{"waterfall", "nature", "trekking"}, []string{terrainHill, terrainWildlife, terrainAdventure}},
+	{"Viewpoint", "Darshan Point", []string{"viewpoint", "sunrise", "photography"}, []string{terrainHill}},
+	{"Sunset Point", "", []string{"viewpoint", "sunset", "photography"}, []string{terrainHill, terrainBeach, terrainDesert}},
+	{"Fort", "Qila", []string{"fort", "heritage", "history"}, []string{terrainHeritage, terrainDesert, terrainMetro}},
+	{"Palace", "Mahal", []string{"palace", "heritage", "architecture"}, []string{terrainHeritage, terrainDesert}},
+	{"Temple", "Mandir", []string{"temple", "pilgrimage", "spiritual"}, nil},
+	{"Ghat", "", []string{"ghat", "river", "spiritual"}, []string{terrainPilgrimage}},
+	{"Beach", "Samudra Tat", []string{"beach", "sea", "water sports"}, []string{terrainBeach}},
+	{"Tea Garden", "Chai Bagan", []string{"tea estate", "plantation", "nature"}, []string{terrainHill}},
+	{"Botanical Garden", "Vanaspati Udyan", []string{"garden", "nature", "family"}, nil},
+	{"Wildlife Sanctuary", "Abhayaranya", []string{"wildlife", "safari", "nature"}, []string{terrainWildlife, terrainHill}},
+	{"National Park", "Rashtriya Udyan", []string{"wildlife", "safari", "jungle"}, []string{terrainWildlife}},
+	{"Monastery", "Gompa", []string{"monastery", "buddhist", "spiritual"}, []string{terrainHill}},
+	{"Caves", "Gufa", []string{"caves", "heritage", "adventure"}, []string{terrainHeritage, terrainHill}},
+	{"Museum", "Sangrahalaya", []string{"museum", "history", "culture"}, []string{terrainMetro, terrainHeritage}},
+	{"Bazaar", "Haat", []string{"market", "shopping", "street food"}, nil},
+	{"Stepwell", "Baori", []string{"stepwell", "heritage", "architecture"}, []string{terrainDesert, terrainHeritage}},
+	{"Sand Dunes", "Tibba", []string{"desert", "camel safari", "camping"}, []string{terrainDesert}},
+	{"Houseboat Jetty", "", []string{"backwaters", "houseboat", "nature"}, []string{terrainBackwaters}},
+	{"Canal Cruise", "", []string{"backwaters", "cruise", "village life"}, []string{terrainBackwaters}},
+	{"Base Camp", "", []string{"trekking", "adventure", "mountains"}, []string{terrainHill, terrainAdventure}},
+	{"Hot Springs", "Garam Kund", []string{"hot springs", "wellness", "nature"}, []string{terrainHill}},
+	{"Dam", "Bandh", []string{"dam", "reservoir", "picnic"}, nil},
+	{"Park", "Udyan", []string{"park", "picnic", "family"}, []string{terrainMetro}},
+	{"Lighthouse", "Prakash Stambh", []string{"lighthouse", "sea", "viewpoint"}, []string{terrainBeach}},
+	{"Church", "Girja", []string{"church", "heritage", "architecture"}, []string{terrainMetro, terrainBeach}},
+	{"Mosque", "Masjid", []string{"mosque", "heritage", "architecture"}, []string{terrainMetro, terrainHeritage}},
+	{"Gurudwara", "", []string{"gurudwara", "spiritual", "langar"}, []string{terrainPilgrimage, terrainMetro}},
+	{"Ashram", "", []string{"ashram", "yoga", "spiritual"}, []string{terrainPilgrimage}},
+	{"Rafting Point", "", []string{"rafting", "adventure", "river"}, []string{terrainAdventure}},
+	{"Paragliding Site", "", []string{"paragliding", "adventure", "viewpoint"}, []string{terrainAdventure, terrainHill}},
+	{"Coffee Estate", "", []string{"coffee", "plantation", "homestay"}, []string{terrainHill}},
+	{"Island", "Dweep", []string{"island", "nature", "snorkelling"}, []string{terrainBeach, terrainBackwaters}},
+	{"Valley", "Ghati", []string{"valley", "nature", "trekking"}, []string{terrainHill}},
+	{"Meadow", "Bugyal", []string{"meadow", "camping", "nature"}, []string{terrainHill}},
+	{"Pass", "La", []string{"mountain pass", "snow", "road trip"}, []string{terrainHill, terrainAdventure}},
+	{"Heritage Walk", "", []string{"walking tour", "heritage", "culture"}, []string{terrainHeritage, terrainMetro}},
+	{"Bird Sanctuary", "Pakshi Vihar", []string{"birdwatching", "wildlife", "nature"}, []string{terrainWildlife, terrainBackwaters}},
+}
+
+// descriptors prefix attraction features to name scenic spots, e.g. "Misty Lake"
+var descriptors = []string{
+	"Sunrise", "Silver", "Golden", "Hidden", "Royal", "Old", "Green", "Blue", "Crystal", "Misty",
+	"Emerald", "Peacock", "Lotus", "Tiger", "Elephant", "Rainbow", "Cloud", "Moonlight", "Echo", "Twin",
+	"Sacred", "Ancient", "Shanti", "Rani", "Raja", "Surya", "Chandra", "Ganga", "Neel", "Kanchan",
+	"Saffron", "Banyan", "Deodar", "Pine", "Coral", "Monsoon", "Sapphire", "Marigold", "Hornbill", "Jasmine",
+}
+
+// Villages are named by joining a prefix and a suffix, e.g. "Rampur"
+var (
+	villagePrefixes = []string{
+		"Ram", "Shiv", "Lakshman", "Sita", "Gopal", "Hari", "Dev", "Chandan", "Basant", "Sundar",
+		"Kesar", "Amar", "Bhim", "Nand", "Madhu", "Kishan", "Mohan", "Keshav", "Anand", "Bal",
+		"Durga", "Ganesh", "Indra", "Jai", "Kamal", "Lal", "Mani", "Nav", "Prem", "Raj",
+		"Sher", "Tara", "Uday", "Vijay", "Govind", "Bhawani", "Chand", "Dhan", "Hans", "Jagat",
+	}
+	villageSuffixes = []string{
+		"pur", "nagar", "gaon", "garh", "pura", "wadi", "palli", "halli", "kot", "khera",
+		"ganj", "bari", "dih", "tola", "patti",
+	}
+)
+
+// Organization names join a prefix with a suffix for their type
+var (
+	organizationPrefixes = []string{
+		"Himalayan", "Coastal", "Royal", "Heritage", "Evergreen", "Monsoon", "Saffron", "Lotus", "Peacock", "Spice Route",
+		"Sunrise", "Blue Lagoon", "Golden Triangle", "Desert Rose", "Misty Hills", "Tiger Trail", "Silk Route", "Emerald", "Konkan", "Deccan",
+		"Ganges", "Malabar", "Rann", "Nilgiri", "Brahmaputra", "Aravalli", "Western Ghats", "Sahyadri", "Vindhya", "Kaveri",
+	}
+	organizationSuffixes = map[string][]string{
+		TypeSupplier:    {"Stays", "Resorts", "Homestays", "Adventures", "Expeditions", "Camps", "Retreats", "Houseboats", "Cabs", "Hospitality"},
+		TypeTravelAgent: {"Travels", "Tours", "Holidays", "Journeys", "Tours and Travels", "Trips", "Voyages", "Getaways"},
+	}
+)
+
+var (
+	firstNames = []string{
+		"Aarav", "Vivaan", "Aditya", "Arjun", "Sai", "Reyansh", "Krishna", "Ishaan", "Rohan", "Kabir",
+		"Ananya", "Diya", "Aadhya", "Saanvi", "Priya", "Kavya", "Meera", "Isha", "Nisha", "Pooja",
+		"Rahul", "Vikram", "Suresh", "Ravi", "Amit", "Deepak", "Karthik", "Manish", "Sanjay", "Arun",
+		"Lakshmi", "Sneha", "Divya", "Anjali", "Shreya", "Neha", "Riya", "Tanvi", "Fatima", "Gurpreet",
+	}
+	lastNames = []string{
+		"Sharma", "Verma", "Gupta", "Singh", "Kumar", "Patel", "Reddy", "Nair", "Iyer", "Menon",
+		"Das", "Bose", "Chatterjee", "Banerjee", "Mukherjee", "Rao", "Pillai", "Joshi", "Kulkarni", "Deshpande",
+		"Mehta", "Shah", "Khan", "Ahmed", "Gill", "Sandhu", "Bhatt", "Thakur", "Chauhan", "Yadav",
+		"Naidu", "Hegde", "Shetty", "Kapoor", "Malhotra", "Saxena", "Mishra", "Pandey", "Tiwari", "Barua",
+	}
+)
+
+// roleTemplate is a custom role every organization of a type gets
+type roleTemplate struct {
+	Suffix      string
+	Description string
+	Permissions []string // resource:action
+}
+
+var roleTemplates = map[string][]roleTemplate{
+	TypeSupplier: {
+		{"INVENTORY_MANAGER", "Manages the locations and listings of the organization", []string{
+			"organizations:read", "locations:read", "locations:list", "locations:create", "locations:update", "locations:delete",
+		}},
+		{"RESERVATIONS", "Handles bookings and guest queries", []string{
+			"organizations:read", "users:read", "users:list", "locations:read", "locations:list",
+		}},
+		{"OWNER", "Administers the organization and its team", []string{
+			"organizations:read", "organizations:update", "users:read", "users:list", "users:create", "users:delete",
+			"roles:read", "roles:list", "locations:read", "locations:list",
+		}},
+	},
+	TypeTravelAgent: {
+		{"SALES_AGENT", "Builds itineraries and quotes for customers", []string{
+			"organizations:read", "locations:read", "locations:list",
+		}},
+		{"TEAM_LEAD", "Leads the sales team", []string{
+			"organizations:read", "users:read", "users:list", "users:create", "locations:read", "locations:list",
+		}},
+	},
+}
@@ -0,0 +1,518 @@
+// Package demodata generates a large, realistic dataset for load and UI testing:
+// organizations with custom roles and users, and thousands of Indian tourism locations.
+// Generation is deterministic: the same Options always produce the same records, so a
+// rerun finds them already written and only adds what is missing.
+package demodata
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/utils"
+	locEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	orgEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	userEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+)
+
+// Organization types generated. PLATFORM is the operator itself and is seeded once.
+const (
+	TypeSupplier    = "SUPPLIER"
+	TypeTravelAgent = "TRAVEL_AGENT"
+)
+
+// OrganizationTypes lists the types N organizations are generated for
+var OrganizationTypes = []string{TypeSupplier, TypeTravelAgent}
+
+// Options size the generated dataset
+type Options struct {
+	Seed                 uint64
+	OrganizationsPerType int
+	UsersPerOrganization int
+	// Locations is the total number of locations. The country, states, cities and their
+	// transit hubs are always generated; villages and scenic spots fill up the rest.
+	Locations int
+	Password  string // password of every generated user
+}
+
+// Dataset is the generated data, in the order it must be written
+type Dataset struct {
+	Organizations []Organization
+	Locations     []Location
+}
+
+// Organization is a generated organization with its custom roles and users
+type Organization struct {
+	Organization orgEntity.Organization
+	Roles        []Role
+	Users        []User
+}
+
+// Role is a custom role of an organization
+type Role struct {
+	Name        string
+	Description string
+	Permissions []string // resource:action
+}
+
+// User is a member of an organization holding one of its roles
+type User struct {
+	User userEntity.User
+	Role int // index into Organization.Roles
+}
+
+// Location is a generated location placed under a location generated before it
+type Location struct {
+	Location locEntity.Location
+	Parent   int // index into Dataset.Locations, -1 for the root
+}
+
+// generator holds the random source and the names taken so far
+type generator struct {
+	rng    *rand.Rand
+	opts   Options
+	names  map[string]bool // location names, which must be unique
+	phones map[string]bool
+}
+
+// Generate builds the dataset for opts
+func Generate(opts Options) *Dataset {
+	g := &generator{
+		rng:    rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x9e3779b97f4a7c15)),
+		opts:   opts,
+		names:  map[string]bool{},
+		phones: map[string]bool{},
+	}
+	return &Dataset{
+		Organizations: g.organizations(),
+		Locations:     g.locations(),
+	}
+}
+
+func (g *generator) organizations() []Organization {
+	var orgs []Organization
+	taken := map[string]bool{}
+	for _, orgType := range OrganizationTypes {
+		suffixes := organizationSuffixes[orgType]
+		for i := 0; i < g.opts.OrganizationsPerType; i++ {
+			name := g.pick(organizationPrefixes) + " " + g.pick(suffixes)
+			// Names repeat once the combinations run out; number them like branch offices
+			for n := 2; taken[name]; n++ {
+				name = fmt.Sprintf("%s %s %d", g.pick(organizationPrefixes), g.pick(suffixes), n)
+			}
+			taken[name] = true
+			orgs = append(orgs, g.organization(orgType, name))
+		}
+	}
+	return orgs
+}
+
+func (g *generator) organization(orgType, name string) Organization {
+	state := &states[g.rng.IntN(len(states))]
+	city := &state.Cities[g.rng.IntN(len(state.Cities))]
+	slug := utils.GenerateSlug(name)
+
+	org := Organization{Organization: orgEntity.Organization{
+		Name:    name,
+		Slug:    slug,
+		Type:    orgType,
+		Email:   "contact@" + slug + ".example.com",
+		Phone:   g.phone(),
+		Website: "https://" + slug + ".example.com",
+		TaxIDs:  []string{g.gstin(state), g.pan()},
+		Address: orgEntity.Address{
+			Street:  fmt.Sprintf("%d, %s Road", 1+g.rng.IntN(250), g.pick(lastNames)),
+			City:    city.Name,
+			State:   state.Name,
+			Country: "India",
+			Pincode: g.pincode(state),
+		},
+		Status: g.weighted([]string{"Approved", "Pending", "Suspended"}, []int{8, 1, 1}),
+	}}
+
+	prefix := strings.ToUpper(strings.ReplaceAll(slug, "-", "_"))
+	for _, template := range roleTemplates[orgType] {
+		org.Roles = append(org.Roles, Role{
+			Name:        prefix + "_" + template.Suffix,
+			Description: template.Description,
+			Permissions: template.Permissions,
+		})
+	}
+
+	for i := 0; i < g.opts.UsersPerOrganization; i++ {
+		first, last := g.pick(firstNames), g.pick(lastNames)
+		role := g.rng.IntN(len(org.Roles))
+		if i == 0 {
+			role = len(org.Roles) - 1 // the most senior role
+		}
+		org.Users = append(org.Users, User{Role: role, User: userEntity.User{
+			FullName: first + " " + last,
+			Emails: []userEntity.Email{{
+				Email:      fmt.Sprintf("%s.%s.%d@%s.example.com", strings.ToLower(first), strings.ToLower(last), i+1, slug),
+				IsVerified: true,
+			}},
+			Phones:   []userEntity.Phone{{Number: g.phone(), IsVerified: g.rng.IntN(4) > 0}},
+			Password: g.opts.Password,
+			Status: userEntity.UserStatus(g.weighted(
+				[]string{string(userEntity.UserStatusActive), string(userEntity.UserStatusInvited), string(userEntity.UserStatusSuspended)},
+				[]int{7, 2, 1})),
+		}})
+	}
+	return org
+}
+
+// locations generates the country, its states and cities with transit hubs, then villages
+// and scenic spots up to the requested total
+func (g *generator) locations() []Location {
+	locs := []Location{{Parent: -1, Location: locEntity.Location{
+		Name:        "India",
+		Type:        locEntity.TypeCountry,
+		Country:     "India",
+		Coordinates: locEntity.Coordinates{Lat: 22.3511, Lng: 78.6677},
+		Tags:        []string{"country"},
+		Aliases:     []string{"Bharat", "Hindustan"},
+		Description: "A country of mountains, deserts, backwaters and beaches, with thousands of years of living heritage.",
+		Popularity:  100000,
+	}}}
+	g.names["India"] = true
+
+	type placedCity struct {
+		index int
+		state *stateInfo
+		city  *cityInfo
+	}
+	var cities []placedCity
+
+	for si := range states {
+		state := &states[si]
+		lat, lng := stateCentre(state)
+		stateIndex := len(locs)
+		locs = append(locs, Location{Parent: 0, Location: locEntity.Location{
+			Name:        state.Name,
+			Type:        locEntity.TypeState,
+			Country:     "India",
+			State:       state.Name,
+			Coordinates: locEntity.Coordinates{Lat: round(lat), Lng: round(lng)},
+			Tags:        stateTags(state),
+			Description: fmt.Sprintf("%s, home to %s.", state.Name, cityList(state)),
+			Popularity:  int64(5000 + g.rng.IntN(45000)),
+		}})
+		g.names[state.Name] = true
+
+		for ci := range state.Cities {
+			city := &state.Cities[ci]
+			name := g.unique(city.Name, state.Name)
+			cities = append(cities, placedCity{index: len(locs), state: state, city: city})
+			locs = append(locs, Location{Parent: stateIndex, Location: locEntity.Location{
+				Name:        name,
+				Type:        locEntity.TypeCity,
+				Country:     "India",
+				State:       state.Name,
+				District:    city.Name,
+				Pincode:     g.pincode(state),
+				Coordinates: locEntity.Coordinates{Lat: city.Lat, Lng: city.Lng},
+				Tags:        append([]string{"city"}, city.Terrains...),
+				Aliases:     city.Aliases,
+				Description: fmt.Sprintf("%s is a %s destination in %s.", city.Name, strings.Join(city.Terrains, " and "), state.Name),
+				Popularity:  int64(1000 + g.rng.IntN(49000)),
+			}})
+		}
+	}
+
+	// Transit hubs next to every city
+	for _, c := range cities {
+		hubs := []struct {
+			name, alias string
+			tags        []string
+			minKm       float64
+			maxKm       float64
+		}{
+			{c.city.Name + " Railway Station", c.city.Name + " Junction", []string{"railway", "transport"}, 0.5, 4},
+			{c.city.Name + " Bus Stand", c.city.Name + " ISBT", []string{"bus", "transport"}, 0.5, 5},
+		}
+		if c.city.Airport {
+			hubs = append(hubs, struct {
+				name, alias string
+				tags        []string
+				minKm       float64
+				maxKm       float64
+			}{c.city.Name + " Airport", c.city.Name + " International Airport", []string{"airport", "transport"}, 6, 20})
+		}
+		for _, hub := range hubs {
+			lat, lng := g.near(c.city.Lat, c.city.Lng, hub.minKm, hub.maxKm)
+			locs = append(locs, Location{Parent: c.index, Location: locEntity.Location{
+				Name:        g.unique(hub.name, c.state.Name),
+				Type:        locEntity.TypeTransitHub,
+				Country:     "India",
+				State:       c.state.Name,
+				District:    c.city.Name,
+				Pincode:     locs[c.index].Location.Pincode,
+				Coordinates: locEntity.Coordinates{Lat: lat, Lng: lng},
+				Tags:        hub.tags,
+				Aliases:     []string{hub.alias},
+				Description: fmt.Sprintf("Main %s serving %s.", strings.ToLower(strings.TrimPrefix(hub.name, c.city.Name+" ")), c.city.Name),
+				Popularity:  int64(g.rng.IntN(3000)),
+			}})
+		}
+	}
+
+	// Villages and scenic spots fill up the rest, a fifth of them villages
+	remaining := g.opts.Locations - len(locs)
+	if remaining <= 0 {
+		return locs
+	}
+	villages := min(remaining/5, len(villagePrefixes)*len(villageSuffixes)/2)
+	type placedVillage struct {
+		index int
+		city  placedCity
+	}
+	var placedVillages []placedVillage
+	for i := 0; i < villages; i++ {
+		c := cities[g.rng.IntN(len(cities))]
+		name, ok := g.villageName()
+		if !ok {
+			break
+		}
+		lat, lng := g.near(c.city.Lat, c.city.Lng, 5, 30)
+		placedVillages = append(placedVillages, placedVillage{index: len(locs), city: c})
+		locs = append(locs, Location{Parent: c.index, Location: locEntity.Location{
+			Name:        name,
+			Type:        locEntity.TypeVillage,
+			Country:     "India",
+			State:       c.state.Name,
+			District:    c.city.Name,
+			Pincode:     g.pincode(c.state),
+			Coordinates: locEntity.Coordinates{Lat: lat, Lng: lng},
+			Tags:        []string{"village", "rural", "homestay"},
+			Description: fmt.Sprintf("A village near %s, %s.", c.city.Name, c.state.Name),
+			Popularity:  int64(g.rng.ExpFloat64() * 200),
+		}})
+	}
+
+	for len(locs) < g.opts.Locations {
+		c := cities[g.rng.IntN(len(cities))]
+		parent, parentName := c.index, c.city.Name
+		centreLat, centreLng := c.city.Lat, c.city.Lng
+		// Some spots are better known by the village they are in
+		if len(placedVillages) > 0 && g.rng.IntN(6) == 0 {
+			v := placedVillages[g.rng.IntN(len(placedVillages))]
+			village := locs[v.index].Location
+			parent, parentName, c = v.index, village.Name, v.city
+			centreLat, centreLng = village.Coordinates.Lat, village.Coordinates.Lng
+		}
+
+		spot := g.attractionFor(c.city)
+		name := g.spotName(spot, parentName)
+		lat, lng := g.near(centreLat, centreLng, 1, 25)
+		loc := locEntity.Location{
+			Name:        name,
+			Type:        locEntity.TypeScenicSpot,
+			Country:     "India",
+			State:       c.state.Name,
+			District:    c.city.Name,
+			Pincode:     g.pincode(c.state),
+			Coordinates: locEntity.Coordinates{Lat: lat, Lng: lng},
+			Tags:        g.spotTags(spot, c.city),
+			Description: fmt.Sprintf("A %s %s near %s, %s.", g.pick(spotAdjectives), strings.ToLower(spot.Feature), parentName, c.state.Name),
+			Popularity:  int64(g.rng.ExpFloat64() * 800),
+		}
+		if spot.Hindi != "" && g.rng.IntN(2) == 0 {
+			loc.Aliases = append(loc.Aliases, strings.TrimSuffix(name, spot.Feature)+spot.Hindi)
+		}
+		if g.rng.IntN(4) == 0 {
+			loc.Aliases = append(loc.Aliases, spot.Feature+" near "+parentName)
+		}
+		locs = append(locs, Location{Parent: parent, Location: loc})
+	}
+	return locs
+}
+
+var spotAdjectives = []string{"popular", "quiet", "scenic", "much-photographed", "serene", "historic", "family-friendly", "lesser-known"}
+
+// attractionFor picks an attraction that fits the city's terrain
+func (g *generator) attractionFor(city *cityInfo) attraction {
+	var fits []attraction
+	for _, a := range attractions {
+		if len(a.Terrains) == 0 || overlaps(a.Terrains, city.Terrains) {
+			fits = append(fits, a)
+		}
+	}
+	return fits[g.rng.IntN(len(fits))]
+}
+
+// spotName names a scenic spot "<Descriptor> <Feature>" or "<Place> <Feature>", qualifying
+// it with the place it is near once the short names are taken
+func (g *generator) spotName(spot attraction, place string) string {
+	for try := 0; try < 8; try++ {
+		name := g.pick(descriptors) + " " + spot.Feature
+		if g.rng.IntN(3) == 0 {
+			name = place + " " + spot.Feature
+		}
+		if !g.names[name] {
+			g.names[name] = true
+			return name
+		}
+	}
+	return g.unique(g.pick(descriptors)+" "+spot.Feature, place)
+}
+
+func (g *generator) spotTags(spot attraction, city *cityInfo) []string {
+	tags := append([]string{}, spot.Tags...)
+	for _, terrain := range city.Terrains {
+		if terrain != terrainMetro && !contains(tags, terrain) && g.rng.IntN(2) == 0 {
+			tags = append(tags, terrain)
+		}
+	}
+	return tags
+}
+
+// villageName returns an unused village name, false once every combination is taken
+func (g *generator) villageName() (string, bool) {
+	for try := 0; try < 50; try++ {
+		name := g.pick(villagePrefixes) + g.pick(villageSuffixes)
+		if !g.names[name] {
+			g.names[name] = true
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// unique returns name, or "name, qualifier" and then a numbered form when it is taken
+func (g *generator) unique(name, qualifier string) string {
+	candidate := name
+	for n := 1; g.names[candidate]; n++ {
+		candidate = name + ", " + qualifier
+		if n > 1 {
+			candidate = fmt.Sprintf("%s, %s %d", name, qualifier, n)
+		}
+	}
+	g.names[candidate] = true
+	return candidate
+}
+
+// near returns a point between minKm and maxKm from lat, lng in a random direction
+func (g *generator) near(lat, lng, minKm, maxKm float64) (float64, float64) {
+	distance := minKm + g.rng.Float64()*(maxKm-minKm)
+	bearing := g.rng.Float64() * 2 * math.Pi
+	dLat := distance * math.Cos(bearing) / 111.0
+	dLng := distance * math.Sin(bearing) / (111.0 * math.Cos(lat*math.Pi/180))
+	return round(lat + dLat), round(lng + dLng)
+}
+
+func (g *generator) phone() string {
+	for {
+		phone := fmt.Sprintf("+91%d%09d", 6+g.rng.IntN(4), g.rng.IntN(1_000_000_000))
+		if !g.phones[phone] {
+			g.phones[phone] = true
+			return phone
+		}
+	}
+}
+
+func (g *generator) pincode(state *stateInfo) string {
+	return fmt.Sprintf("%s%04d", state.PincodePrefix, g.rng.IntN(10000))
+}
+
+// gstin returns a well-formed GSTIN: state code, PAN, entity number, Z and a check character
+func (g *generator) gstin(state *stateInfo) string {
+	return fmt.Sprintf("%s%s%dZ%c", state.GSTCode, g.pan(), 1+g.rng.IntN(9), alphanumeric[g.rng.IntN(len(alphanumeric))])
+}
+
+func (g *generator) pan() string {
+	return fmt.Sprintf("%s%04d%c", g.letters(5), g.rng.IntN(10000), 'A'+rune(g.rng.IntN(26)))
+}
+
+const alphanumeric = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+func (g *generator) letters(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('A' + g.rng.IntN(26))
+	}
+	return string(b)
+}
+
+func (g *generator) pick(values []string) string {
+	return values[g.rng.IntN(len(values))]
+}
+
+// weighted picks a value with probability proportional to its weight
+func (g *generator) weighted(values []string, weights []int) string {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	n := g.rng.IntN(total)
+	for i, w := range weights {
+		if n < w {
+			return values[i]
+		}
+		n -= w
+	}
+	return values[len(values)-1]
+}
+
+// stateCentre is the mean position of the state's cities
+func stateCentre(state *stateInfo) (float64, float64) {
+	var lat, lng float64
+	for _, city := range state.Cities {
+		lat += city.Lat
+		lng += city.Lng
+	}
+	n := float64(len(state.Cities))
+	return lat / n, lng / n
+}
+
+func stateTags(state *stateInfo) []string {
+	seen := map[string]bool{}
+	for _, city := range state.Cities {
+		for _, terrain := range city.Terrains {
+			if terrain != terrainMetro {
+				seen[terrain] = true
+			}
+		}
+	}
+	tags := []string{"state"}
+	for terrain := range seen {
+		tags = append(tags, terrain)
+	}
+	sort.Strings(tags[1:])
+	return tags
+}
+
+func cityList(state *stateInfo) string {
+	names := make([]string, len(state.Cities))
+	for i, city := range state.Cities {
+		names[i] = city.Name
+	}
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+func overlaps(a, b []string) bool {
+	for _, x := range a {
+		if contains(b, x) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// round keeps coordinates to about a metre
+func round(v float64) float64 {
+	return math.Round(v*1e5) / 1e5
+}
@@ -0,0 +1,63 @@
+package demodata
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testOptions = Options{
+	Seed:                 42,
+	OrganizationsPerType: 5,
+	UsersPerOrganization: 4,
+	Locations:            2000,
+	Password:             "Password@123",
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first, second := Generate(testOptions), Generate(testOptions)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("the same options generated different datasets")
+	}
+
+	other := testOptions
+	other.Seed++
+	if reflect.DeepEqual(first, Generate(other)) {
+		t.Error("a different seed generated the same dataset")
+	}
+}
+
+func TestGenerateSizes(t *testing.T) {
+	data := Generate(testOptions)
+
+	if want := len(OrganizationTypes) * testOptions.OrganizationsPerType; len(data.Organizations) != want {
+		t.Errorf("organizations = %d, want %d", len(data.Organizations), want)
+	}
+	for _, org := range data.Organizations {
+		if len(org.Users) != testOptions.UsersPerOrganization {
+			t.Errorf("%s has %d users, want %d", org.Organization.Name, len(org.Users), testOptions.UsersPerOrganization)
+		}
+		for _, user := range org.Users {
+			if user.Role < 0 || user.Role >= len(org.Roles) {
+				t.Errorf("%s has a user with role %d of %d", org.Organization.Name, user.Role, len(org.Roles))
+			}
+		}
+	}
+	if len(data.Locations) != testOptions.Locations {
+		t.Errorf("locations = %d, want %d", len(data.Locations), testOptions.Locations)
+	}
+}
+
+func TestGenerateLocationsAreOrderedAndUnique(t *testing.T) {
+	data := Generate(testOptions)
+
+	names := map[string]bool{}
+	for i, loc := range data.Locations {
+		if i == 0 && loc.Parent != -1 || i > 0 && (loc.Parent < 0 || loc.Parent >= i) {
+			t.Errorf("%s at %d has parent %d, want one generated before it", loc.Location.Name, i, loc.Parent)
+		}
+		if names[loc.Location.Name] {
+			t.Errorf("location name %q is repeated", loc.Location.Name)
+		}
+		names[loc.Location.Name] = true
+	}
+}
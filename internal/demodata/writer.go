@@ -0,0 +1,176 @@
+package demodata
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	locEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Counts are how many records of each kind were created and how many already existed
+type Counts struct {
+	Created  map[string]int
+	Existing map[string]int
+}
+
+func (c *Counts) add(kind string, created bool) {
+	if created {
+		c.Created[kind]++
+	} else {
+		c.Existing[kind]++
+	}
+}
+
+// Write stores the dataset through the module use cases. Records that already exist,
+// matched by slug, role name, email or location name, are reused, so writing the same
+// dataset twice creates nothing the second time. The permissions must be seeded first.
+func Write(ctx context.Context, app *container.AppContainer, data *Dataset) (*Counts, error) {
+	counts := &Counts{Created: map[string]int{}, Existing: map[string]int{}}
+
+	permissionIDs, err := loadPermissionIDs(ctx, app)
+	if err != nil {
+		return counts, err
+	}
+
+	for i := range data.Organizations {
+		if err := writeOrganization(ctx, app, &data.Organizations[i], permissionIDs, counts); err != nil {
+			return counts, err
+		}
+	}
+	if err := writeLocations(ctx, app, data.Locations, counts); err != nil {
+		return counts, err
+	}
+	return counts, nil
+}
+
+// loadPermissionIDs maps resource:action to the ID of every stored permission
+func loadPermissionIDs(ctx context.Context, app *container.AppContainer) (map[string]string, error) {
+	permissions, _, err := app.Permission.Repository.List(ctx, make(map[string]interface{}), 1, 5000)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	if len(permissions) == 0 {
+		return nil, fmt.Errorf("no permissions found, run the seeder first")
+	}
+	ids := make(map[string]string, len(permissions))
+	for _, p := range permissions {
+		ids[p.String()] = p.ID.Hex()
+	}
+	return ids, nil
+}
+
+func writeOrganization(ctx context.Context, app *container.AppContainer, o *Organization, permissionIDs map[string]string, counts *Counts) error {
+	org := o.Organization
+	existing, err := app.Organization.Repository.FindBySlug(ctx, org.Slug)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		org.ID = existing.ID
+	} else {
+		org.ID = primitive.NewObjectID()
+		if err := app.Organization.CreateOrganizationUseCase.Execute(ctx, &org); err != nil {
+			return fmt.Errorf("failed to create organization %s: %w", org.Slug, err)
+		}
+	}
+	counts.add("organizations", existing == nil)
+
+	roleIDs := make([]string, len(o.Roles))
+	for i, r := range o.Roles {
+		role, err := app.Role.Repository.GetByName(ctx, r.Name)
+		if err != nil {
+			return err
+		}
+		counts.add("roles", role == nil)
+		if role != nil {
+			roleIDs[i] = role.ID.Hex()
+			continue
+		}
+
+		ids := make([]string, 0, len(r.Permissions))
+		for _, key := range r.Permissions {
+			id, ok := permissionIDs[key]
+			if !ok {
+				return fmt.Errorf("role %s: unknown permission %s", r.Name, key)
+			}
+			ids = append(ids, id)
+		}
+		role = &roleEntity.Role{
+			ID:             primitive.NewObjectID(),
+			Name:           r.Name,
+			Description:    r.Description,
+			Permissions:    ids,
+			Scope:          roleEntity.RoleScopeOrganization,
+			OrganizationID: &org.ID,
+			CreatedBy:      "system",
+		}
+		if err := app.Role.CreateRoleUseCase.Execute(ctx, role); err != nil {
+			return fmt.Errorf("failed to create role %s: %w", r.Name, err)
+		}
+		roleIDs[i] = role.ID.Hex()
+	}
+
+	for _, u := range o.Users {
+		user := u.User
+		exists, err := app.User.Repository.ExistsByEmail(ctx, user.GetPrimaryEmail())
+		if err != nil {
+			return err
+		}
+		counts.add("users", !exists)
+		if exists {
+			continue
+		}
+
+		user.ID = primitive.NewObjectID()
+		user.RoleID = roleIDs[u.Role]
+		user.OrganizationID = org.ID.Hex()
+		if err := app.User.CreateUserUseCase.Execute(ctx, &user); err != nil {
+			return fmt.Errorf("failed to create user %s: %w", user.GetPrimaryEmail(), err)
+		}
+	}
+
+	log.Printf("Organization %s: %d roles, %d users", org.Slug, len(o.Roles), len(o.Users))
+	return nil
+}
+
+// writeLocations creates the locations parents first, reusing stored ones with the same name
+func writeLocations(ctx context.Context, app *container.AppContainer, locations []Location, counts *Counts) error {
+	// Location names are unique, so one pass over the stored ones finds what exists
+	stored := map[string]primitive.ObjectID{}
+	err := app.Location.ExportLocationsUseCase.Execute(ctx, map[string]interface{}{"deletedAt": nil}, func(loc *locEntity.Location) error {
+		stored[loc.Name] = loc.ID
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list locations: %w", err)
+	}
+
+	ids := make([]primitive.ObjectID, len(locations))
+	for i, l := range locations {
+		if id, ok := stored[l.Location.Name]; ok {
+			ids[i] = id
+			counts.add("locations", false)
+			continue
+		}
+
+		loc := l.Location
+		if l.Parent >= 0 {
+			parentID := ids[l.Parent]
+			loc.ParentID = &parentID
+		}
+		if err := app.Location.CreateLocationUseCase.Execute(ctx, &loc); err != nil {
+			return fmt.Errorf("failed to create location %s: %w", loc.Name, err)
+		}
+		ids[i] = loc.ID
+		counts.add("locations", true)
+
+		if created := counts.Created["locations"]; created%500 == 0 {
+			log.Printf("Created %d locations", created)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Package export writes the results of list endpoints as CSV or XLSX files. Rows are
+// written as they are read, so an export of any size runs in constant memory, and the
+// caller picks which columns to include.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Format is the file format of an export
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// FlushEvery controls how many rows are written between flushes to the client
+const FlushEvery = 200
+
+var (
+	// ErrUnsupportedFormat is returned for formats other than csv and xlsx
+	ErrUnsupportedFormat = errors.New("unsupported export format, use csv or xlsx")
+	// ErrInvalidColumns is returned for columns parameters naming unknown columns
+	ErrInvalidColumns = errors.New("invalid export columns")
+)
+
+// ParseFormat reads a format parameter, csv when empty
+func ParseFormat(param string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(param))) {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType is the media type of files in the format
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// FileName names an export of resource taken at t
+func (f Format) FileName(resource string, t time.Time) string {
+	return fmt.Sprintf("%s-%s.%s", resource, t.Format("20060102-150405"), f)
+}
+
+// Column is one column an export can include
+type Column struct {
+	Key    string // name used in the columns parameter and row values
+	Header string // first row of the file
+}
+
+// Columns are the columns of an export, in file order
+type Columns []Column
+
+// Select reads a comma separated list of column keys. It returns every column when param
+// is empty, and the listed columns in the listed order otherwise.
+func (c Columns) Select(param string) (Columns, error) {
+	if strings.TrimSpace(param) == "" {
+		return c, nil
+	}
+	byKey := make(map[string]Column, len(c))
+	for _, column := range c {
+		byKey[column.Key] = column
+	}
+
+	var selected Columns
+	seen := map[string]bool{}
+	for _, part := range strings.Split(param, ",") {
+		key := strings.TrimSpace(part)
+		if key == "" || seen[key] {
+			continue
+		}
+		column, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q, use one of %s", ErrInvalidColumns, key, strings.Join(c.Keys(), ", "))
+		}
+		seen[key] = true
+		selected = append(selected, column)
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("%w: select at least one column", ErrInvalidColumns)
+	}
+	return selected, nil
+}
+
+// Keys returns the keys of the columns
+func (c Columns) Keys() []string {
+	keys := make([]string, len(c))
+	for i, column := range c {
+		keys[i] = column.Key
+	}
+	return keys
+}
+
+// Headers returns the header row
+func (c Columns) Headers() []string {
+	headers := make([]string, len(c))
+	for i, column := range c {
+		headers[i] = column.Header
+	}
+	return headers
+}
+
+// Record orders the values of a row, keyed by column key, as the columns
+func (c Columns) Record(values map[string]string) []string {
+	record := make([]string, len(c))
+	for i, column := range c {
+		record[i] = values[column.Key]
+	}
+	return record
+}
+
+// Rows streams the rows of an export, calling emit with the values of each row keyed by
+// column key. Values for columns that were not selected are ignored.
+type Rows func(ctx context.Context, emit func(values map[string]string) error) error
+
+// Source is what an export request selects: how many rows match and how to read them.
+// Both must use the context they are given rather than the request's, since a
+// background job may read the rows after the response.
+type Source struct {
+	Count func(ctx context.Context) (int64, error)
+	Rows  Rows
+}
+
+// Writer writes the records of an export file
+type Writer interface {
+	Write(record []string) error
+	// Flush sends the records written so far to the underlying writer
+	Flush() error
+	// Close completes the file, it must be called once all records are written
+	Close() error
+}
+
+// NewWriter creates a writer for format on w
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatXLSX:
+		return newXLSXWriter(w), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// Write writes the header and every row of rows to out as a complete file and returns
+// the number of rows written. When out is an http.Flusher, rows are flushed to the client
+// every FlushEvery rows.
+func Write(ctx context.Context, out io.Writer, format Format, columns Columns, rows Rows) (int64, error) {
+	w, err := NewWriter(format, out)
+	if err != nil {
+		return 0, err
+	}
+	flusher, _ := out.(http.Flusher)
+	flush := func() error {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	}
+
+	if err := w.Write(columns.Headers()); err != nil {
+		return 0, err
+	}
+
+	var count int64
+	err = rows(ctx, func(values map[string]string) error {
+		if err := w.Write(columns.Record(values)); err != nil {
+			return err
+		}
+		count++
+		if count%FlushEvery == 0 {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+	if err := w.Close(); err != nil {
+		return count, err
+	}
+	if flusher != nil {
+		flusher.Flush()
+	}
+	return count, nil
+}
@@ -0,0 +1,36 @@
+package export
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListSeparator joins the values of list fields in a single cell, as in location exports
+const ListSeparator = "|"
+
+// Time formats a timestamp as RFC 3339 in UTC, empty when zero
+func Time(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// OptionalTime formats an optional timestamp, empty when nil
+func OptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return Time(*t)
+}
+
+// Bool formats a flag as true or false
+func Bool(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+// List joins the values of a list field with ListSeparator
+func List(values []string) string {
+	return strings.Join(values, ListSeparator)
+}
@@ -0,0 +1,149 @@
+// Package fieldset reads the fields and expand query parameters of read endpoints and
+// trims responses to the requested sparse fieldset
+package fieldset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrInvalidFields is returned for fields or expand parameters naming unknown members
+var ErrInvalidFields = errors.New("invalid fields")
+
+// idMembers are kept in every sparse response so items can still be told apart
+var idMembers = []string{"id", "_id"}
+
+// Set is a set of top-level response member names. A nil Set selects every member.
+type Set map[string]bool
+
+// Has reports whether name is in the set
+func (s Set) Has(name string) bool {
+	return s[name]
+}
+
+func (s Set) names() string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// Of returns the JSON member names of the struct v, which a fields parameter may select
+func Of(v interface{}) Set {
+	set := Set{}
+	collect(reflect.TypeOf(v), set)
+	return set
+}
+
+func collect(t reflect.Type, set Set) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			collect(field.Type, set)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		set[name] = true
+	}
+}
+
+// Parse reads a comma separated list of member names from param, named after the query
+// parameter it came from. It returns nil when param is empty.
+func Parse(param, name string, allowed Set) (Set, error) {
+	if strings.TrimSpace(param) == "" {
+		return nil, nil
+	}
+	set := Set{}
+	for _, part := range strings.Split(param, ",") {
+		member := strings.TrimSpace(part)
+		if member == "" {
+			continue
+		}
+		if !allowed.Has(member) {
+			return nil, fmt.Errorf("%w: %s cannot include %q, use one of %s", ErrInvalidFields, name, member, allowed.names())
+		}
+		set[member] = true
+	}
+	return set, nil
+}
+
+// FromQuery reads the fields query parameter of a request for responses shaped like allowed
+func FromQuery(c *gin.Context, allowed Set) (Set, error) {
+	return Parse(c.Query("fields"), "fields", allowed)
+}
+
+// ExpandFromQuery reads the expand query parameter of a request, which may name the
+// relationships in allowed
+func ExpandFromQuery(c *gin.Context, allowed ...string) (Set, error) {
+	set := Set{}
+	for _, name := range allowed {
+		set[name] = true
+	}
+	return Parse(c.Query("expand"), "expand", set)
+}
+
+// Select returns v trimmed to the members in fields, plus its ID. v is returned as is when
+// fields is nil.
+func Select(v interface{}, fields Set) (interface{}, error) {
+	if fields == nil {
+		return v, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return nil, err
+	}
+	for name := range members {
+		if !fields.Has(name) && !isID(name) {
+			delete(members, name)
+		}
+	}
+	return members, nil
+}
+
+// SelectAll applies Select to every element of the slice items
+func SelectAll(items interface{}, fields Set) (interface{}, error) {
+	if fields == nil {
+		return items, nil
+	}
+	value := reflect.ValueOf(items)
+	selected := make([]interface{}, value.Len())
+	for i := range selected {
+		item, err := Select(value.Index(i).Interface(), fields)
+		if err != nil {
+			return nil, err
+		}
+		selected[i] = item
+	}
+	return selected, nil
+}
+
+func isID(name string) bool {
+	for _, id := range idMembers {
+		if name == id {
+			return true
+		}
+	}
+	return false
+}
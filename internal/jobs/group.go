@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+)
+
+// Group tracks background work that must stop before the server exits. Work started
+// through it gets a context that Shutdown cancels, and Shutdown waits for it to return.
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewGroup creates a Group ready to run work
+func NewGroup() *Group {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Group{ctx: ctx, cancel: cancel}
+}
+
+// Go runs fn in the background with the group's context. Once Shutdown has begun, fn
+// runs at once with the cancelled context instead, so it can record that it was stopped.
+func (g *Group) Go(fn func(ctx context.Context)) {
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		fn(g.ctx)
+		return
+	}
+	g.wg.Add(1)
+	g.mu.Unlock()
+
+	go func() {
+		defer g.wg.Done()
+		fn(g.ctx)
+	}()
+}
+
+// Shutdown cancels the running work and waits for it to return, or for ctx to end
+func (g *Group) Shutdown(ctx context.Context) error {
+	g.mu.Lock()
+	g.closed = true
+	g.mu.Unlock()
+	g.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
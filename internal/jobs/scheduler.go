@@ -0,0 +1,32 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	"go.uber.org/zap"
+)
+
+// Every runs fn on a fixed interval in g until g shuts down. A failing run is
+// logged and retried on the next tick; runs never overlap.
+func Every(g *Group, name string, interval time.Duration, fn func(ctx context.Context) error) {
+	g.Go(func(ctx context.Context) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				started := time.Now()
+				if err := fn(ctx); err != nil {
+					logger.Log.Error("Background job failed", zap.String("job", name), zap.Error(err))
+					continue
+				}
+				logger.Log.Debug("Background job finished", zap.String("job", name), zap.Duration("took", time.Since(started)))
+			}
+		}
+	})
+}
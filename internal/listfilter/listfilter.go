@@ -0,0 +1,327 @@
+// Package listfilter compiles the filter expressions accepted by list endpoints, such as
+// "status:in:Approved,Pending;createdAt:gte:2026-01-01;name:contains:tour", to MongoDB
+// queries. Only declared fields can be filtered, each with the operators its type allows,
+// and values are always parsed into scalars placed under an operator, so a client cannot
+// inject operators or regular expressions.
+package listfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Limits on a filter expression
+const (
+	MaxClauses     = 20
+	MaxListValues  = 100
+	MaxValueLength = 256
+)
+
+// ErrInvalidFilter is matched by every error Parse returns
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Type is the type of a filterable field, which decides how values are parsed and which
+// operators apply
+type Type int
+
+const (
+	String Type = iota
+	Number
+	Bool
+	Date
+	ObjectID
+)
+
+// Operator is a filter operator
+type Operator string
+
+const (
+	Eq         Operator = "eq"
+	Ne         Operator = "ne"
+	Gt         Operator = "gt"
+	Gte        Operator = "gte"
+	Lt         Operator = "lt"
+	Lte        Operator = "lte"
+	In         Operator = "in"
+	Nin        Operator = "nin"
+	Contains   Operator = "contains"
+	StartsWith Operator = "startswith"
+	Exists     Operator = "exists"
+)
+
+// operatorsByType lists the operators each field type supports
+var operatorsByType = map[Type][]Operator{
+	String:   {Eq, Ne, In, Nin, Contains, StartsWith, Exists},
+	Number:   {Eq, Ne, Gt, Gte, Lt, Lte, In, Nin, Exists},
+	Bool:     {Eq, Ne, Exists},
+	Date:     {Eq, Ne, Gt, Gte, Lt, Lte, Exists},
+	ObjectID: {Eq, Ne, In, Nin, Exists},
+}
+
+// Field declares a filterable field
+type Field struct {
+	Path string // stored field name, dotted for embedded fields
+	Type Type
+}
+
+// Fields maps the field names a resource can be filtered by to their declarations
+type Fields map[string]Field
+
+// Error describes one invalid clause of a filter expression
+type Error struct {
+	Clause  string `json:"clause"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Clause, e.Message)
+}
+
+// Errors is every invalid clause of a filter expression
+type Errors []*Error
+
+// Error implements error
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%v: %s", ErrInvalidFilter, strings.Join(messages, "; "))
+}
+
+// Is makes Errors match ErrInvalidFilter
+func (e Errors) Is(target error) bool {
+	return target == ErrInvalidFilter
+}
+
+// Parse compiles expr into one MongoDB condition per clause. Clauses are separated by
+// ";", each is field:operator:value, and in and nin take values separated by ",". A
+// backslash escapes a separator inside a value. Every invalid clause is reported.
+func Parse(expr string, fields Fields) ([]bson.M, error) {
+	clauses := split(expr, ';')
+	if len(clauses) > MaxClauses {
+		return nil, Errors{{Clause: expr, Message: fmt.Sprintf("at most %d clauses are allowed", MaxClauses)}}
+	}
+
+	var conditions []bson.M
+	var errs Errors
+	for _, clause := range clauses {
+		if strings.TrimSpace(clause) == "" {
+			continue
+		}
+		condition, err := parseClause(clause, fields)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return conditions, nil
+}
+
+// Apply parses expr and adds its conditions to filter, combined with any conditions
+// already under $and
+func Apply(filter map[string]interface{}, expr string, fields Fields) error {
+	if strings.TrimSpace(expr) == "" {
+		return nil
+	}
+	conditions, err := Parse(expr, fields)
+	if err != nil {
+		return err
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	var and []interface{}
+	if existing, ok := filter["$and"].([]interface{}); ok {
+		and = existing
+	}
+	for _, condition := range conditions {
+		and = append(and, condition)
+	}
+	filter["$and"] = and
+	return nil
+}
+
+func parseClause(clause string, fields Fields) (bson.M, *Error) {
+	parts := splitN(clause, ':', 3)
+	if len(parts) != 3 {
+		return nil, &Error{Clause: clause, Message: "expected field:operator:value"}
+	}
+	name := strings.TrimSpace(unescape(parts[0]))
+	operator := Operator(strings.ToLower(strings.TrimSpace(parts[1])))
+	raw := parts[2]
+
+	field, ok := fields[name]
+	if !ok {
+		return nil, &Error{Clause: clause, Field: name, Message: fmt.Sprintf("cannot filter by %q, use one of %s", name, fields.names())}
+	}
+	if !supports(field.Type, operator) {
+		return nil, &Error{Clause: clause, Field: name, Message: fmt.Sprintf("operator %q is not supported for %s, use one of %s", operator, name, operatorNames(field.Type))}
+	}
+
+	switch operator {
+	case In, Nin:
+		items := split(raw, ',')
+		if len(items) > MaxListValues {
+			return nil, &Error{Clause: clause, Field: name, Message: fmt.Sprintf("at most %d values are allowed", MaxListValues)}
+		}
+		values := make(bson.A, 0, len(items))
+		for _, item := range items {
+			value, err := parseValue(field.Type, unescape(item))
+			if err != nil {
+				return nil, &Error{Clause: clause, Field: name, Message: err.Error()}
+			}
+			values = append(values, value)
+		}
+		return bson.M{field.Path: bson.M{"$" + string(operator): values}}, nil
+
+	case Exists:
+		exists, err := strconv.ParseBool(unescape(raw))
+		if err != nil {
+			return nil, &Error{Clause: clause, Field: name, Message: "exists takes true or false"}
+		}
+		return bson.M{field.Path: bson.M{"$exists": exists}}, nil
+
+	case Contains, StartsWith:
+		value := unescape(raw)
+		if err := checkLength(value); err != nil {
+			return nil, &Error{Clause: clause, Field: name, Message: err.Error()}
+		}
+		pattern := regexp.QuoteMeta(value)
+		if operator == StartsWith {
+			pattern = "^" + pattern
+		}
+		return bson.M{field.Path: bson.M{"$regex": pattern, "$options": "i"}}, nil
+
+	default:
+		value, err := parseValue(field.Type, unescape(raw))
+		if err != nil {
+			return nil, &Error{Clause: clause, Field: name, Message: err.Error()}
+		}
+		return bson.M{field.Path: bson.M{"$" + string(operator): value}}, nil
+	}
+}
+
+// parseValue converts a raw value to the Go type stored for fieldType
+func parseValue(fieldType Type, raw string) (interface{}, error) {
+	if err := checkLength(raw); err != nil {
+		return nil, err
+	}
+	switch fieldType {
+	case Number:
+		if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return n, nil
+		}
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+		return f, nil
+	case Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not true or false", raw)
+		}
+		return b, nil
+	case Date:
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+			if t, err := time.Parse(layout, raw); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a date, use YYYY-MM-DD or RFC 3339", raw)
+	case ObjectID:
+		id, err := primitive.ObjectIDFromHex(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid ID", raw)
+		}
+		return id, nil
+	default:
+		return raw, nil
+	}
+}
+
+func checkLength(value string) error {
+	if len(value) > MaxValueLength {
+		return fmt.Errorf("values must be at most %d characters", MaxValueLength)
+	}
+	return nil
+}
+
+func supports(fieldType Type, operator Operator) bool {
+	for _, supported := range operatorsByType[fieldType] {
+		if supported == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func operatorNames(fieldType Type) string {
+	names := make([]string, len(operatorsByType[fieldType]))
+	for i, operator := range operatorsByType[fieldType] {
+		names[i] = string(operator)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (f Fields) names() string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// split splits s on unescaped occurrences of sep, keeping escapes for unescape
+func split(s string, sep byte) []string {
+	return splitN(s, sep, -1)
+}
+
+// splitN is split returning at most n parts, the last holding the rest of s
+func splitN(s string, sep byte, n int) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if s[i] == sep && (n < 0 || len(parts) < n-1) {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unescape removes the backslashes escaping separators
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package listfilter
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var testFields = Fields{
+	"name":      {Path: "name", Type: String},
+	"city":      {Path: "address.city", Type: String},
+	"rating":    {Path: "rating", Type: Number},
+	"active":    {Path: "isActive", Type: Bool},
+	"createdAt": {Path: "createdAt", Type: Date},
+	"parentId":  {Path: "parentId", Type: ObjectID},
+}
+
+func TestParse(t *testing.T) {
+	id := primitive.NewObjectID()
+
+	tests := []struct {
+		expr string
+		want []bson.M
+	}{
+		{"name:eq:Goa", []bson.M{{"name": bson.M{"$eq": "Goa"}}}},
+		{"city:ne:Pune", []bson.M{{"address.city": bson.M{"$ne": "Pune"}}}},
+		{"rating:gte:4", []bson.M{{"rating": bson.M{"$gte": int64(4)}}}},
+		{"rating:lt:4.5", []bson.M{{"rating": bson.M{"$lt": 4.5}}}},
+		{"active:eq:true", []bson.M{{"isActive": bson.M{"$eq": true}}}},
+		{"createdAt:gte:2026-01-02", []bson.M{{"createdAt": bson.M{"$gte": time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}}}},
+		{"parentId:eq:" + id.Hex(), []bson.M{{"parentId": bson.M{"$eq": id}}}},
+		{"name:in:Goa,Pune", []bson.M{{"name": bson.M{"$in": bson.A{"Goa", "Pune"}}}}},
+		{"rating:nin:1,2", []bson.M{{"rating": bson.M{"$nin": bson.A{int64(1), int64(2)}}}}},
+		{"name:exists:false", []bson.M{{"name": bson.M{"$exists": false}}}},
+		{"name:contains:a.b", []bson.M{{"name": bson.M{"$regex": `a\.b`, "$options": "i"}}}},
+		{"name:startswith:Go", []bson.M{{"name": bson.M{"$regex": "^Go", "$options": "i"}}}},
+		{"name:EQ:Goa", []bson.M{{"name": bson.M{"$eq": "Goa"}}}},
+		{
+			"name:eq:Goa;rating:gt:3;",
+			[]bson.M{{"name": bson.M{"$eq": "Goa"}}, {"rating": bson.M{"$gt": int64(3)}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Parse(tt.expr, testFields)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEscapes(t *testing.T) {
+	got, err := Parse(`name:in:a\,b,c\;d;city:eq:x\:y`, testFields)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := []bson.M{
+		{"name": bson.M{"$in": bson.A{"a,b", "c;d"}}},
+		{"address.city": bson.M{"$eq": "x:y"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %v, want %v", got, want)
+	}
+}
+
+func TestParseValuesStayScalar(t *testing.T) {
+	got, err := Parse(`name:eq:{"$ne":null}`, testFields)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := []bson.M{{"name": bson.M{"$eq": `{"$ne":null}`}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %v, want %v", got, want)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := map[string]string{
+		"unknown field":        "password:eq:x",
+		"missing value":        "name:eq",
+		"unsupported operator": "active:gt:true",
+		"unknown operator":     "name:regex:.*",
+		"bad number":           "rating:eq:high",
+		"bad bool":             "active:eq:yes",
+		"bad date":             "createdAt:gte:yesterday",
+		"bad id":               "parentId:eq:123",
+		"bad exists":           "name:exists:maybe",
+		"value too long":       "name:eq:" + strings.Repeat("a", MaxValueLength+1),
+		"too many values":      "rating:in:" + strings.Repeat("1,", MaxListValues) + "1",
+		"too many clauses":     strings.Repeat("name:eq:a;", MaxClauses+1),
+	}
+	for name, expr := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(expr, testFields)
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("Parse(%q) = %v, want ErrInvalidFilter", expr, err)
+			}
+		})
+	}
+}
+
+func TestParseReportsEveryInvalidClause(t *testing.T) {
+	_, err := Parse("password:eq:x;name:eq:Goa;rating:eq:high", testFields)
+	var errs Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Parse = %v, want Errors", err)
+	}
+	if len(errs) != 2 || errs[0].Field != "password" || errs[1].Field != "rating" {
+		t.Errorf("errors = %v, want password and rating", errs)
+	}
+}
+
+func TestApply(t *testing.T) {
+	filter := map[string]interface{}{
+		"deletedAt": nil,
+		"$and":      []interface{}{bson.M{"type": "CITY"}},
+	}
+	if err := Apply(filter, "name:eq:Goa", testFields); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	want := []interface{}{bson.M{"type": "CITY"}, bson.M{"name": bson.M{"$eq": "Goa"}}}
+	if !reflect.DeepEqual(filter["$and"], want) {
+		t.Errorf("$and = %v, want %v", filter["$and"], want)
+	}
+	if _, ok := filter["deletedAt"]; !ok {
+		t.Error("Apply removed an existing condition")
+	}
+
+	empty := map[string]interface{}{}
+	if err := Apply(empty, "  ", testFields); err != nil || len(empty) != 0 {
+		t.Errorf("Apply with an empty expression = %v, filter %v", err, empty)
+	}
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -42,6 +43,9 @@ func GetAuthContext(ctx context.Context) *AuthContext {
 	return nil
 }
 
+// SetAuthContext stores the auth context, and the user as the actor of the changes the
+// request makes
 func SetAuthContext(ctx context.Context, authCtx *AuthContext) context.Context {
+	ctx = models.WithActor(ctx, authCtx.UserID.Hex())
 	return context.WithValue(ctx, "auth_context", authCtx)
 }
@@ -121,7 +121,7 @@ func inferActionFromMethod(method string, path string) string {
 		} else {
 			action = "create"
 		}
-	case http.MethodPut:
+	case http.MethodPut, http.MethodPatch:
 		action = "update"
 	case http.MethodDelete:
 		if strings.Contains(path, "hard-delete") {
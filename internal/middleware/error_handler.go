@@ -13,14 +13,18 @@ type ErrorCode string
 
 // Standard error codes
 const (
-	ErrorCodeInvalidRequest     ErrorCode = "INVALID_REQUEST"
-	ErrorCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
-	ErrorCodeForbidden          ErrorCode = "FORBIDDEN"
-	ErrorCodeNotFound           ErrorCode = "NOT_FOUND"
-	ErrorCodeConflict           ErrorCode = "CONFLICT"
-	ErrorCodeInternalServer     ErrorCode = "INTERNAL_SERVER_ERROR"
-	ErrorCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
-	ErrorCodeValidationFailed   ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeInvalidRequest       ErrorCode = "INVALID_REQUEST"
+	ErrorCodeUnauthorized         ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden            ErrorCode = "FORBIDDEN"
+	ErrorCodeNotFound             ErrorCode = "NOT_FOUND"
+	ErrorCodeConflict             ErrorCode = "CONFLICT"
+	ErrorCodeInternalServer       ErrorCode = "INTERNAL_SERVER_ERROR"
+	ErrorCodeServiceUnavailable   ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrorCodeValidationFailed     ErrorCode = "VALIDATION_FAILED"
+	ErrorCodePreconditionFailed   ErrorCode = "PRECONDITION_FAILED"
+	ErrorCodePreconditionRequired ErrorCode = "PRECONDITION_REQUIRED"
+	ErrorCodeUnsupportedMedia     ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrorCodeIdempotencyKeyReused ErrorCode = "IDEMPOTENCY_KEY_REUSED"
 )
 
 // AppError represents a standard application error
@@ -29,6 +33,7 @@ type AppError struct {
 	Message string
 	Err     error
 	Status  int
+	Details interface{} // optional structured details returned to the client
 }
 
 // Error implements the error interface
@@ -39,6 +44,13 @@ func (e *AppError) Error() string {
 	return e.Message
 }
 
+// WithDetails returns a copy of the error carrying structured details
+func (e *AppError) WithDetails(details interface{}) *AppError {
+	withDetails := *e
+	withDetails.Details = details
+	return &withDetails
+}
+
 // ErrorWithContext extends AppError with a request context
 type ErrorWithContext struct {
 	*AppError
@@ -78,53 +90,69 @@ func ErrorHandler() gin.HandlerFunc {
 
 		// If there are errors
 		if len(c.Errors) > 0 {
-			err := c.Errors.Last().Err
-			var appErr *AppError
-
-			// Check if it's already an AppError
-			if e, ok := err.(*AppError); ok {
-				appErr = e
-			} else if e, ok := err.(*ErrorWithContext); ok {
-				appErr = e.AppError
-			} else {
-				// Check if it matches any common errors
-				errorMsg := err.Error()
-				for key, commonErr := range CommonErrors {
-					if strings.Contains(errorMsg, key) {
-						appErr = commonErr
-						break
-					}
-				}
-
-				// If not found in common errors, use a generic one
-				if appErr == nil {
-					appErr = NewAppError(
-						ErrorCodeInternalServer,
-						"An unexpected error occurred",
-						err,
-						http.StatusInternalServerError,
-					)
-				}
-			}
+			appErr := errorResponse(c)
 
-			// If there was a custom status in the context, use it
-			if statusCode, exists := c.Get("ErrorStatusCode"); exists {
-				if code, ok := statusCode.(int); ok {
-					appErr.Status = code
-				}
-			}
+			// Abort with the error
+			c.AbortWithStatusJSON(appErr.Status, errorBody(appErr))
+		}
+	}
+}
 
-			// Create standard response
-			response := StandardResponse{
-				Success:      false,
-				StatusCode:   appErr.Status,
-				ErrorMessage: appErr.Message,
+// errorBody builds the standard response for appErr. Only errors with structured details
+// carry their code, other errors keep the plain message shape.
+func errorBody(appErr *AppError) StandardResponse {
+	response := StandardResponse{
+		Success:      false,
+		StatusCode:   appErr.Status,
+		ErrorMessage: appErr.Message,
+	}
+	if appErr.Details != nil {
+		response.ErrorCode = string(appErr.Code)
+		response.Details = appErr.Details
+	}
+	return response
+}
+
+// errorResponse converts the last error recorded on the context to an AppError
+func errorResponse(c *gin.Context) *AppError {
+	err := c.Errors.Last().Err
+	var appErr *AppError
+
+	// Check if it's already an AppError
+	if e, ok := err.(*AppError); ok {
+		appErr = e
+	} else if e, ok := err.(*ErrorWithContext); ok {
+		appErr = e.AppError
+	} else {
+		// Check if it matches any common errors
+		errorMsg := err.Error()
+		for key, commonErr := range CommonErrors {
+			if strings.Contains(errorMsg, key) {
+				appErr = commonErr
+				break
 			}
+		}
 
-			// Abort with the error
-			c.AbortWithStatusJSON(appErr.Status, response)
+		// If not found in common errors, use a generic one
+		if appErr == nil {
+			appErr = NewAppError(
+				ErrorCodeInternalServer,
+				"An unexpected error occurred",
+				err,
+				http.StatusInternalServerError,
+			)
+		}
+	}
+
+	// If there was a custom status in the context, use it
+	if statusCode, exists := c.Get("ErrorStatusCode"); exists {
+		if code, ok := statusCode.(int); ok {
+			withStatus := *appErr
+			withStatus.Status = code
+			appErr = &withStatus
 		}
 	}
+	return appErr
 }
 
 // HandleError helper function to handle errors in handlers
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// ETag formats a resource version as an entity tag
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// SetETag sets the ETag header for a resource version
+func SetETag(c *gin.Context, version int64) {
+	c.Header("ETag", ETag(version))
+}
+
+// NotModified sets the ETag header and reports whether If-None-Match names the current
+// version, in which case a 304 has been written and the handler should return
+func NotModified(c *gin.Context, version int64) bool {
+	SetETag(c, version)
+	if !matchesETag(c.GetHeader("If-None-Match"), version) {
+		return false
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// CheckIfMatch requires an If-Match header naming the current version of the resource,
+// so clients cannot overwrite changes they have not seen. It returns a 428 error when
+// the header is missing and a 412 error when the resource has changed.
+func CheckIfMatch(c *gin.Context, version int64) *AppError {
+	ifMatch := c.GetHeader("If-Match")
+	if strings.TrimSpace(ifMatch) == "" {
+		return NewAppError(ErrorCodePreconditionRequired,
+			"If-Match header is required, send the ETag of the resource you are updating",
+			nil, http.StatusPreconditionRequired)
+	}
+	if !matchesETag(ifMatch, version) {
+		return preconditionFailed()
+	}
+	return nil
+}
+
+// CheckIfMatchIfPresent checks If-Match only when the client sent one. Partial updates
+// use it, as they only write the fields they change and the write is still refused if
+// the resource changed after it was read.
+func CheckIfMatchIfPresent(c *gin.Context, version int64) *AppError {
+	if strings.TrimSpace(c.GetHeader("If-Match")) == "" {
+		return nil
+	}
+	return CheckIfMatch(c, version)
+}
+
+// NewVersionConflictAppError maps a lost optimistic concurrency race to 412, falling back to a server error
+func NewVersionConflictAppError(err error, fallback string) *AppError {
+	if errors.Is(err, models.ErrVersionConflict) {
+		return preconditionFailed()
+	}
+	return NewAppError(ErrorCodeInternalServer, fallback, err, http.StatusInternalServerError)
+}
+
+func preconditionFailed() *AppError {
+	return NewAppError(ErrorCodePreconditionFailed,
+		"The resource was modified by another request, fetch it again and retry",
+		nil, http.StatusPreconditionFailed)
+}
+
+// matchesETag reports whether a comma separated list of entity tags contains version or
+// "*". Weak tags are compared by value, since proxies that compress responses weaken them.
+func matchesETag(header string, version int64) bool {
+	want := ETag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == want {
+			return true
+		}
+	}
+	return false
+}
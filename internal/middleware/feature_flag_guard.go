@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+)
+
+// FeatureFlagChecker resolves whether a feature flag is enabled for an organization
+type FeatureFlagChecker interface {
+	IsEnabled(ctx context.Context, organizationID string, flag string) (bool, error)
+}
+
+// RequireFeatureFlag blocks the request unless flag is enabled for the caller's organization.
+// Platform admins act across organizations and are not held to any one tenant's flags.
+func RequireFeatureFlag(checker FeatureFlagChecker, flag string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authCtx := GetAuthContext(c.Request.Context()); authCtx != nil &&
+			(authCtx.Role == "PLATFORM_ADMIN" || authCtx.RoleScope == roleEntity.RoleScopeGlobal) {
+			c.Next()
+			return
+		}
+
+		organizationID := ""
+		if rbacContext, err := GetScopedRBACContext(c); err == nil {
+			organizationID = rbacContext.OrganizationID
+		} else if authCtx := GetAuthContext(c.Request.Context()); authCtx != nil && authCtx.OrganizationID != nil {
+			organizationID = authCtx.OrganizationID.Hex()
+		}
+
+		enabled, err := checker.IsEnabled(c.Request.Context(), organizationID, flag)
+		if err != nil {
+			logger.Log.Error("Failed to evaluate feature flag",
+				zap.String("flag", flag),
+				zap.String("organization_id", organizationID),
+				zap.Error(err))
+			HandleError(c, NewAppError(
+				ErrorCodeInternalServer,
+				"Failed to evaluate feature flag",
+				err,
+				http.StatusInternalServerError,
+			))
+			c.Abort()
+			return
+		}
+
+		if !enabled {
+			HandleError(c, NewAppError(
+				ErrorCodeForbidden,
+				"This feature is not enabled for your organization",
+				nil,
+				http.StatusForbidden,
+			))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,265 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header that makes a POST or bulk request safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from an earlier request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodyBytes caps the request bodies buffered to fingerprint them
+	maxIdempotentBodyBytes = 10 << 20
+)
+
+// replayedHeaders are the response headers stored with a response and replayed with it
+var replayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// Idempotency makes POST and bulk requests sent with an Idempotency-Key header safe to
+// retry. The first successful response is stored per user and key for ttl and replayed
+// to retries of the same request. A retry with a different method, path, query or body
+// under the same key is refused with 422, and a retry that arrives while the first
+// request is still running is refused with 409. Failed requests are not stored, so they
+// can be retried. Bodies over 10 MiB are refused with 413, except multipart uploads,
+// which are spooled to a temporary file instead of memory. It must run after
+// AuthMiddleware.
+func Idempotency(store services.IdempotencyStore, ttl, lockTTL time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+		if key == "" || !idempotentRoute(c) {
+			c.Next()
+			return
+		}
+
+		authCtx := GetAuthContext(c.Request.Context())
+		if authCtx == nil {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithError(c, NewAppError(ErrorCodeInvalidRequest,
+				"Idempotency-Key must be at most 255 characters", nil, http.StatusBadRequest))
+			return
+		}
+
+		var bodySum []byte
+		if isMultipart(c.Request) {
+			sum, cleanup, err := spoolBody(c.Request)
+			if err != nil {
+				abortWithError(c, NewAppError(ErrorCodeInvalidRequest, "Failed to read request body", err, http.StatusBadRequest))
+				return
+			}
+			defer cleanup()
+			bodySum = sum
+		} else {
+			body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxIdempotentBodyBytes))
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				abortWithError(c, NewAppError(ErrorCodeInvalidRequest,
+					"Request body is too large to be sent with an Idempotency-Key", err, http.StatusRequestEntityTooLarge))
+				return
+			}
+			if err != nil {
+				abortWithError(c, NewAppError(ErrorCodeInvalidRequest, "Failed to read request body", err, http.StatusBadRequest))
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+			sum := sha256.Sum256(body)
+			bodySum = sum[:]
+		}
+
+		ctx := c.Request.Context()
+		storeKey := authCtx.UserID.Hex() + ":" + hashHex([]byte(key))
+		fingerprint := idempotencyFingerprint(c.Request, authCtx, bodySum)
+
+		// The record is read again under the lock, as the first request may have finished
+		// between the two reads
+		if replayed, err := replayIdempotent(c, store, storeKey, fingerprint); replayed || err != nil {
+			return
+		}
+		token, acquired, err := store.Lock(ctx, storeKey, lockTTL)
+		if err != nil {
+			abortWithError(c, idempotencyUnavailable(err))
+			return
+		}
+		if !acquired {
+			c.Header("Retry-After", "1")
+			abortWithError(c, NewAppError(ErrorCodeConflict,
+				"A request with this Idempotency-Key is still being processed, retry shortly",
+				nil, http.StatusConflict))
+			return
+		}
+		defer func() {
+			// The request context may be canceled by now, the lock must still be released
+			if err := store.Unlock(context.WithoutCancel(ctx), storeKey, token); err != nil {
+				logger.Log.Warn("Failed to release idempotency lock", zap.Error(err))
+			}
+		}()
+		if replayed, err := replayIdempotent(c, store, storeKey, fingerprint); replayed || err != nil {
+			return
+		}
+
+		recorder := &idempotencyRecorder{ResponseWriter: c.Writer, body: new(bytes.Buffer)}
+		c.Writer = recorder
+		c.Next()
+		c.Writer = recorder.ResponseWriter
+
+		status := recorder.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if recorder.streaming || len(c.Errors) > 0 || status < 200 || status >= 300 {
+			return
+		}
+
+		header := http.Header{}
+		for _, name := range replayedHeaders {
+			if value := recorder.Header().Get(name); value != "" {
+				header.Set(name, value)
+			}
+		}
+		record := &services.IdempotencyRecord{
+			Fingerprint: fingerprint,
+			StatusCode:  status,
+			Header:      header,
+			Body:        recorder.body.Bytes(),
+			CreatedAt:   time.Now(),
+		}
+		if err := store.Save(context.WithoutCancel(ctx), storeKey, record, ttl); err != nil {
+			logger.Log.Error("Failed to store idempotent response", zap.Error(err))
+		}
+	}
+}
+
+// idempotentRoute reports whether the matched route accepts an Idempotency-Key: every
+// POST and every bulk route
+func idempotentRoute(c *gin.Context) bool {
+	return c.Request.Method == http.MethodPost || strings.Contains(c.FullPath(), "/bulk")
+}
+
+// idempotencyFingerprint identifies a request by its principal, method, path, query and
+// the SHA-256 of its body
+func idempotencyFingerprint(r *http.Request, authCtx *AuthContext, bodySum []byte) string {
+	principal := authCtx.UserID.Hex()
+	if authCtx.OrganizationID != nil {
+		principal += "@" + authCtx.OrganizationID.Hex()
+	}
+	request := r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n" + principal + "\n"
+	return hashHex([]byte(request), bodySum)
+}
+
+// spoolBody copies the body of r to a temporary file, which then serves as the body, and
+// returns the SHA-256 of the content. cleanup removes the file.
+func spoolBody(r *http.Request) (sum []byte, cleanup func(), err error) {
+	file, err := os.CreateTemp("", "idempotent-body-*")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup = func() {
+		file.Close()
+		os.Remove(file.Name())
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), r.Body); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	r.Body.Close()
+	r.Body = file
+	return hash.Sum(nil), cleanup, nil
+}
+
+func isMultipart(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/")
+}
+
+// replayIdempotent writes the stored response for key, if there is one, and aborts the
+// request. It reports whether the request was answered, with an error when that answer
+// is an error response.
+func replayIdempotent(c *gin.Context, store services.IdempotencyStore, key, fingerprint string) (bool, error) {
+	record, err := store.Get(c.Request.Context(), key)
+	if err != nil {
+		abortWithError(c, idempotencyUnavailable(err))
+		return false, err
+	}
+	if record == nil {
+		return false, nil
+	}
+	if record.Fingerprint != fingerprint {
+		appErr := NewAppError(ErrorCodeIdempotencyKeyReused,
+			"Idempotency-Key was already used for a different request", nil, http.StatusUnprocessableEntity)
+		abortWithError(c, appErr)
+		return false, appErr
+	}
+
+	for name, values := range record.Header {
+		for _, value := range values {
+			c.Writer.Header().Add(name, value)
+		}
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Writer.WriteHeader(record.StatusCode)
+	c.Writer.Write(record.Body)
+	c.Abort()
+	return true, nil
+}
+
+func idempotencyUnavailable(err error) *AppError {
+	return NewAppError(ErrorCodeServiceUnavailable,
+		"Idempotency-Key cannot be checked right now, retry the request with the same key", err, http.StatusServiceUnavailable)
+}
+
+func abortWithError(c *gin.Context, err *AppError) {
+	HandleError(c, err)
+	c.Abort()
+}
+
+func hashHex(parts ...[]byte) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write(part)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// idempotencyRecorder passes the response through while keeping a copy of the body
+type idempotencyRecorder struct {
+	gin.ResponseWriter
+	body      *bytes.Buffer
+	streaming bool
+}
+
+// Write records the body and passes it on
+func (w *idempotencyRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteString records the body and passes it on
+func (w *idempotencyRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestIdempotencyFingerprint(t *testing.T) {
+	orgID := primitive.NewObjectID()
+	auth := &AuthContext{UserID: primitive.NewObjectID(), OrganizationID: &orgID}
+	sum := sha256.Sum256([]byte(`{"name":"Goa"}`))
+	body := sum[:]
+	otherSum := sha256.Sum256([]byte(`{"name":"Pune"}`))
+	request := func(method, target string) *http.Request {
+		return httptest.NewRequest(method, target, nil)
+	}
+	base := idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=true"), auth, body)
+
+	if got := idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=true"), auth, body); got != base {
+		t.Errorf("fingerprint of the same request changed: %s, want %s", got, base)
+	}
+
+	otherOrgID := primitive.NewObjectID()
+	differing := map[string]string{
+		"method": idempotencyFingerprint(request(http.MethodPut, "/api/v1/locations?notify=true"), auth, body),
+		"path":   idempotencyFingerprint(request(http.MethodPost, "/api/v1/users?notify=true"), auth, body),
+		"query":  idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=false"), auth, body),
+		"body":   idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=true"), auth, otherSum[:]),
+		"user": idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=true"),
+			&AuthContext{UserID: primitive.NewObjectID(), OrganizationID: &orgID}, body),
+		"organization": idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=true"),
+			&AuthContext{UserID: auth.UserID, OrganizationID: &otherOrgID}, body),
+		"no organization": idempotencyFingerprint(request(http.MethodPost, "/api/v1/locations?notify=true"),
+			&AuthContext{UserID: auth.UserID}, body),
+	}
+	for name, got := range differing {
+		if got == base {
+			t.Errorf("fingerprint ignores the %s", name)
+		}
+	}
+}
+
+func TestSpoolBodyHashesContent(t *testing.T) {
+	upload := func(content string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/uploads", strings.NewReader(content))
+		r.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+		return r
+	}
+	spool := func(r *http.Request) []byte {
+		t.Helper()
+		sum, cleanup, err := spoolBody(r)
+		if err != nil {
+			t.Fatalf("spoolBody: %v", err)
+		}
+		t.Cleanup(cleanup)
+		return sum
+	}
+
+	first := upload("same size, file A")
+	firstSum := spool(first)
+	if string(spool(upload("same size, file A"))) != string(firstSum) {
+		t.Error("the same body hashed differently")
+	}
+	if string(spool(upload("same size, file B"))) == string(firstSum) {
+		t.Error("bodies of the same size with different content hashed the same")
+	}
+
+	content, err := io.ReadAll(first.Body)
+	if err != nil || string(content) != "same size, file A" {
+		t.Errorf("spooled body reads %q, %v, want the original content", content, err)
+	}
+}
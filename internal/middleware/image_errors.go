@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+)
+
+// NewImageAppError maps image pipeline errors to client errors, falling back to a server error
+func NewImageAppError(err error, fallback string) *AppError {
+	switch {
+	case errors.Is(err, services.ErrUnsupportedImage),
+		errors.Is(err, services.ErrSVGNotAllowed),
+		errors.Is(err, services.ErrUnsafeSVG):
+		return NewAppError(ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+	case errors.Is(err, services.ErrImageDimensionsTooLarge):
+		return NewAppError(ErrorCodeValidationFailed, err.Error(), nil, http.StatusRequestEntityTooLarge)
+	default:
+		return NewAppError(ErrorCodeInternalServer, fallback, err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+)
+
+// NewListAppError maps invalid sort, cursor, count, fields and expand parameters to 400
+// and invalid filter expressions to a validation failure listing each bad clause, falling
+// back to a server error
+func NewListAppError(err error, fallback string) *AppError {
+	var filterErrs listfilter.Errors
+	if errors.As(err, &filterErrs) {
+		return NewAppError(ErrorCodeValidationFailed, "Invalid filter expression", nil, http.StatusBadRequest).
+			WithDetails(filterErrs)
+	}
+	if errors.Is(err, pagination.ErrInvalidQuery) || errors.Is(err, models.ErrInvalidCursor) ||
+		errors.Is(err, fieldset.ErrInvalidFields) {
+		return NewAppError(ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest)
+	}
+	return NewAppError(ErrorCodeInternalServer, fallback, err, http.StatusInternalServerError)
+}
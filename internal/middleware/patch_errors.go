@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+)
+
+// NewPatchAppError maps errors of applying a merge patch or JSON patch to responses,
+// falling back to a server error. Unsupported content types also advertise the
+// supported ones in the Accept-Patch header.
+func NewPatchAppError(c *gin.Context, err error, fallback string) *AppError {
+	switch {
+	case errors.Is(err, patch.ErrUnsupportedMediaType):
+		c.Header("Accept-Patch", patch.AcceptPatch)
+		return NewAppError(ErrorCodeUnsupportedMedia, err.Error(), nil, http.StatusUnsupportedMediaType)
+	case errors.Is(err, patch.ErrTestFailed):
+		return NewAppError(ErrorCodeConflict, err.Error(), nil, http.StatusConflict)
+	case errors.Is(err, patch.ErrInvalidPatch):
+		return NewAppError(ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest)
+	default:
+		return NewAppError(ErrorCodeInternalServer, fallback, err, http.StatusInternalServerError)
+	}
+}
@@ -14,6 +14,8 @@ type StandardResponse struct {
 	Success      bool        `json:"success"`
 	StatusCode   int         `json:"statusCode"`
 	ErrorMessage string      `json:"errorMessage,omitempty"`
+	ErrorCode    string      `json:"errorCode,omitempty"`
+	Details      interface{} `json:"details,omitempty"`
 	Data         interface{} `json:"data,omitempty"`
 }
 
@@ -39,12 +41,34 @@ func ResponseInterceptor() gin.HandlerFunc {
 		// Process the request
 		c.Next()
 
+		// Streaming handlers have already written straight to the client
+		if bodyCapture.streaming {
+			return
+		}
+
 		// Get status code and headers
 		statusCode := bodyCapture.status
 		if statusCode == 0 {
 			statusCode = http.StatusOK
 		}
 
+		// Not modified responses have no body to wrap
+		if statusCode == http.StatusNotModified {
+			originalWriter.WriteHeader(statusCode)
+			return
+		}
+
+		// Errors recorded with HandleError are rendered here, as this writer is the one
+		// that reaches the client
+		if len(c.Errors) > 0 {
+			appErr := errorResponse(c)
+			originalWriter.Header().Set("Content-Type", "application/json")
+			jsonResponse, _ := json.Marshal(errorBody(appErr))
+			originalWriter.WriteHeader(appErr.Status)
+			originalWriter.Write(jsonResponse)
+			return
+		}
+
 		// Check if this is a success response based on status code
 		success := statusCode >= 200 && statusCode < 300
 
@@ -118,12 +142,8 @@ func ResponseInterceptor() gin.HandlerFunc {
 			Data:         responseData,
 		}
 
-		// Write to the original writer
-		for k, values := range bodyCapture.Header() {
-			for _, v := range values {
-				originalWriter.Header().Add(k, v)
-			}
-		}
+		// Write to the original writer. Headers set by the handler are already on it,
+		// since the capture shares its header map.
 		originalWriter.Header().Set("Content-Type", "application/json")
 		jsonResponse, _ := json.Marshal(response)
 		originalWriter.WriteHeader(statusCode)
@@ -131,26 +151,54 @@ func ResponseInterceptor() gin.HandlerFunc {
 	}
 }
 
+// EnableStreaming lets the current handler write its body directly to the client,
+// skipping the standard response envelope. Use it for file downloads and exports.
+func EnableStreaming(c *gin.Context) {
+	writer := c.Writer
+	for {
+		switch w := writer.(type) {
+		case *idempotencyRecorder:
+			w.streaming = true
+			writer = w.ResponseWriter
+		case *responseCapture:
+			w.streaming = true
+			return
+		default:
+			return
+		}
+	}
+}
+
 // responseCapture is a ResponseWriter that captures the response
 type responseCapture struct {
 	gin.ResponseWriter
-	body   *bytes.Buffer
-	status int
+	body      *bytes.Buffer
+	status    int
+	streaming bool
 }
 
 // Write captures the response but doesn't write it
 func (w *responseCapture) Write(b []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(b)
+	}
 	return w.body.Write(b)
 }
 
 // WriteString captures the response but doesn't write it
 func (w *responseCapture) WriteString(s string) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.WriteString(s)
+	}
 	return w.body.WriteString(s)
 }
 
 // WriteHeader captures the status code but doesn't write it
 func (w *responseCapture) WriteHeader(code int) {
 	w.status = code
+	if w.streaming {
+		w.ResponseWriter.WriteHeader(code)
+	}
 }
 
 // Status returns the captured status code
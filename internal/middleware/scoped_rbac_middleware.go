@@ -190,6 +190,11 @@ func GetScopedRBACContext(c *gin.Context) (*ScopedRBACContext, error) {
 	return rbacContext, nil
 }
 
+// HasPermission reports whether the user has the action on the resource
+func (r *ScopedRBACContext) HasPermission(resource, action string) bool {
+	return hasPermission(r.Permissions, resource+":"+action)
+}
+
 // hasPermission checks if user has a specific permission
 func hasPermission(userPermissions []string, requiredPermission string) bool {
 	for _, perm := range userPermissions {
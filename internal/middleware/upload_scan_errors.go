@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+)
+
+// NewUploadScanAppError maps upload scanner errors to responses. Rejections become
+// VALIDATION_FAILED with one detail entry per rejected file.
+func NewUploadScanAppError(err error, fallback string) *AppError {
+	var scanErr *services.ScanError
+	var scanErrs services.ScanErrors
+	switch {
+	case errors.As(err, &scanErrs):
+		return NewAppError(ErrorCodeValidationFailed, "One or more files were rejected", nil, http.StatusUnprocessableEntity).
+			WithDetails(scanErrs)
+	case errors.As(err, &scanErr):
+		return NewAppError(ErrorCodeValidationFailed, scanErr.Error(), nil, http.StatusUnprocessableEntity).
+			WithDetails(services.ScanErrors{scanErr})
+	case errors.Is(err, services.ErrScannerUnavailable):
+		return NewAppError(ErrorCodeServiceUnavailable, "Uploads cannot be checked right now, please try again later", err, http.StatusServiceUnavailable)
+	default:
+		return NewAppError(ErrorCodeInternalServer, fallback, err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func init() {
+	register("Drop indexes that conflict with the baseline indexes", dropLegacyIndexes, nil)
+}
+
+// legacyIndexes were created by earlier builds with names or partial filters the
+// baseline indexes replace
+var legacyIndexes = map[string][]string{
+	"organizations": {"idx_deleted_at", "idx_slug"},
+	"permissions":   {"unique_resource_action_scope"},
+	"locations":     {"idx_name_type"},
+	"users":         {"idx_primary_email"},
+}
+
+func dropLegacyIndexes(ctx context.Context, env *Env) error {
+	for collection, names := range legacyIndexes {
+		for _, name := range names {
+			if err := dropIndexIfExists(ctx, env.DB.Collection(collection), name); err != nil {
+				return fmt.Errorf("failed to drop index %s on %s: %w", name, collection, err)
+			}
+		}
+	}
+	return nil
+}
+
+// dropIndexIfExists drops the named index, doing nothing when the collection or index is missing
+func dropIndexIfExists(ctx context.Context, coll *mongo.Collection, name string) error {
+	cursor, err := coll.Indexes().List(ctx)
+	if err != nil {
+		return err
+	}
+	var indexes []bson.M
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return err
+	}
+	for _, index := range indexes {
+		if index["name"] == name {
+			if _, err := coll.Indexes().DropOne(ctx, name); err != nil {
+				return err
+			}
+			log.Printf("Dropped index %s on %s", name, coll.Name())
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	locMigrations "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/migrations"
+)
+
+func init() {
+	register("Rewrite legacy {lat, lng} location coordinates as GeoJSON points", migrateLocationCoordinates, nil)
+}
+
+func migrateLocationCoordinates(ctx context.Context, env *Env) error {
+	migrated, err := locMigrations.MigrateLocationCoordinates(ctx, env.DB.Collection("locations"))
+	if err != nil {
+		return err
+	}
+	log.Printf("Migrated %d location coordinates to GeoJSON", migrated)
+	return nil
+}
@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	locMigrations "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/migrations"
+)
+
+func init() {
+	register("Backfill the denormalized location search fields", backfillLocationSearchFields, nil)
+}
+
+func backfillLocationSearchFields(ctx context.Context, env *Env) error {
+	backfilled, err := locMigrations.BackfillLocationSearchFields(ctx, env.DB.Collection("locations"))
+	if err != nil {
+		return err
+	}
+	log.Printf("Backfilled search fields for %d locations", backfilled)
+	return nil
+}
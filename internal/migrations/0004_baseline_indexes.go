@@ -0,0 +1,694 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	register("Create the indexes of every collection", createBaselineIndexes, dropBaselineIndexes)
+}
+
+// baselineIndexes are the indexes each module defined when migrations were introduced,
+// previously created by the datasources on startup. They are spelled out here so this
+// migration, and its checksum, never change; later index changes belong in new migrations.
+var baselineIndexes = []struct {
+	collection string
+	models     []mongo.IndexModel
+}{
+	{"organizations", []mongo.IndexModel{
+		// Unique index on slug for active organizations
+		{
+			Keys: bson.D{{Key: "slug", Value: 1}},
+			Options: options.Index().
+				SetName("idx_slug_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "deletedAt", Value: nil}}),
+		},
+		// Index on email for lookups
+		{
+			Keys:    bson.D{{Key: "email", Value: 1}},
+			Options: options.Index().SetName("idx_email"),
+		},
+		// Index on type for filtering by organization type
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}},
+			Options: options.Index().SetName("idx_type"),
+		},
+
+		// Index on status for filtering by status
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}},
+			Options: options.Index().SetName("idx_status"),
+		},
+
+		// Index on deletedAt for soft delete queries (sparse)
+		{
+			Keys:    bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().SetName("idx_deletedAt").SetSparse(true),
+		},
+		// Text index for name and email search
+		{
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "email", Value: "text"},
+			},
+			Options: options.Index().SetName("idx_name_email_text"),
+		},
+		// Index on address fields for location-based queries
+		{
+			Keys: bson.D{
+				{Key: "address.country", Value: 1},
+				{Key: "address.state", Value: 1},
+				{Key: "address.city", Value: 1},
+			},
+			Options: options.Index().SetName("idx_address_location"),
+		},
+
+		// Default sort index for created date
+		{
+			Keys:    bson.D{{Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_created_desc"),
+		},
+
+		// Index on updatedAt for sorting
+		{
+			Keys:    bson.D{{Key: "updatedAt", Value: -1}},
+			Options: options.Index().SetName("idx_updated_desc"),
+		},
+
+		// Compound index for common queries (type + status)
+		{
+			Keys: bson.D{
+				{Key: "type", Value: 1},
+				{Key: "status", Value: 1},
+			},
+			Options: options.Index().SetName("idx_type_status"),
+		},
+		// Compound index for active organizations (status + deletedAt)
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "deletedAt", Value: 1},
+			},
+			Options: options.Index().SetName("idx_active_organizations"),
+		},
+
+		// Index on phone for lookups
+		{
+			Keys:    bson.D{{Key: "phone", Value: 1}},
+			Options: options.Index().SetName("idx_phone"),
+		},
+
+		// Index on website for lookups
+		{
+			Keys:    bson.D{{Key: "website", Value: 1}},
+			Options: options.Index().SetName("idx_website"),
+		},
+
+		// Compound index for pagination with filters
+		{
+			Keys: bson.D{
+				{Key: "type", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("idx_type_created_pagination"),
+		},
+
+		// Compound index for status-based pagination
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("idx_status_created_pagination"),
+		},
+	}},
+	{"organization_settings", []mongo.IndexModel{
+		// One settings document per organization
+		{
+			Keys:    bson.D{{Key: "organizationId", Value: 1}},
+			Options: options.Index().SetName("idx_organizationId_unique").SetUnique(true),
+		},
+	}},
+	{"feature_flag_overrides", []mongo.IndexModel{
+		// One override per flag
+		{
+			Keys:    bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetName("idx_key_unique").SetUnique(true),
+		},
+	}},
+	{"users", []mongo.IndexModel{
+		// Unique index on primary email for active users
+		{
+			Keys: bson.D{{Key: "emails.0", Value: 1}}, // First email is primary
+			Options: options.Index().
+				SetName("idx_primary_email_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "deletedAt", Value: nil}}),
+		},
+
+		// Index on all emails for lookups
+		{
+			Keys:    bson.D{{Key: "emails", Value: 1}},
+			Options: options.Index().SetName("idx_emails"),
+		},
+
+		// Index on all phones for lookups
+		{
+			Keys:    bson.D{{Key: "phones", Value: 1}},
+			Options: options.Index().SetName("idx_phones"),
+		},
+
+		// Index on roleId for role-based queries
+		{
+			Keys:    bson.D{{Key: "roleId", Value: 1}},
+			Options: options.Index().SetName("idx_roleId"),
+		},
+
+		// Index on organizationId for organization-based queries
+		{
+			Keys:    bson.D{{Key: "organizationId", Value: 1}},
+			Options: options.Index().SetName("idx_organizationId"),
+		},
+
+		// Index on status for filtering by status
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}},
+			Options: options.Index().SetName("idx_status"),
+		},
+
+		// Index on deletedAt for soft delete queries (sparse)
+		{
+			Keys:    bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().SetName("idx_deletedAt").SetSparse(true),
+		},
+
+		// Text index for fullName and email search
+		{
+			Keys: bson.D{
+				{Key: "fullName", Value: "text"},
+				{Key: "emails", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("idx_fullName_emails_text").
+				SetWeights(bson.D{
+					{Key: "fullName", Value: 10},
+					{Key: "emails", Value: 8},
+				}),
+		},
+
+		// Index on createdAt for sorting
+		{
+			Keys:    bson.D{{Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_created_desc"),
+		},
+
+		// Index on updatedAt for sorting
+		{
+			Keys:    bson.D{{Key: "updatedAt", Value: -1}},
+			Options: options.Index().SetName("idx_updated_desc"),
+		},
+
+		// Compound index for role and organization queries
+		{
+			Keys: bson.D{
+				{Key: "roleId", Value: 1},
+				{Key: "organizationId", Value: 1},
+			},
+			Options: options.Index().SetName("idx_role_organization"),
+		},
+
+		// Compound index for status and organization queries
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "organizationId", Value: 1},
+			},
+			Options: options.Index().SetName("idx_status_organization"),
+		},
+
+		// Compound index for active users (status + deletedAt)
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "deletedAt", Value: 1},
+			},
+			Options: options.Index().SetName("idx_active_users"),
+		},
+
+		// Index on last login for audit trail queries
+		{
+			Keys:    bson.D{{Key: "auditTrail.lastLoginAt", Value: -1}},
+			Options: options.Index().SetName("idx_last_login").SetSparse(true),
+		},
+
+		// Compound index for pagination with role filter
+		{
+			Keys: bson.D{
+				{Key: "roleId", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("idx_role_created_pagination"),
+		},
+
+		// Compound index for pagination with organization filter
+		{
+			Keys: bson.D{
+				{Key: "organizationId", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("idx_org_created_pagination"),
+		},
+
+		// Compound index for pagination with status filter
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().SetName("idx_status_created_pagination"),
+		},
+
+		// Index for login method queries
+		{
+			Keys:    bson.D{{Key: "loginMethods.passwordLogin.enabled", Value: 1}},
+			Options: options.Index().SetName("idx_password_login_enabled"),
+		},
+
+		{
+			Keys:    bson.D{{Key: "loginMethods.emailOtpLogin.enabled", Value: 1}},
+			Options: options.Index().SetName("idx_email_otp_enabled"),
+		},
+
+		{
+			Keys:    bson.D{{Key: "loginMethods.phoneOtpLogin.enabled", Value: 1}},
+			Options: options.Index().SetName("idx_phone_otp_enabled"),
+		},
+	}},
+	{"permissions", []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: 1},
+				{Key: "action", Value: 1},
+				{Key: "scope", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true).
+				SetName("unique_resource_action_scope").
+				SetPartialFilterExpression(bson.D{
+					{Key: "deletedAt", Value: nil},
+				}),
+		},
+
+		// Index on resource for resource-based queries
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: 1},
+			},
+			Options: options.Index().
+				SetName("resource_lookup"),
+		},
+
+		// Index on action for action-based queries
+		{
+			Keys: bson.D{
+				{Key: "action", Value: 1},
+			},
+			Options: options.Index().
+				SetName("action_lookup"),
+		},
+
+		// Index on scope for scope-based queries
+		{
+			Keys: bson.D{
+				{Key: "scope", Value: 1},
+			},
+			Options: options.Index().
+				SetName("scope_lookup"),
+		},
+
+		// Index on enabled for filtering active/inactive permissions
+		{
+			Keys: bson.D{
+				{Key: "enabled", Value: 1},
+			},
+			Options: options.Index().
+				SetName("enabled_filter"),
+		},
+
+		// Index on priority for sorting and priority-based queries
+		{
+			Keys: bson.D{
+				{Key: "priority", Value: -1}, // Descending for higher priority first
+			},
+			Options: options.Index().
+				SetName("priority_sort"),
+		},
+
+		// Compound index for resource-action queries (common use case)
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: 1},
+				{Key: "action", Value: 1},
+			},
+			Options: options.Index().
+				SetName("resource_action_lookup"),
+		},
+
+		// Compound index for resource-scope queries
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: 1},
+				{Key: "scope", Value: 1},
+			},
+			Options: options.Index().
+				SetName("resource_scope_lookup"),
+		},
+
+		// Compound index for action-scope queries
+		{
+			Keys: bson.D{
+				{Key: "action", Value: 1},
+				{Key: "scope", Value: 1},
+			},
+			Options: options.Index().
+				SetName("action_scope_lookup"),
+		},
+
+		// Text index for searching resource and notes
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: "text"},
+				{Key: "notes", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("text_search").
+				SetWeights(bson.D{
+					{Key: "resource", Value: 10},
+					{Key: "notes", Value: 5},
+				}),
+		},
+
+		// Index on createdAt for sorting and date filtering
+		{
+			Keys: bson.D{
+				{Key: "createdAt", Value: -1},
+			},
+			Options: options.Index().
+				SetName("createdAt_desc"),
+		},
+
+		// Index on updatedAt for sorting and date filtering
+		{
+			Keys: bson.D{
+				{Key: "updatedAt", Value: -1},
+			},
+			Options: options.Index().
+				SetName("updatedAt_desc"),
+		},
+
+		// Index on deletedAt for soft delete filtering
+		{
+			Keys: bson.D{
+				{Key: "deletedAt", Value: 1},
+			},
+			Options: options.Index().
+				SetName("deletedAt_filter").
+				SetSparse(true),
+		},
+
+		// Compound index for active permissions (enabled and not deleted)
+		{
+			Keys: bson.D{
+				{Key: "enabled", Value: 1},
+				{Key: "deletedAt", Value: 1},
+			},
+			Options: options.Index().
+				SetName("active_permissions_filter"),
+		},
+
+		// Compound index for permission evaluation (resource, action, scope, enabled, priority)
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: 1},
+				{Key: "action", Value: 1},
+				{Key: "scope", Value: 1},
+				{Key: "enabled", Value: 1},
+				{Key: "priority", Value: -1},
+			},
+			Options: options.Index().
+				SetName("permission_evaluation"),
+		},
+
+		// Compound index for efficient pagination with resource filter
+		{
+			Keys: bson.D{
+				{Key: "resource", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().
+				SetName("resource_created_pagination"),
+		},
+
+		// Compound index for efficient pagination with action filter
+		{
+			Keys: bson.D{
+				{Key: "action", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().
+				SetName("action_created_pagination"),
+		},
+
+		// Compound index for efficient pagination with scope filter
+		{
+			Keys: bson.D{
+				{Key: "scope", Value: 1},
+				{Key: "createdAt", Value: -1},
+				{Key: "_id", Value: 1},
+			},
+			Options: options.Index().
+				SetName("scope_created_pagination"),
+		},
+
+		// Compound index for priority range queries with enabled filter
+		{
+			Keys: bson.D{
+				{Key: "enabled", Value: 1},
+				{Key: "priority", Value: -1},
+			},
+			Options: options.Index().
+				SetName("enabled_priority_filter"),
+		},
+
+		// Compound index for complex permission queries with all filters
+		{
+			Keys: bson.D{
+				{Key: "enabled", Value: 1},
+				{Key: "resource", Value: 1},
+				{Key: "action", Value: 1},
+				{Key: "scope", Value: 1},
+				{Key: "priority", Value: -1},
+				{Key: "createdAt", Value: -1},
+			},
+			Options: options.Index().
+				SetName("complex_permission_query"),
+		},
+	}},
+	{"roles", []mongo.IndexModel{
+		// Unique index on name for active roles
+		{
+			Keys: bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().
+				SetName("idx_name_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "deletedAt", Value: nil}}),
+		},
+
+		// Index on scope for filtering by scope
+		{
+			Keys:    bson.D{{Key: "scope", Value: 1}},
+			Options: options.Index().SetName("idx_scope"),
+		},
+
+		// Index on permissions for finding the roles that grant a permission
+		{
+			Keys:    bson.D{{Key: "permissions", Value: 1}},
+			Options: options.Index().SetName("idx_permissions"),
+		},
+
+		// Index on deletedAt for soft delete queries (sparse)
+		{
+			Keys:    bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().SetName("idx_deletedAt").SetSparse(true),
+		},
+
+		// Index on createdAt for sorting
+		{
+			Keys:    bson.D{{Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_created_desc"),
+		},
+	}},
+	{"locations", []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "name", Value: 1},
+				{Key: "type", Value: 1},
+			},
+			Options: options.Index().
+				SetName("idx_name_type_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "deletedAt", Value: nil}}),
+		},
+		// Index on type for filtering by location type
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}},
+			Options: options.Index().SetName("idx_type"),
+		},
+
+		{ // Index on country (non-unique)
+			Keys:    bson.D{{Key: "country", Value: 1}},
+			Options: options.Index().SetName("idx_country"),
+		},
+		{ // Index on state (non-unique)
+			Keys:    bson.D{{Key: "state", Value: 1}},
+			Options: options.Index().SetName("idx_state"),
+		},
+		{ // Index on parentId (children lookups)
+			Keys:    bson.D{{Key: "parentId", Value: 1}},
+			Options: options.Index().SetName("idx_parentId"),
+		},
+		{ // Multikey index on ancestors (subtree lookups and path rewrites)
+			Keys:    bson.D{{Key: "ancestors", Value: 1}},
+			Options: options.Index().SetName("idx_ancestors"),
+		},
+		{ // 2dsphere index on the GeoJSON point (near, bbox and polygon queries)
+			Keys:    bson.D{{Key: "coordinates", Value: "2dsphere"}},
+			Options: options.Index().SetName("idx_coordinates_2dsphere"),
+		},
+		{ // Weighted text index for search; language "none" keeps place names unstemmed
+			Keys: bson.D{
+				{Key: "name", Value: "text"},
+				{Key: "aliases", Value: "text"},
+				{Key: "searchKeys", Value: "text"},
+				{Key: "tags", Value: "text"},
+				{Key: "description", Value: "text"},
+			},
+			Options: options.Index().
+				SetName("idx_text_search").
+				SetDefaultLanguage("none").
+				SetWeights(bson.D{
+					{Key: "name", Value: 10},
+					{Key: "aliases", Value: 8},
+					{Key: "searchKeys", Value: 6},
+					{Key: "tags", Value: 4},
+					{Key: "description", Value: 1},
+				}),
+		},
+		{ // Prefix lookups for autocomplete
+			Keys:    bson.D{{Key: "searchName", Value: 1}},
+			Options: options.Index().SetName("idx_searchName"),
+		},
+		{ // Prefix lookups for autocomplete on aliases
+			Keys:    bson.D{{Key: "searchAliases", Value: 1}},
+			Options: options.Index().SetName("idx_searchAliases"),
+		},
+		{ // Trigram fallback for typo-tolerant search
+			Keys:    bson.D{{Key: "searchTrigrams", Value: 1}},
+			Options: options.Index().SetName("idx_searchTrigrams"),
+		},
+		{ // Popularity ordering for suggestions
+			Keys:    bson.D{{Key: "popularity", Value: -1}},
+			Options: options.Index().SetName("idx_popularity_desc"),
+		},
+		{ // Index on tags (non-unique)
+			Keys:    bson.D{{Key: "tags", Value: 1}},
+			Options: options.Index().SetName("idx_tags"),
+		},
+		{ // Index on aliases (non-unique)
+			Keys:    bson.D{{Key: "aliases", Value: 1}},
+			Options: options.Index().SetName("idx_aliases"),
+		},
+		{ // Index on createdAt (for sorting by creation time, non-unique)
+			Keys:    bson.D{{Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_created_desc"),
+		},
+		{ // Index on deletedAt (non-unique)
+			Keys:    bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().SetName("idx_deletedAt"),
+		},
+	}},
+	{"location_import_jobs", []mongo.IndexModel{
+		{ // Recent jobs first
+			Keys:    bson.D{{Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_created_desc"),
+		},
+		{ // Jobs expire 30 days after they finish
+			Keys:    bson.D{{Key: "completedAt", Value: 1}},
+			Options: options.Index().SetName("idx_completedAt_ttl").SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	}},
+	{"export_jobs", []mongo.IndexModel{
+		{ // Jobs of a user, recent first
+			Keys:    bson.D{{Key: "createdBy", Value: 1}, {Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_createdBy_createdAt"),
+		},
+		{ // Jobs are dropped when their download link expires, storage GC then reclaims the file
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetName("idx_expiresAt_ttl").SetExpireAfterSeconds(0),
+		},
+	}},
+	{"upload_sessions", []mongo.IndexModel{
+		{ // Cleanup job scans pending sessions by expiry
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetName("idx_status_expiresAt"),
+		},
+		{ // Sessions of a resource
+			Keys:    bson.D{{Key: "target", Value: 1}, {Key: "targetId", Value: 1}},
+			Options: options.Index().SetName("idx_target"),
+		},
+		{ // Session records are dropped 30 days after they were opened
+			Keys:    bson.D{{Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("idx_createdAt_ttl").SetExpireAfterSeconds(30 * 24 * 60 * 60),
+		},
+	}},
+}
+
+// namespaceNotFound is the server error for a collection that does not exist
+const namespaceNotFound = 26
+
+func createBaselineIndexes(ctx context.Context, env *Env) error {
+	for _, baseline := range baselineIndexes {
+		if _, err := env.DB.Collection(baseline.collection).Indexes().CreateMany(ctx, baseline.models); err != nil {
+			return fmt.Errorf("failed to create %s indexes: %w", baseline.collection, err)
+		}
+	}
+	return nil
+}
+
+func dropBaselineIndexes(ctx context.Context, env *Env) error {
+	for _, baseline := range baselineIndexes {
+		_, err := env.DB.Collection(baseline.collection).Indexes().DropAll(ctx)
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == namespaceNotFound {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to drop %s indexes: %w", baseline.collection, err)
+		}
+	}
+	return nil
+}
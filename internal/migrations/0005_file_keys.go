@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	"context"
+	"log"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+)
+
+func init() {
+	register("Store file keys instead of storage URLs", migrateFileKeys, nil)
+}
+
+func migrateFileKeys(ctx context.Context, env *Env) error {
+	result, err := database.NewFileKeyMigration(env.DB, env.Files).Run(ctx, false)
+	if err != nil {
+		return err
+	}
+	for collection, count := range result {
+		log.Printf("Migrated file keys of %d %s", count, collection)
+	}
+	return nil
+}
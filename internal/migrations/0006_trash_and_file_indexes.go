@@ -0,0 +1,89 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	register("Index the trash bin and file reference queries", createTrashAndFileIndexes, dropTrashAndFileIndexes)
+}
+
+// trashAndFileIndexes serve the trash bin, which lists and purges the soft-deleted
+// documents of each collection by deletion time and deleter, and the storage garbage
+// collector, which looks up export job files. The file reference scans of
+// organizations, users and locations read every document and need no index.
+var trashAndFileIndexes = []struct {
+	collection string
+	models     []mongo.IndexModel
+}{
+	{"users", []mongo.IndexModel{
+		{ // The baseline partial indexes skip deleted users
+			Keys:    bson.D{{Key: "deletedAt", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("idx_trash_deletedAt").SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "deletedBy", Value: 1}, {Key: "deletedAt", Value: -1}},
+			Options: options.Index().SetName("idx_trash_deletedBy").SetSparse(true),
+		},
+	}},
+	{"organizations", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "deletedBy", Value: 1}, {Key: "deletedAt", Value: -1}},
+			Options: options.Index().SetName("idx_trash_deletedBy").SetSparse(true),
+		},
+	}},
+	{"roles", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "deletedBy", Value: 1}, {Key: "deletedAt", Value: -1}},
+			Options: options.Index().SetName("idx_trash_deletedBy").SetSparse(true),
+		},
+	}},
+	{"locations", []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "deletedBy", Value: 1}, {Key: "deletedAt", Value: -1}},
+			Options: options.Index().SetName("idx_trash_deletedBy").SetSparse(true),
+		},
+	}},
+	{"export_jobs", []mongo.IndexModel{
+		{ // Jobs whose file is still stored
+			Keys: bson.D{{Key: "fileKey", Value: 1}},
+			Options: options.Index().
+				SetName("idx_fileKey").
+				SetPartialFilterExpression(bson.D{{Key: "fileKey", Value: bson.M{"$gt": ""}}}),
+		},
+	}},
+}
+
+// indexNotFound is the server error for dropping an index that does not exist
+const indexNotFound = 27
+
+func createTrashAndFileIndexes(ctx context.Context, env *Env) error {
+	for _, spec := range trashAndFileIndexes {
+		if _, err := env.DB.Collection(spec.collection).Indexes().CreateMany(ctx, spec.models); err != nil {
+			return fmt.Errorf("failed to create %s indexes: %w", spec.collection, err)
+		}
+	}
+	return nil
+}
+
+func dropTrashAndFileIndexes(ctx context.Context, env *Env) error {
+	for _, spec := range trashAndFileIndexes {
+		for _, model := range spec.models {
+			_, err := env.DB.Collection(spec.collection).Indexes().DropOne(ctx, *model.Options.Name)
+			var cmdErr mongo.CommandError
+			if errors.As(err, &cmdErr) && (cmdErr.Code == namespaceNotFound || cmdErr.Code == indexNotFound) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to drop %s index %s: %w", spec.collection, *model.Options.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	locMigrations "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/migrations"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func init() {
+	register("Backfill and index the location search tokens", createLocationSearchTokens, dropLocationSearchTokens)
+}
+
+// Autocomplete matches a prefix against the start of any word of a location name or
+// alias, which a regex over searchName cannot do through an index
+func createLocationSearchTokens(ctx context.Context, env *Env) error {
+	coll := env.DB.Collection("locations")
+	backfilled, err := locMigrations.BackfillLocationSearchTokens(ctx, coll)
+	if err != nil {
+		return err
+	}
+	log.Printf("Backfilled search tokens for %d locations", backfilled)
+
+	_, err = coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "searchTokens", Value: 1}},
+		Options: options.Index().SetName("idx_searchTokens"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create locations search token index: %w", err)
+	}
+	return nil
+}
+
+func dropLocationSearchTokens(ctx context.Context, env *Env) error {
+	_, err := env.DB.Collection("locations").Indexes().DropOne(ctx, "idx_searchTokens")
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && (cmdErr.Code == namespaceNotFound || cmdErr.Code == indexNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to drop locations index idx_searchTokens: %w", err)
+	}
+	return nil
+}
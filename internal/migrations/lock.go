@@ -0,0 +1,107 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	lockID = "lock"
+	// lockTTL is how long a lock outlives a migrator that stopped renewing it
+	lockTTL = 2 * time.Minute
+	// lockHeartbeat is how often a running migrator renews its lock
+	lockHeartbeat = 30 * time.Second
+)
+
+// ErrLocked is returned when another process is running migrations
+var ErrLocked = errors.New("migrations are locked by another process")
+
+// lockDoc is the single lock document kept next to the migration records
+type lockDoc struct {
+	ID        string    `bson:"_id"`
+	Owner     string    `bson:"owner"`
+	LockedAt  time.Time `bson:"lockedAt"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+// withLock runs fn holding the migration lock, renewing it until fn returns. A lock left
+// behind by a crashed process is taken over once it expires.
+func (m *Migrator) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	owner := lockOwner()
+	if err := m.acquire(ctx, owner); err != nil {
+		return err
+	}
+	defer m.release(owner)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go m.heartbeat(ctx, owner)
+
+	return fn(ctx)
+}
+
+func (m *Migrator) acquire(ctx context.Context, owner string) error {
+	now := time.Now()
+	_, err := m.collection.UpdateOne(ctx,
+		bson.M{"_id": lockID, "expiresAt": bson.M{"$lt": now}},
+		bson.M{"$set": bson.M{"owner": owner, "lockedAt": now, "expiresAt": now.Add(lockTTL)}},
+		options.Update().SetUpsert(true),
+	)
+	if err == nil {
+		return nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	var held lockDoc
+	if err := m.collection.FindOne(ctx, bson.M{"_id": lockID}).Decode(&held); err != nil {
+		return ErrLocked
+	}
+	return fmt.Errorf("%w: held by %s since %s", ErrLocked, held.Owner, held.LockedAt.Format(time.RFC3339))
+}
+
+func (m *Migrator) heartbeat(ctx context.Context, owner string) {
+	ticker := time.NewTicker(lockHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := m.collection.UpdateOne(ctx,
+				bson.M{"_id": lockID, "owner": owner},
+				bson.M{"$set": bson.M{"expiresAt": time.Now().Add(lockTTL)}},
+			)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("Failed to renew migration lock: %v", err)
+			}
+		}
+	}
+}
+
+// release drops the lock with its own context so it still runs after ctx is cancelled
+func (m *Migrator) release(owner string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := m.collection.DeleteOne(ctx, bson.M{"_id": lockID, "owner": owner}); err != nil {
+		log.Printf("Failed to release migration lock: %v", err)
+	}
+}
+
+// lockOwner identifies this process in the lock document
+func lockOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d:%d", host, os.Getpid(), time.Now().UnixNano())
+}
@@ -0,0 +1,94 @@
+// Package migrations versions the database schema. Each migration is a Go file named
+// after its version, e.g. 0004_baseline_indexes.go, registering Up and optionally Down
+// functions that change indexes or backfill data. Applied migrations are recorded in the
+// schema_migrations collection with a checksum of their file, so editing a migration
+// after it ran is detected; add a new one instead.
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+)
+
+// sources are the migration files, checksummed to detect edits after they were applied
+//
+//go:embed [0-9]*.go
+var sources embed.FS
+
+// Env is what migrations run against
+type Env struct {
+	DB    *mongo.Database
+	Files services.FileService
+}
+
+// Migration is one versioned change to the database. Migrations are not transactional:
+// a failed Up is not recorded and runs again in full, so it must be safe to repeat.
+type Migration struct {
+	Version     int
+	Name        string // file name without extension, e.g. 0004_baseline_indexes
+	Description string
+	Checksum    string // SHA-256 of the migration file
+
+	Up func(ctx context.Context, env *Env) error
+	// Down reverts Up, nil when the migration cannot be undone
+	Down func(ctx context.Context, env *Env) error
+}
+
+var registry = map[int]*Migration{}
+
+// register adds the migration defined by the calling file, taking its version and name
+// from the file name. It is called from the init function of every migration file.
+func register(description string, up, down func(ctx context.Context, env *Env) error) {
+	_, path, _, ok := runtime.Caller(1)
+	if !ok {
+		panic("migrations: cannot determine the file registering a migration")
+	}
+	file := filepath.Base(path)
+	name := strings.TrimSuffix(file, ".go")
+
+	prefix, _, _ := strings.Cut(name, "_")
+	version, err := strconv.Atoi(prefix)
+	if err != nil || version <= 0 {
+		panic(fmt.Sprintf("migrations: %s must start with a positive version, e.g. 0001_", file))
+	}
+	if existing, ok := registry[version]; ok {
+		panic(fmt.Sprintf("migrations: %s and %s share version %d", existing.Name, name, version))
+	}
+
+	source, err := sources.ReadFile(file)
+	if err != nil {
+		panic(fmt.Sprintf("migrations: %s is not embedded: %v", file, err))
+	}
+	sum := sha256.Sum256(source)
+
+	registry[version] = &Migration{
+		Version:     version,
+		Name:        name,
+		Description: description,
+		Checksum:    hex.EncodeToString(sum[:]),
+		Up:          up,
+		Down:        down,
+	}
+}
+
+// All returns every registered migration in version order
+func All() []*Migration {
+	all := make([]*Migration, 0, len(registry))
+	for _, m := range registry {
+		all = append(all, m)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Version < all[j].Version })
+	return all
+}
@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestChecksumsMatchFiles(t *testing.T) {
+	for _, migration := range All() {
+		source, err := os.ReadFile(migration.Name + ".go")
+		if err != nil {
+			t.Fatalf("%s: %v", migration.Name, err)
+		}
+		sum := sha256.Sum256(source)
+		if want := hex.EncodeToString(sum[:]); migration.Checksum != want {
+			t.Errorf("%s checksum = %s, want %s", migration.Name, migration.Checksum, want)
+		}
+	}
+}
+
+func TestMigrationsAreNumberedInOrder(t *testing.T) {
+	checksums := map[string]string{}
+	for i, migration := range All() {
+		if migration.Version != i+1 {
+			t.Errorf("%s has version %d, want %d", migration.Name, migration.Version, i+1)
+		}
+		if migration.Up == nil {
+			t.Errorf("%s has no Up", migration.Name)
+		}
+		if other, ok := checksums[migration.Checksum]; ok {
+			t.Errorf("%s and %s have the same checksum", other, migration.Name)
+		}
+		checksums[migration.Checksum] = migration.Name
+	}
+}
+
+func TestCheckStatuses(t *testing.T) {
+	tests := []struct {
+		name          string
+		states        []string
+		failOnPending bool
+		want          error
+	}{
+		{"all applied", []string{StateApplied, StateApplied}, true, nil},
+		{"pending allowed", []string{StateApplied, StatePending}, false, nil},
+		{"pending", []string{StateApplied, StatePending}, true, ErrPending},
+		{"modified", []string{StateModified, StatePending}, false, ErrModified},
+		{"unknown", []string{StateApplied, StateUnknown}, false, ErrUnknown},
+		{"modified before unknown", []string{StateUnknown, StateModified}, true, ErrModified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := make([]Status, len(tt.states))
+			for i, state := range tt.states {
+				statuses[i] = Status{Version: i + 1, Name: "migration", State: state}
+			}
+			err := checkStatuses(statuses, tt.failOnPending)
+			if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("checkStatuses = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CollectionName is where applied migrations and the migration lock are stored
+const CollectionName = "schema_migrations"
+
+// Migration states reported by Status
+const (
+	StateApplied  = "applied"
+	StatePending  = "pending"
+	StateModified = "modified" // applied, but its file changed since
+	StateUnknown  = "unknown"  // applied by a newer build that has a migration this one lacks
+)
+
+var (
+	ErrPending      = errors.New("database has pending migrations")
+	ErrModified     = errors.New("applied migrations were modified")
+	ErrUnknown      = errors.New("database has migrations this build does not know")
+	ErrIrreversible = errors.New("migration cannot be reverted")
+)
+
+// record is the stored form of an applied migration
+type record struct {
+	Version    int       `bson:"_id"`
+	Name       string    `bson:"name"`
+	Checksum   string    `bson:"checksum"`
+	AppliedAt  time.Time `bson:"appliedAt"`
+	DurationMs int64     `bson:"durationMs"`
+}
+
+// Status is the state of one migration
+type Status struct {
+	Version     int        `json:"version"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	State       string     `json:"state"`
+	AppliedAt   *time.Time `json:"appliedAt,omitempty"`
+}
+
+// Migrator applies and reverts the registered migrations
+type Migrator struct {
+	env        *Env
+	migrations []*Migration
+	collection *mongo.Collection
+}
+
+// NewMigrator creates a migrator for every registered migration
+func NewMigrator(env *Env) *Migrator {
+	return &Migrator{
+		env:        env,
+		migrations: All(),
+		collection: env.DB.Collection(CollectionName),
+	}
+}
+
+// Status reports every known migration, and applied ones this build does not know, in
+// version order
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name, Description: migration.Description, State: StatePending}
+		if rec, ok := applied[migration.Version]; ok {
+			appliedAt := rec.AppliedAt
+			status.AppliedAt = &appliedAt
+			status.State = StateApplied
+			if rec.Checksum != migration.Checksum {
+				status.State = StateModified
+			}
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, rec := range applied {
+		appliedAt := rec.AppliedAt
+		statuses = append(statuses, Status{Version: rec.Version, Name: rec.Name, State: StateUnknown, AppliedAt: &appliedAt})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, nil
+}
+
+// Check returns an error unless every migration is applied unmodified. The API calls it
+// at startup so it never runs against a schema it was not built for.
+func (m *Migrator) Check(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	return checkStatuses(statuses, true)
+}
+
+// Up applies the pending migrations up to and including version target, every one when
+// target is 0, and returns the ones it applied
+func (m *Migrator) Up(ctx context.Context, target int) ([]*Migration, error) {
+	var done []*Migration
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if err := checkStatuses(statuses, false); err != nil {
+			return err
+		}
+
+		pending := map[int]bool{}
+		for _, status := range statuses {
+			if status.State == StatePending {
+				pending[status.Version] = true
+			}
+		}
+		for _, migration := range m.migrations {
+			if !pending[migration.Version] || (target > 0 && migration.Version > target) {
+				continue
+			}
+			if err := m.apply(ctx, migration); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns the ones it
+// reverted. It stops at the first migration that cannot be undone.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	var done []*Migration
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if err := checkStatuses(statuses, false); err != nil {
+			return err
+		}
+
+		byVersion := map[int]*Migration{}
+		for _, migration := range m.migrations {
+			byVersion[migration.Version] = migration
+		}
+		for i := len(statuses) - 1; i >= 0 && len(done) < steps; i-- {
+			if statuses[i].State != StateApplied {
+				continue
+			}
+			migration := byVersion[statuses[i].Version]
+			if migration.Down == nil {
+				return fmt.Errorf("%w: %s", ErrIrreversible, migration.Name)
+			}
+			if err := m.revert(ctx, migration); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+func (m *Migrator) apply(ctx context.Context, migration *Migration) error {
+	log.Printf("Applying migration %s", migration.Name)
+	started := time.Now()
+	if err := migration.Up(ctx, m.env); err != nil {
+		return fmt.Errorf("migration %s failed: %w", migration.Name, err)
+	}
+	rec := record{
+		Version:    migration.Version,
+		Name:       migration.Name,
+		Checksum:   migration.Checksum,
+		AppliedAt:  time.Now(),
+		DurationMs: time.Since(started).Milliseconds(),
+	}
+	if _, err := m.collection.InsertOne(ctx, rec); err != nil {
+		return fmt.Errorf("migration %s ran but could not be recorded: %w", migration.Name, err)
+	}
+	log.Printf("Applied migration %s in %s", migration.Name, time.Since(started).Round(time.Millisecond))
+	return nil
+}
+
+func (m *Migrator) revert(ctx context.Context, migration *Migration) error {
+	log.Printf("Reverting migration %s", migration.Name)
+	if err := migration.Down(ctx, m.env); err != nil {
+		return fmt.Errorf("reverting migration %s failed: %w", migration.Name, err)
+	}
+	if _, err := m.collection.DeleteOne(ctx, bson.M{"_id": migration.Version}); err != nil {
+		return fmt.Errorf("migration %s was reverted but is still recorded: %w", migration.Name, err)
+	}
+	log.Printf("Reverted migration %s", migration.Name)
+	return nil
+}
+
+// applied returns the stored records by version
+func (m *Migrator) applied(ctx context.Context) (map[int]record, error) {
+	cursor, err := m.collection.Find(ctx, bson.M{"_id": bson.M{"$type": "number"}}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+	applied := make(map[int]record, len(records))
+	for _, rec := range records {
+		applied[rec.Version] = rec
+	}
+	return applied, nil
+}
+
+// checkStatuses fails on modified or unknown migrations, and on pending ones when
+// failOnPending is set
+func checkStatuses(statuses []Status, failOnPending bool) error {
+	var pending, modified, unknown []string
+	for _, status := range statuses {
+		switch status.State {
+		case StatePending:
+			pending = append(pending, status.Name)
+		case StateModified:
+			modified = append(modified, status.Name)
+		case StateUnknown:
+			unknown = append(unknown, status.Name)
+		}
+	}
+	switch {
+	case len(modified) > 0:
+		return fmt.Errorf("%w: %s", ErrModified, strings.Join(modified, ", "))
+	case len(unknown) > 0:
+		return fmt.Errorf("%w: %s", ErrUnknown, strings.Join(unknown, ", "))
+	case failOnPending && len(pending) > 0:
+		return fmt.Errorf("%w: %s", ErrPending, strings.Join(pending, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package models
+
+import "context"
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the ID of the user making a request, so the
+// records they change can say who changed them
+func WithActor(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, userID)
+}
+
+// ActorFromContext returns the user ID set by WithActor, or "" for changes made by the
+// system, such as background jobs
+func ActorFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(actorKey{}).(string)
+	return userID
+}
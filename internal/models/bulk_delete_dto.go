@@ -2,7 +2,7 @@ package models
 
 import "errors"
 
-// BulkDeleteDto represents a request to delete multiple organizations
+// BulkDeleteDto represents a request to delete or restore multiple records of one resource
 type BulkDeleteDto struct {
 	IDs []string `json:"ids" binding:"required"`
 }
@@ -10,7 +10,7 @@ type BulkDeleteDto struct {
 // Validate performs validation on BulkDeleteDto
 func (dto *BulkDeleteDto) Validate() error {
 	if len(dto.IDs) == 0 {
-		return errors.New("at least one organization ID is required")
+		return errors.New("at least one ID is required")
 	}
 	return nil
 }
\ No newline at end of file
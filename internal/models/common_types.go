@@ -1 +1,38 @@
 package models
+
+// ImageVariants maps a configured variant name (thumb, card, full) to its file.
+// Stored records hold storage keys, which are resolved to URLs for responses.
+type ImageVariants map[string]string
+
+// Map returns a copy of the variants with fn applied to each file reference
+func (v ImageVariants) Map(fn func(string) string) ImageVariants {
+	if v == nil {
+		return nil
+	}
+	mapped := make(ImageVariants, len(v))
+	for name, ref := range v {
+		mapped[name] = fn(ref)
+	}
+	return mapped
+}
+
+// ProcessedImage is an uploaded image together with its resized variants. URL and
+// Variants hold storage keys.
+type ProcessedImage struct {
+	URL         string        `json:"url" bson:"url"`
+	ContentType string        `json:"contentType,omitempty" bson:"contentType,omitempty"`
+	Width       int           `json:"width,omitempty" bson:"width,omitempty"`
+	Height      int           `json:"height,omitempty" bson:"height,omitempty"`
+	Variants    ImageVariants `json:"variants,omitempty" bson:"variants,omitempty"`
+}
+
+// URLs returns the original URL followed by every variant URL
+func (p *ProcessedImage) URLs() []string {
+	urls := []string{p.URL}
+	for _, url := range p.Variants {
+		if url != "" && url != p.URL {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
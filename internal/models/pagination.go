@@ -0,0 +1,55 @@
+package models
+
+import "errors"
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded or was issued
+// for a different sort order
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// CountMode controls how the total of a list is computed
+type CountMode string
+
+const (
+	// CountExact counts every matching document
+	CountExact CountMode = "exact"
+	// CountEstimated counts matching documents up to a cap, above which the total is a lower bound
+	CountEstimated CountMode = "estimated"
+	// CountNone skips counting
+	CountNone CountMode = "none"
+)
+
+// SortField is one key of a list sort order
+type SortField struct {
+	Field      string // stored field name, dotted for embedded fields
+	Descending bool
+}
+
+// ListOptions selects one page of a list. After and Before are opaque cursors taken from
+// a previous PageInfo; when either is set Page is ignored. An empty Sort means newest
+// first.
+type ListOptions struct {
+	Page   int
+	Limit  int
+	Sort   []SortField
+	After  string
+	Before string
+	Count  CountMode
+}
+
+// UsesCursor reports whether the page is selected by a cursor rather than a page number
+func (o ListOptions) UsesCursor() bool {
+	return o.After != "" || o.Before != ""
+}
+
+// PageInfo describes the page returned for ListOptions. Total is nil when the list was
+// not counted.
+type PageInfo struct {
+	Page           int
+	Limit          int
+	Total          *int64
+	TotalEstimated bool
+	HasNext        bool
+	HasPrev        bool
+	NextCursor     string
+	PrevCursor     string
+}
@@ -29,6 +29,10 @@ type SwaggerErrorResponse struct {
 	StatusCode int `json:"statusCode" example:"400"`
 	// Human-readable error message
 	ErrorMessage string `json:"errorMessage" example:"Invalid request parameters"`
+	// Machine-readable error code, set with details
+	ErrorCode string `json:"errorCode,omitempty" example:"VALIDATION_FAILED"`
+	// Optional structured error details, e.g. why an upload was rejected
+	Details interface{} `json:"details,omitempty"`
 	// Optional error details
 	Data interface{} `json:"data,omitempty"`
 }
@@ -44,13 +48,23 @@ type SwaggerPaginatedResponse struct {
 	Data struct {
 		// Array of items
 		Items []interface{} `json:"items"`
-		// Current page number
-		Page int `json:"page" example:"1"`
+		// Current page number, omitted when paging by cursor
+		Page int `json:"page,omitempty" example:"1"`
 		// Number of items per page
 		Limit int `json:"limit" example:"10"`
-		// Total number of items
-		Total int64 `json:"total" example:"42"`
+		// Total number of items, omitted when count=none
+		Total int64 `json:"total,omitempty" example:"42"`
+		// Whether total is a lower bound, with count=estimated
+		TotalEstimated bool `json:"totalEstimated,omitempty" example:"false"`
 		// Total number of pages
-		TotalPages int `json:"total_pages" example:"5"`
+		TotalPages int `json:"totalPages,omitempty" example:"5"`
+		// Whether there are items after this page
+		HasNext bool `json:"hasNext" example:"true"`
+		// Whether there are items before this page
+		HasPrev bool `json:"hasPrev" example:"false"`
+		// Cursor for the next page, pass it as after
+		NextCursor string `json:"nextCursor,omitempty"`
+		// Cursor for the previous page, pass it as before
+		PrevCursor string `json:"prevCursor,omitempty"`
 	} `json:"data"`
 }
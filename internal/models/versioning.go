@@ -0,0 +1,7 @@
+package models
+
+import "errors"
+
+// ErrVersionConflict is returned when an update was based on an outdated version of a
+// document, because another request changed it in the meantime
+var ErrVersionConflict = errors.New("resource was modified by another request")
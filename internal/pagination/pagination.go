@@ -0,0 +1,119 @@
+// Package pagination reads the paging and sorting query parameters shared by list
+// endpoints and builds their response envelope
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// MaxSortFields is the most fields a sort parameter may list
+const MaxSortFields = 3
+
+// ErrInvalidQuery is returned for malformed sort, cursor or count parameters
+var ErrInvalidQuery = errors.New("invalid list query")
+
+// SortFields maps the field names a list can be sorted by to their stored field names
+type SortFields map[string]string
+
+func (f SortFields) names() string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// ParseSort parses a sort parameter such as "name,-createdAt": a comma separated list of
+// field names, each descending when prefixed with "-"
+func ParseSort(param string, allowed SortFields) ([]models.SortField, error) {
+	if strings.TrimSpace(param) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(param, ",")
+	if len(parts) > MaxSortFields {
+		return nil, fmt.Errorf("%w: sort by at most %d fields", ErrInvalidQuery, MaxSortFields)
+	}
+	fields := make([]models.SortField, 0, len(parts))
+	seen := map[string]bool{}
+	for _, part := range parts {
+		name := strings.TrimSpace(part)
+		descending := strings.HasPrefix(name, "-")
+		name = strings.TrimLeft(name, "+-")
+
+		field, ok := allowed[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: cannot sort by %q, use one of %s", ErrInvalidQuery, name, allowed.names())
+		}
+		if seen[field] {
+			return nil, fmt.Errorf("%w: %q is listed twice in sort", ErrInvalidQuery, name)
+		}
+		seen[field] = true
+		fields = append(fields, models.SortField{Field: field, Descending: descending})
+	}
+	return fields, nil
+}
+
+// FromQuery builds the list options for a request from the page and limit its DTO parsed
+// and the sort, after, before and count query parameters
+func FromQuery(c *gin.Context, page, limit int, allowed SortFields) (models.ListOptions, error) {
+	opts := models.ListOptions{
+		Page:   page,
+		Limit:  limit,
+		After:  c.Query("after"),
+		Before: c.Query("before"),
+		Count:  models.CountMode(strings.ToLower(c.Query("count"))),
+	}
+	if opts.After != "" && opts.Before != "" {
+		return opts, fmt.Errorf("%w: use either after or before, not both", ErrInvalidQuery)
+	}
+
+	switch opts.Count {
+	case "", models.CountExact, models.CountEstimated, models.CountNone:
+	default:
+		return opts, fmt.Errorf("%w: count must be exact, estimated or none", ErrInvalidQuery)
+	}
+
+	sortFields, err := ParseSort(c.Query("sort"), allowed)
+	if err != nil {
+		return opts, err
+	}
+	opts.Sort = sortFields
+	return opts, nil
+}
+
+// Response builds the paginated response envelope for items. Page numbers are included
+// when the page was selected by number, and the total when the list was counted.
+func Response(items interface{}, info *models.PageInfo) gin.H {
+	response := gin.H{
+		"items":   items,
+		"limit":   info.Limit,
+		"hasNext": info.HasNext,
+		"hasPrev": info.HasPrev,
+	}
+	if info.NextCursor != "" {
+		response["nextCursor"] = info.NextCursor
+	}
+	if info.PrevCursor != "" {
+		response["prevCursor"] = info.PrevCursor
+	}
+	if info.Page > 0 {
+		response["page"] = info.Page
+	}
+	if info.Total != nil {
+		response["total"] = *info.Total
+		response["totalEstimated"] = info.TotalEstimated
+		if info.Page > 0 && info.Limit > 0 {
+			response["totalPages"] = (*info.Total + int64(info.Limit) - 1) / int64(info.Limit)
+		}
+	}
+	return response
+}
@@ -0,0 +1,35 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// applyMergePatch applies an RFC 7396 merge patch: members set to null are removed,
+// objects are merged recursively and any other value replaces the target
+func applyMergePatch(target interface{}, body []byte) (interface{}, error) {
+	var mergePatch interface{}
+	if err := json.Unmarshal(body, &mergePatch); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	return merge(target, mergePatch), nil
+}
+
+func merge(target, mergePatch interface{}) interface{} {
+	members, ok := mergePatch.(map[string]interface{})
+	if !ok {
+		return mergePatch
+	}
+	object, ok := target.(map[string]interface{})
+	if !ok {
+		object = map[string]interface{}{}
+	}
+	for name, value := range members {
+		if value == nil {
+			delete(object, name)
+			continue
+		}
+		object[name] = merge(object[name], value)
+	}
+	return object
+}
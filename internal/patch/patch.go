@@ -0,0 +1,169 @@
+// Package patch applies partial updates sent as JSON Merge Patch (RFC 7396) or JSON
+// Patch (RFC 6902) to the JSON form of a resource
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Supported request content types
+const (
+	ContentTypeMergePatch = "application/merge-patch+json"
+	ContentTypeJSONPatch  = "application/json-patch+json"
+)
+
+// AcceptPatch lists the supported content types, for the Accept-Patch header
+var AcceptPatch = strings.Join([]string{ContentTypeMergePatch, ContentTypeJSONPatch}, ", ")
+
+var (
+	// ErrUnsupportedMediaType is returned for content types other than the patch formats
+	ErrUnsupportedMediaType = errors.New("unsupported patch content type")
+	// ErrInvalidPatch is returned when the patch is malformed or cannot be applied
+	ErrInvalidPatch = errors.New("invalid patch")
+	// ErrTestFailed is returned when a JSON Patch test operation does not match
+	ErrTestFailed = errors.New("patch test operation failed")
+)
+
+// Apply patches the JSON object document with body according to contentType. Plain
+// application/json is read as a merge patch.
+func Apply(contentType string, document, body []byte) ([]byte, error) {
+	var target interface{}
+	if err := json.Unmarshal(document, &target); err != nil {
+		return nil, err
+	}
+
+	var patched interface{}
+	var err error
+	switch strings.ToLower(strings.TrimSpace(contentType)) {
+	case ContentTypeMergePatch, "application/json":
+		patched, err = applyMergePatch(target, body)
+	case ContentTypeJSONPatch:
+		patched, err = applyJSONPatch(target, body)
+	default:
+		return nil, fmt.Errorf("%w: %q, use %s", ErrUnsupportedMediaType, contentType, AcceptPatch)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := patched.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("%w: the patched document must be an object", ErrInvalidPatch)
+	}
+	return json.Marshal(patched)
+}
+
+// ApplyTo patches the JSON form of current and decodes the top-level members the
+// patch changed into dst, which should be a fresh update DTO with optional fields.
+// A removed member is decoded as the zero value of its previous type, so removing a
+// field clears it. Members unknown to dst are rejected.
+func ApplyTo(contentType string, current interface{}, body []byte, dst interface{}) error {
+	document, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	patched, err := Apply(contentType, document, body)
+	if err != nil {
+		return err
+	}
+
+	var before, after map[string]interface{}
+	if err := json.Unmarshal(document, &before); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(patched, &after); err != nil {
+		return err
+	}
+
+	changes := map[string]interface{}{}
+	for name, value := range after {
+		if old, ok := before[name]; !ok || !reflect.DeepEqual(old, value) {
+			changes[name] = withRemoved(old, value)
+		}
+	}
+	for name, old := range before {
+		if _, ok := after[name]; !ok {
+			changes[name] = zeroValue(old)
+		}
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	return nil
+}
+
+// withRemoved returns value with the object members that were removed from old set to
+// their zero value, at any depth
+func withRemoved(old, value interface{}) interface{} {
+	oldObject, ok := old.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	merged := make(map[string]interface{}, len(object))
+	for name, member := range object {
+		merged[name] = withRemoved(oldObject[name], member)
+	}
+	for name, oldMember := range oldObject {
+		if _, ok := object[name]; !ok {
+			merged[name] = zeroValue(oldMember)
+		}
+	}
+	return merged
+}
+
+// zeroValue returns the empty value of the JSON type of v, recursing into objects so
+// their members keep their types
+func zeroValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case string:
+		return ""
+	case float64:
+		return 0
+	case bool:
+		return false
+	case []interface{}:
+		return []interface{}{}
+	case map[string]interface{}:
+		zero := make(map[string]interface{}, len(value))
+		for name, member := range value {
+			zero[name] = zeroValue(member)
+		}
+		return zero
+	default:
+		return nil
+	}
+}
+
+// deepCopy copies a decoded JSON value
+func deepCopy(v interface{}) interface{} {
+	switch value := v.(type) {
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, item := range value {
+			copied[i] = deepCopy(item)
+		}
+		return copied
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(value))
+		for name, member := range value {
+			copied[name] = deepCopy(member)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,49 @@
+package seeder
+
+import (
+	"context"
+	"sort"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+)
+
+// ExportSeedData dumps the stored permissions and roles as seed data, so roles edited
+// through the API can be carried into a seed profile. Roles reference permissions by
+// resource:action; soft-deleted roles are left out.
+func ExportSeedData(ctx context.Context, appContainer *container.AppContainer) (*SeedData, error) {
+	permissions, _, err := appContainer.Permission.Repository.List(ctx, make(map[string]interface{}), 1, 5000)
+	if err != nil {
+		return nil, err
+	}
+	roles, _, err := appContainer.Role.Repository.List(ctx, map[string]interface{}{"deletedAt": nil}, 1, 5000)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &SeedData{}
+	keys := make(map[string]string, len(permissions))
+	for _, p := range permissions {
+		keys[p.ID.Hex()] = p.String()
+		data.Permissions = append(data.Permissions, PermissionSeed{
+			Resource:    p.Resource,
+			Action:      string(p.Action),
+			Description: p.Description,
+		})
+	}
+	sort.Slice(data.Permissions, func(i, j int) bool { return data.Permissions[i].Key() < data.Permissions[j].Key() })
+
+	for _, r := range roles {
+		seed := RoleSeed{Name: r.Name, Description: r.Description, Scope: string(r.Scope)}
+		for _, id := range r.Permissions {
+			// Dangling IDs have no key to export
+			if key, ok := keys[id]; ok {
+				seed.Permissions = append(seed.Permissions, key)
+			}
+		}
+		sort.Strings(seed.Permissions)
+		data.Roles = append(data.Roles, seed)
+	}
+	sort.Slice(data.Roles, func(i, j int) bool { return data.Roles[i].Name < data.Roles[j].Name })
+
+	return data, nil
+}
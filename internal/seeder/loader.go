@@ -1,20 +1,38 @@
 // loader.go
 //
-// Seed data loader. Reads and parses seed.json file.
+// Seed data loader. Reads and parses seed profiles, merging the profiles they extend.
 
 package seeder
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 )
 
-// SeedData represents the entire seed.json structure
+// Seed profiles shipped in DataDir, each extending the one before it
+const (
+	ProfileMinimal = "minimal" // permissions, the platform organization and its admin
+	ProfileDemo    = "demo"    // minimal plus a supplier organization, role and user
+	ProfileE2E     = "e2e"     // demo plus fixed accounts in every state for end-to-end tests
+)
+
+// Profiles lists the shipped seed profiles
+var Profiles = []string{ProfileMinimal, ProfileDemo, ProfileE2E}
+
+// DataDir holds the seed profiles, one <profile>.json file each
+const DataDir = "internal/seeder/data"
+
+// SeedData represents a seed file. Extends names a file in the same directory, without
+// .json, whose records are seeded too; records here replace the ones there with the same key.
 type SeedData struct {
-	Permissions   []PermissionSeed   `json:"permissions"`
-	Organizations []OrganizationSeed `json:"organizations"`
-	Roles         []RoleSeed         `json:"roles"`
-	Users         []UserSeed         `json:"users"`
+	Extends       string             `json:"extends,omitempty"`
+	Permissions   []PermissionSeed   `json:"permissions,omitempty"`
+	Organizations []OrganizationSeed `json:"organizations,omitempty"`
+	Roles         []RoleSeed         `json:"roles,omitempty"`
+	Users         []UserSeed         `json:"users,omitempty"`
 }
 
 // OrganizationSeed represents an organization record in seed.json
@@ -78,21 +96,94 @@ type EmailSeed struct {
 	IsVerified bool   `json:"isVerified"`
 }
 
-// LoadSeedData loads the seed data from the JSON file.
+// Key identifies the permission as resource:action, the form roles reference it by
+func (p PermissionSeed) Key() string {
+	return p.Resource + ":" + p.Action
+}
+
+// LoadProfile loads the named seed profile from DataDir.
+//
+// Returns:
+// - SeedData: parsed seed data, merged with the profiles it extends.
+// - error: if the profile is unknown or parsing fails.
+func LoadProfile(profile string) (*SeedData, error) {
+	if !slices.Contains(Profiles, profile) {
+		return nil, fmt.Errorf("unknown seed profile %q, expected one of %v", profile, Profiles)
+	}
+	return LoadSeedFile(filepath.Join(DataDir, profile+".json"))
+}
+
+// LoadSeedFile loads the seed file at path.
 //
 // Returns:
-// - SeedData: parsed seed data.
-// - error: if parsing fails.
-func LoadSeedData() (*SeedData, error) {
-	file, err := os.ReadFile("internal/seeder/data/seed.json")
+// - SeedData: parsed seed data, merged with the files it extends.
+// - error: if reading or parsing fails, or the files extend each other in a cycle.
+func LoadSeedFile(path string) (*SeedData, error) {
+	return loadSeedFile(path, map[string]bool{})
+}
+
+func loadSeedFile(path string, visited map[string]bool) (*SeedData, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if visited[abs] {
+		return nil, fmt.Errorf("seed file %s extends itself", path)
+	}
+	visited[abs] = true
+
+	file, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
 	var data SeedData
 	if err := json.Unmarshal(file, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if data.Extends == "" {
+		return &data, nil
+	}
+
+	base, err := loadSeedFile(filepath.Join(filepath.Dir(path), data.Extends+".json"), visited)
+	if err != nil {
 		return nil, err
 	}
+	return mergeSeedData(base, &data), nil
+}
 
-	return &data, nil
+// mergeSeedData returns the records of base followed by those of override, override
+// replacing base records with the same key in place
+func mergeSeedData(base, override *SeedData) *SeedData {
+	return &SeedData{
+		Permissions:   mergeSeeds(base.Permissions, override.Permissions, PermissionSeed.Key),
+		Organizations: mergeSeeds(base.Organizations, override.Organizations, func(o OrganizationSeed) string { return o.Slug }),
+		Roles:         mergeSeeds(base.Roles, override.Roles, func(r RoleSeed) string { return r.Name }),
+		Users:         mergeSeeds(base.Users, override.Users, userSeedKey),
+	}
+}
+
+func mergeSeeds[T any](base, override []T, key func(T) string) []T {
+	merged := append([]T{}, base...)
+	index := make(map[string]int, len(merged))
+	for i, item := range merged {
+		index[key(item)] = i
+	}
+	for _, item := range override {
+		if i, ok := index[key(item)]; ok {
+			merged[i] = item
+			continue
+		}
+		index[key(item)] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
+
+// userSeedKey identifies a user by primary email
+func userSeedKey(u UserSeed) string {
+	if len(u.Emails) == 0 {
+		return u.FullName
+	}
+	return u.Emails[0].Email
 }
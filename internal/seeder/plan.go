@@ -0,0 +1,65 @@
+package seeder
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ChangeAction is what seeding does to one record
+type ChangeAction string
+
+const (
+	ChangeCreate    ChangeAction = "create"
+	ChangeUpdate    ChangeAction = "update"
+	ChangeUnchanged ChangeAction = "unchanged"
+)
+
+// Change is the change seeding makes, or would make, to one record
+type Change struct {
+	Kind    string // permission, organization, role or user
+	Key     string // what the record is matched on, e.g. resource:action or slug
+	Action  ChangeAction
+	Details []string // what an update changes
+}
+
+// Plan lists the changes of a seed run. In a dry run nothing was written.
+type Plan struct {
+	DryRun  bool
+	Changes []Change
+}
+
+func (p *Plan) add(kind, key string, action ChangeAction, details ...string) {
+	p.Changes = append(p.Changes, Change{Kind: kind, Key: key, Action: action, Details: details})
+}
+
+// Count returns the number of changes with action
+func (p *Plan) Count(action ChangeAction) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// Print writes every create and update, one per line, followed by the totals
+func (p *Plan) Print(w io.Writer) {
+	for _, change := range p.Changes {
+		if change.Action == ChangeUnchanged {
+			continue
+		}
+		line := fmt.Sprintf("%-9s %-12s %s", change.Action, change.Kind, change.Key)
+		if len(change.Details) > 0 {
+			line += " (" + strings.Join(change.Details, "; ") + ")"
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	summary := "Created %d, updated %d, %d unchanged\n"
+	if p.DryRun {
+		summary = "Would create %d, update %d, %d unchanged\n"
+	}
+	fmt.Fprintf(w, summary, p.Count(ChangeCreate), p.Count(ChangeUpdate), p.Count(ChangeUnchanged))
+}
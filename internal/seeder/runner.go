@@ -3,6 +3,8 @@ package seeder
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
@@ -16,67 +18,112 @@ import (
 	"go.uber.org/zap"
 )
 
+// seedRun holds the state of one seeding pass
+type seedRun struct {
+	app  *container.AppContainer
+	plan *Plan
+
+	// permissionIDs maps resource:action to the ID of every stored permission
+	permissionIDs map[string]string
+	// seededPermissions are the resource:action keys of the seed data, stored or not
+	seededPermissions []string
+}
+
 // ExecuteSeeder runs seed operations for permissions, organizations, roles, users.
 // Order is important: permissions -> organizations -> roles -> users
-func ExecuteSeeder(appContainer *container.AppContainer, data *SeedData) error {
+//
+// Records are matched on their natural key. Missing ones are created; permissions and
+// roles that differ from the seed data are updated, other existing records are left
+// alone. With dryRun nothing is written and the plan lists what would change.
+func ExecuteSeeder(appContainer *container.AppContainer, data *SeedData, dryRun bool) (*Plan, error) {
 	ctx := context.Background()
+	run := &seedRun{app: appContainer, plan: &Plan{DryRun: dryRun}}
 
-	logger.Log.Info("🔧 Starting seeder execution")
+	logger.Log.Info("🔧 Starting seeder execution", zap.Bool("dryRun", dryRun))
 
 	// Step 1: Seed Permissions
 	logger.Log.Info("📋 Step 1: Seeding permissions")
-	if err := seedPermissions(ctx, appContainer, data.Permissions); err != nil {
+	if err := run.seedPermissions(ctx, data.Permissions); err != nil {
 		logger.Log.Error("❌ Failed to seed permissions", zap.Error(err))
-		return fmt.Errorf("failed to seed permissions: %w", err)
+		return run.plan, fmt.Errorf("failed to seed permissions: %w", err)
 	}
 	logger.Log.Info("✅ Step 1 completed: Permissions seeded")
 
 	// Step 2: Seed Organizations
 	logger.Log.Info("🏢 Step 2: Seeding organizations")
-	if err := seedOrganizations(ctx, appContainer, data.Organizations); err != nil {
+	if err := run.seedOrganizations(ctx, data.Organizations); err != nil {
 		logger.Log.Error("❌ Failed to seed organizations", zap.Error(err))
-		return fmt.Errorf("failed to seed organizations: %w", err)
+		return run.plan, fmt.Errorf("failed to seed organizations: %w", err)
 	}
 	logger.Log.Info("✅ Step 2 completed: Organizations seeded")
 
 	// Step 3: Seed Roles
 	logger.Log.Info("👤 Step 3: Seeding roles")
-	if err := seedRoles(ctx, appContainer, data.Roles); err != nil {
+	if err := run.seedRoles(ctx, data.Roles); err != nil {
 		logger.Log.Error("❌ Failed to seed roles", zap.Error(err))
-		return fmt.Errorf("failed to seed roles: %w", err)
+		return run.plan, fmt.Errorf("failed to seed roles: %w", err)
 	}
 	logger.Log.Info("✅ Step 3 completed: Roles seeded")
 
 	// Step 4: Seed Users (with organization linking)
 	logger.Log.Info("👥 Step 4: Seeding users")
-	if err := seedUsers(ctx, appContainer, data.Users); err != nil {
+	if err := run.seedUsers(ctx, data.Users); err != nil {
 		logger.Log.Error("❌ Failed to seed users", zap.Error(err))
-		return fmt.Errorf("failed to seed users: %w", err)
+		return run.plan, fmt.Errorf("failed to seed users: %w", err)
 	}
 	logger.Log.Info("✅ Step 4 completed: Users seeded")
 
 	logger.Log.Info("🎉 All seeding steps completed successfully")
-	return nil
+	return run.plan, nil
 }
 
-// seedPermissions handles permission seeding
-func seedPermissions(ctx context.Context, appContainer *container.AppContainer, permissions []PermissionSeed) error {
+// seedPermissions creates missing permissions and updates changed descriptions
+func (r *seedRun) seedPermissions(ctx context.Context, permissions []PermissionSeed) error {
 	logger.Log.Info("🔐 Starting to seed permissions", zap.Int("count", len(permissions)))
 
+	// Fetch the stored permissions once to diff every seed against
+	existing, _, err := r.app.Permission.Repository.List(ctx, make(map[string]interface{}), 1, 5000)
+	if err != nil {
+		logger.Log.Error("Error fetching existing permissions", zap.Error(err))
+		return err
+	}
+	stored := make(map[string]*permissionEntity.Permission, len(existing))
+	r.permissionIDs = make(map[string]string, len(existing))
+	for _, e := range existing {
+		stored[e.String()] = e
+		r.permissionIDs[e.String()] = e.ID.Hex()
+	}
+
 	for i, p := range permissions {
+		key := p.Key()
+		r.seededPermissions = append(r.seededPermissions, key)
 		logger.Log.Debug("Processing permission",
 			zap.Int("index", i+1),
 			zap.Int("total", len(permissions)),
-			zap.String("permission", fmt.Sprintf("%s:%s", p.Resource, p.Action)))
+			zap.String("permission", key))
 
-		existing, _, err := appContainer.Permission.Repository.List(ctx, make(map[string]interface{}), 1, 5000)
-		if err != nil {
-			logger.Log.Error("Error fetching existing permissions", zap.Error(err))
-			return err
+		if e, ok := stored[key]; ok {
+			if e.Description == p.Description {
+				r.plan.add("permission", key, ChangeUnchanged)
+				logger.Log.Debug("Permission up to date, skipping", zap.String("permission", key))
+				continue
+			}
+
+			r.plan.add("permission", key, ChangeUpdate, "description")
+			if r.plan.DryRun {
+				continue
+			}
+			e.Description = p.Description
+			if err := r.app.Permission.UpdatePermissionUseCase.Execute(ctx, e); err != nil {
+				logger.Log.Error("Failed to update permission", zap.String("permission", key), zap.Error(err))
+				return err
+			}
+			logger.Log.Info("✅ Updated permission", zap.String("permission", key))
+			continue
 		}
 
-		if permissionExists(existing, p.Resource, p.Action) {
-			logger.Log.Debug("Permission exists, skipping", zap.String("permission", fmt.Sprintf("%s:%s", p.Resource, p.Action)))
+		r.plan.add("permission", key, ChangeCreate)
+		if r.plan.DryRun {
 			continue
 		}
 
@@ -89,20 +136,22 @@ func seedPermissions(ctx context.Context, appContainer *container.AppContainer,
 			UpdatedAt:   time.Now(),
 		}
 
-		if err := appContainer.Permission.CreatePermissionUseCase.Execute(ctx, perm); err != nil {
-			logger.Log.Error("Failed to seed permission", zap.String("permission", fmt.Sprintf("%s:%s", p.Resource, p.Action)), zap.Error(err))
+		if err := r.app.Permission.CreatePermissionUseCase.Execute(ctx, perm); err != nil {
+			logger.Log.Error("Failed to seed permission", zap.String("permission", key), zap.Error(err))
 			return err
 		}
+		r.permissionIDs[key] = perm.ID.Hex()
 
-		logger.Log.Info("✅ Seeded permission", zap.String("permission", fmt.Sprintf("%s:%s", p.Resource, p.Action)))
+		logger.Log.Info("✅ Seeded permission", zap.String("permission", key))
 	}
 
 	logger.Log.Info("🔐 Permissions seeding completed", zap.Int("count", len(permissions)))
 	return nil
 }
 
-// seedOrganizations handles organization seeding
-func seedOrganizations(ctx context.Context, appContainer *container.AppContainer, organizations []OrganizationSeed) error {
+// seedOrganizations creates missing organizations, matched by slug
+func (r *seedRun) seedOrganizations(ctx context.Context, organizations []OrganizationSeed) error {
+	appContainer := r.app
 	logger.Log.Info("🏢 Starting to seed organizations", zap.Int("count", len(organizations)))
 
 	if len(organizations) == 0 {
@@ -138,10 +187,16 @@ func seedOrganizations(ctx context.Context, appContainer *container.AppContainer
 		}
 
 		if count > 0 {
+			r.plan.add("organization", o.Slug, ChangeUnchanged)
 			logger.Log.Info("Organization exists, skipping", zap.String("slug", o.Slug))
 			continue
 		}
 
+		r.plan.add("organization", o.Slug, ChangeCreate)
+		if r.plan.DryRun {
+			continue
+		}
+
 		// Create organization entity
 		org := &entity.Organization{
 			ID:      primitive.NewObjectID(),
@@ -188,89 +243,172 @@ func seedOrganizations(ctx context.Context, appContainer *container.AppContainer
 	return nil
 }
 
-// seedRoles handles role seeding
-func seedRoles(ctx context.Context, appContainer *container.AppContainer, roles []RoleSeed) error {
+// seedRoles creates missing roles and updates the description, scope and permissions of
+// changed ones, matched by name
+func (r *seedRun) seedRoles(ctx context.Context, roles []RoleSeed) error {
 	logger.Log.Info("👤 Starting to seed roles", zap.Int("count", len(roles)))
 
-	roleCollection := appContainer.MongoDatabase.Collection("roles")
-
-	for i, r := range roles {
+	for i, rs := range roles {
 		logger.Log.Debug("Processing role",
 			zap.Int("index", i+1),
 			zap.Int("total", len(roles)),
-			zap.String("name", r.Name),
-			zap.String("scope", r.Scope))
+			zap.String("name", rs.Name),
+			zap.String("scope", rs.Scope))
 
-		// Check if role already exists
-		count, _ := roleCollection.CountDocuments(ctx, bson.M{"name": r.Name})
-		if count > 0 {
-			logger.Log.Info("Role exists, skipping", zap.String("role", r.Name))
-			continue
+		wanted, err := r.rolePermissions(rs)
+		if err != nil {
+			return fmt.Errorf("role %s: %w", rs.Name, err)
 		}
 
-		// Fetch all permissions for role assignment
-		allPerms, _, err := appContainer.Permission.Repository.List(ctx, make(map[string]interface{}), 1, 5000)
+		existing, err := r.app.Role.Repository.GetByName(ctx, rs.Name)
 		if err != nil {
-			logger.Log.Error("Failed to fetch permissions for role", zap.String("role", r.Name), zap.Error(err))
+			logger.Log.Error("Failed to fetch role", zap.String("role", rs.Name), zap.Error(err))
 			return err
 		}
 
-		// Build permission IDs based on role requirements
-		var permIDs []string
-		if len(r.Permissions) == 1 && r.Permissions[0] == "*" {
-			// "*" means "give me every permission"
-			for _, p := range allPerms {
-				permIDs = append(permIDs, p.ID.Hex())
+		if existing == nil {
+			r.plan.add("role", rs.Name, ChangeCreate, fmt.Sprintf("%d permissions", len(wanted)))
+			if r.plan.DryRun {
+				continue
 			}
-			logger.Log.Debug("Assigning all permissions to role",
-				zap.String("role", r.Name),
-				zap.Int("permission_count", len(permIDs)))
-		} else {
-			// Otherwise only the named ones
-			for _, want := range r.Permissions {
-				for _, p := range allPerms {
-					permString := fmt.Sprintf("%s:%s", p.Resource, p.Action)
-					if permString == want {
-						permIDs = append(permIDs, p.ID.Hex())
-						break
-					}
-				}
+
+			role := &roleEntity.Role{
+				ID:          primitive.NewObjectID(),
+				Name:        rs.Name,
+				Description: rs.Description,
+				Scope:       roleEntity.RoleScope(rs.Scope),
+				Permissions: r.permissionIDsFor(wanted),
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				IsSystem:    true,
+				CreatedBy:   "system",
+			}
+
+			if err := r.app.Role.CreateRoleUseCase.Execute(ctx, role); err != nil {
+				logger.Log.Error("Failed to seed role", zap.String("role", rs.Name), zap.Error(err))
+				return fmt.Errorf("failed to seed role %s: %w", rs.Name, err)
 			}
-			logger.Log.Debug("Assigning specific permissions to role",
-				zap.String("role", r.Name),
-				zap.Int("permission_count", len(permIDs)),
-				zap.Strings("permissions", r.Permissions))
+
+			logger.Log.Info("✅ Seeded role",
+				zap.String("role", rs.Name),
+				zap.String("scope", rs.Scope),
+				zap.String("id", role.ID.Hex()))
+			continue
 		}
 
-		role := &roleEntity.Role{
-			ID:          primitive.NewObjectID(),
-			Name:        r.Name,
-			Description: r.Description,
-			Scope:       roleEntity.RoleScope(r.Scope),
-			Permissions: permIDs,
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
-			IsSystem:    true,
-			CreatedBy:   "system",
+		details := r.roleChanges(existing, rs, wanted)
+		if len(details) == 0 {
+			r.plan.add("role", rs.Name, ChangeUnchanged)
+			logger.Log.Debug("Role up to date, skipping", zap.String("role", rs.Name))
+			continue
 		}
 
-		if err := appContainer.Role.CreateRoleUseCase.Execute(ctx, role); err != nil {
-			logger.Log.Error("Failed to seed role", zap.String("role", r.Name), zap.Error(err))
-			return fmt.Errorf("failed to seed role %s: %w", r.Name, err)
+		r.plan.add("role", rs.Name, ChangeUpdate, details...)
+		if r.plan.DryRun {
+			continue
+		}
+
+		existing.Description = rs.Description
+		existing.Scope = roleEntity.RoleScope(rs.Scope)
+		existing.Permissions = r.permissionIDsFor(wanted)
+		if err := r.app.Role.UpdateRoleUseCase.Execute(ctx, existing); err != nil {
+			logger.Log.Error("Failed to update role", zap.String("role", rs.Name), zap.Error(err))
+			return fmt.Errorf("failed to update role %s: %w", rs.Name, err)
 		}
 
-		logger.Log.Info("✅ Seeded role",
-			zap.String("role", r.Name),
-			zap.String("scope", r.Scope),
-			zap.String("id", role.ID.Hex()))
+		logger.Log.Info("✅ Updated role", zap.String("role", rs.Name), zap.Strings("changes", details))
 	}
 
 	logger.Log.Info("👤 Roles seeding completed", zap.Int("count", len(roles)))
 	return nil
 }
 
-// seedUsers handles user seeding with organization linking
-func seedUsers(ctx context.Context, appContainer *container.AppContainer, users []UserSeed) error {
+// rolePermissions returns the resource:action keys the role is granted. "*" grants every
+// stored and seeded permission.
+func (r *seedRun) rolePermissions(rs RoleSeed) ([]string, error) {
+	known := make(map[string]bool, len(r.permissionIDs)+len(r.seededPermissions))
+	var all []string
+	for _, key := range r.seededPermissions {
+		if !known[key] {
+			known[key] = true
+			all = append(all, key)
+		}
+	}
+	for key := range r.permissionIDs {
+		if !known[key] {
+			known[key] = true
+			all = append(all, key)
+		}
+	}
+
+	if len(rs.Permissions) == 1 && rs.Permissions[0] == "*" {
+		sort.Strings(all)
+		return all, nil
+	}
+	for _, key := range rs.Permissions {
+		if !known[key] {
+			return nil, fmt.Errorf("unknown permission %s", key)
+		}
+	}
+	return rs.Permissions, nil
+}
+
+// roleChanges describes how the stored role differs from its seed
+func (r *seedRun) roleChanges(existing *roleEntity.Role, rs RoleSeed, wanted []string) []string {
+	var details []string
+	if existing.Description != rs.Description {
+		details = append(details, "description")
+	}
+	if string(existing.Scope) != rs.Scope {
+		details = append(details, fmt.Sprintf("scope %s -> %s", existing.Scope, rs.Scope))
+	}
+
+	keys := make(map[string]string, len(r.permissionIDs))
+	for key, id := range r.permissionIDs {
+		keys[id] = key
+	}
+	current := make(map[string]bool, len(existing.Permissions))
+	for _, id := range existing.Permissions {
+		if key, ok := keys[id]; ok {
+			current[key] = true
+		} else {
+			current[id] = true // dangling ID, removed by the update
+		}
+	}
+
+	var changed []string
+	for _, key := range wanted {
+		if !current[key] {
+			changed = append(changed, "+"+key)
+		}
+		delete(current, key)
+	}
+	var removed []string
+	for key := range current {
+		removed = append(removed, "-"+key)
+	}
+	sort.Strings(removed)
+	changed = append(changed, removed...)
+	if len(changed) > 0 {
+		details = append(details, "permissions "+strings.Join(changed, " "))
+	}
+	return details
+}
+
+// permissionIDsFor maps resource:action keys to the IDs of the stored permissions
+func (r *seedRun) permissionIDsFor(keys []string) []string {
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if id, ok := r.permissionIDs[key]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// seedUsers creates missing users, matched by primary email, linking their organization and role
+func (r *seedRun) seedUsers(ctx context.Context, users []UserSeed) error {
+	appContainer := r.app
 	logger.Log.Info("👥 Starting to seed users", zap.Int("count", len(users)))
 
 	userCollection := appContainer.MongoDatabase.Collection("users")
@@ -297,10 +435,16 @@ func seedUsers(ctx context.Context, appContainer *container.AppContainer, users
 		}
 
 		if count > 0 {
+			r.plan.add("user", email, ChangeUnchanged)
 			logger.Log.Info("User exists, skipping", zap.String("email", email))
 			continue
 		}
 
+		r.plan.add("user", email, ChangeCreate)
+		if r.plan.DryRun {
+			continue
+		}
+
 		// Find organization by slug
 		var orgDoc bson.M
 		if err := organizationCollection.FindOne(ctx, bson.M{"slug": u.OrganizationSlug}).Decode(&orgDoc); err != nil {
@@ -367,13 +511,3 @@ func seedUsers(ctx context.Context, appContainer *container.AppContainer, users
 	logger.Log.Info("👥 Users seeding completed", zap.Int("count", len(users)))
 	return nil
 }
-
-// permissionExists checks if permission already exists by name
-func permissionExists(existing []*permissionEntity.Permission, resource, action string) bool {
-	for _, e := range existing {
-		if e.Resource == resource && e.Action == permissionEntity.PermissionAction(action) {
-			return true
-		}
-	}
-	return false
-}
@@ -7,42 +7,50 @@ import (
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.uber.org/zap"
 )
 
+// Options control a seed run
+type Options struct {
+	Force  bool // empty the seed collections first
+	DryRun bool // only plan the changes
+}
+
 // RunSeeder triggers the seeding process.
 //
 // Parameters:
 // - appContainer: global DI container.
-// - force: if true, cleans existing data before seeding.
+// - data: seed data, see LoadProfile and LoadSeedFile.
+// - opts: Force cleans existing data before seeding, DryRun writes nothing.
 //
 // Returns:
+// - Plan: the changes made, or that would be made in a dry run.
 // - error: if seeding fails.
-func RunSeeder(appContainer *container.AppContainer, force bool) error {
-	logger.Log.Info("🚀 Seeder started", zap.String("force", strconv.FormatBool(force)))
+func RunSeeder(appContainer *container.AppContainer, data *SeedData, opts Options) (*Plan, error) {
+	logger.Log.Info("🚀 Seeder started",
+		zap.String("force", strconv.FormatBool(opts.Force)),
+		zap.String("dryRun", strconv.FormatBool(opts.DryRun)))
+
+	if opts.Force && opts.DryRun {
+		return nil, fmt.Errorf("force cannot be combined with a dry run")
+	}
 
 	// Validate container
 	if err := validateContainer(appContainer); err != nil {
 		logger.Log.Error("❌ Container validation failed", zap.Error(err))
-		return err
+		return nil, err
 	}
 
 	// If force mode, purge collections
-	if force {
+	if opts.Force {
 		if err := purgeSeedCollections(appContainer); err != nil {
 			logger.Log.Error("❌ Failed to purge collections", zap.Error(err))
-			return err
+			return nil, err
 		}
 		logger.Log.Info("✅ Purged existing seed collections")
 	}
 
-	// Load seed data from JSON
-	data, err := LoadSeedData()
-	if err != nil {
-		logger.Log.Error("❌ Failed to load seed data", zap.Error(err))
-		return err
-	}
-
 	logger.Log.Info("📋 Loaded seed data",
 		zap.Int("permissions", len(data.Permissions)),
 		zap.Int("organizations", len(data.Organizations)),
@@ -50,13 +58,16 @@ func RunSeeder(appContainer *container.AppContainer, force bool) error {
 		zap.Int("users", len(data.Users)))
 
 	// Execute seeding in proper order
-	if err := ExecuteSeeder(appContainer, data); err != nil {
+	plan, err := ExecuteSeeder(appContainer, data, opts.DryRun)
+	if err != nil {
 		logger.Log.Error("❌ Seeder execution failed", zap.Error(err))
-		return err
+		return plan, err
 	}
 
-	logger.Log.Info("✅ Seeder completed successfully")
-	return nil
+	logger.Log.Info("✅ Seeder completed successfully",
+		zap.Int("created", plan.Count(ChangeCreate)),
+		zap.Int("updated", plan.Count(ChangeUpdate)))
+	return plan, nil
 }
 
 // validateContainer ensures all required components are initialized
@@ -82,7 +93,7 @@ func validateContainer(appContainer *container.AppContainer) error {
 	return nil
 }
 
-// purgeSeedCollections drops existing seed collections
+// purgeSeedCollections empties existing seed collections, keeping the indexes created by migrations
 func purgeSeedCollections(appContainer *container.AppContainer) error {
 	ctx := context.Background()
 
@@ -95,9 +106,8 @@ func purgeSeedCollections(appContainer *container.AppContainer) error {
 	}
 
 	for _, coll := range collections {
-		if err := appContainer.MongoDatabase.Collection(coll).Drop(ctx); err != nil {
-			logger.Log.Warn("⚠️ Failed to purge collection (might not exist)", zap.String("collection", coll), zap.Error(err))
-			// Don't return error here as collection might not exist
+		if _, err := appContainer.MongoDatabase.Collection(coll).DeleteMany(ctx, bson.M{}); err != nil {
+			logger.Log.Warn("⚠️ Failed to purge collection", zap.String("collection", coll), zap.Error(err))
 		} else {
 			logger.Log.Info("💥 Collection purged", zap.String("collection", coll))
 		}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+)
+
+// newBulkHandler builds the bulk handler each resource mounts its bulk route on
+func newBulkHandler(app *container.AppContainer) *bulk.Handler {
+	return bulk.NewHandler(
+		app.RBACService,
+		app.Config.BulkMaxItems,
+		func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return database.WithTransaction(ctx, app.MongoClient, fn)
+		},
+	)
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	exportHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/presentation/http/handlers"
+	exportRoutes "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/presentation/http/routes"
+	"github.com/gin-gonic/gin"
+)
+
+// newExportHandler builds the export handler each resource mounts its export route on
+func newExportHandler(app *container.AppContainer) *exportHandlers.ExportHandler {
+	return exportHandlers.NewExportHandler(
+		app.Export.StartExportUseCase,
+		app.Export.GetExportJobUseCase,
+		app.FileService,
+		app.Config.ExportSyncMaxRows,
+		app.Organization.FeatureFlagEvaluator,
+	)
+}
+
+func registerExportRoutes(router *gin.RouterGroup, app *container.AppContainer) {
+	exportRoutes.RegisterExportRoutes(router, newExportHandler(app))
+}
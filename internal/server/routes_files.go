@@ -0,0 +1,93 @@
+package server
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// registerFileRoutes serves files written by the local storage driver through signed URLs.
+// Other drivers serve files themselves, so nothing is registered for them.
+func registerFileRoutes(rg *gin.RouterGroup, app *container.AppContainer) {
+	localFiles, ok := app.FileService.(*services.LocalFileService)
+	if !ok {
+		return
+	}
+
+	rg.GET(constants.FilesBasePath+constants.ServeFilePath, serveLocalFile(localFiles))
+	rg.PUT(constants.FilesBasePath+constants.ServeFilePath, receiveLocalUpload(localFiles))
+}
+
+// receiveLocalUpload accepts a direct upload presigned by LocalFileService.PresignUpload
+func receiveLocalUpload(fs *services.LocalFileService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimPrefix(c.Param("key"), "/")
+
+		size, err := fs.VerifyUploadURL(key, c.Request.URL.Query(), c.ContentType())
+		if err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeForbidden, err.Error(), nil, http.StatusForbidden))
+			return
+		}
+		if c.Request.ContentLength >= 0 && c.Request.ContentLength != size {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, "Content-Length does not match the signed size", nil, http.StatusBadRequest))
+			return
+		}
+
+		if err := fs.WriteUpload(key, c.Request.Body, size); err != nil {
+			if errors.Is(err, services.ErrInvalidFileKey) || errors.Is(err, services.ErrUploadSizeMismatch) {
+				middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+				return
+			}
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to store upload", err, http.StatusInternalServerError))
+			return
+		}
+
+		c.Status(http.StatusOK)
+	}
+}
+
+func serveLocalFile(fs *services.LocalFileService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := strings.TrimPrefix(c.Param("key"), "/")
+
+		if err := fs.VerifySignedURL(key, c.Query("expires"), c.Query("signature")); err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeForbidden, err.Error(), nil, http.StatusForbidden))
+			return
+		}
+
+		file, err := fs.Open(key)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) || errors.Is(err, services.ErrInvalidFileKey) {
+				middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "File not found", nil, http.StatusNotFound))
+				return
+			}
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to read file", err, http.StatusInternalServerError))
+			return
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil || info.IsDir() {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "File not found", nil, http.StatusNotFound))
+			return
+		}
+
+		if contentType := mime.TypeByExtension(filepath.Ext(key)); contentType != "" {
+			c.Header("Content-Type", contentType)
+		}
+		c.Header("Cache-Control", "private, max-age=300")
+		c.Header("X-Content-Type-Options", "nosniff")
+		// Uploaded SVGs must not run scripts on the API origin
+		c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+		http.ServeContent(c.Writer, c.Request, info.Name(), info.ModTime(), file)
+	}
+}
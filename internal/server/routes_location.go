@@ -21,8 +21,25 @@ func registerLocationRoutes(router *gin.RouterGroup, app *container.AppContainer
 		app.Location.RestoreLocationUseCase,
 		app.Location.BulkRestoreLocationsUseCase,
 		app.Location.HardDeleteLocationUseCase,
+		app.Location.GetLocationChildrenUseCase,
+		app.Location.GetLocationAncestorsUseCase,
+		app.Location.MoveLocationUseCase,
+		app.Location.ListLocationsByDistanceUseCase,
+		app.Location.SearchLocationsUseCase,
+		app.Location.AutocompleteLocationsUseCase,
+		app.Location.RecordLocationViewUseCase,
+		app.Location.ImportLocationsUseCase,
+		app.Location.GetLocationImportJobUseCase,
+		app.Location.ExportLocationsUseCase,
+		app.ImagePipeline,
+		app.Location.ReorderLocationMediaUseCase,
+		app.Location.UpdateLocationMediaUseCase,
+		app.Location.SetLocationCoverMediaUseCase,
+		app.Location.DeleteLocationMediaUseCase,
+		app.UploadScanner,
+		app.Location.PatchLocationUseCase,
 	)
 
 	// Register location routes with the handler
-	locRoutes.RegisterLocationRoutes(router, locHandler, app)
+	locRoutes.RegisterLocationRoutes(router, locHandler, app, newUploadHandler(app), newBulkHandler(app))
 }
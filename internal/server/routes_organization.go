@@ -21,7 +21,17 @@ func registerOrganizationRoutes( router *gin.RouterGroup, app *container.AppCont
 		app.Organization.BulkSoftDeleteOrganizationsUseCase,
 		app.Organization.HardDeleteOrganizationUseCase,
 		app.Organization.BulkRestoreOrganizationsUseCase,
+		app.Organization.GetOrganizationSettingsUseCase,
+		app.Organization.UpdateOrganizationSettingsUseCase,
+		app.Organization.ListFeatureFlagOverridesUseCase,
+		app.Organization.SetFeatureFlagOverrideUseCase,
+		app.Organization.DeleteFeatureFlagOverrideUseCase,
+		app.Organization.FeatureFlagEvaluator,
+		app.ImagePipeline,
+		app.UploadScanner,
+		app.Organization.PatchOrganizationUseCase,
+		app.Organization.ExportOrganizationsUseCase,
 	)
 
-	orgRoutes.RegisterOrganizationRoutes(router, orgHandler)
+	orgRoutes.RegisterOrganizationRoutes(router, orgHandler, newUploadHandler(app), newExportHandler(app), newBulkHandler(app))
 }
\ No newline at end of file
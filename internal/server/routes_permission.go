@@ -14,6 +14,7 @@ func registerPermissionRoutes(router *gin.RouterGroup, app *container.AppContain
 		app.Permission.ListPermissionsUseCase,
 		app.Permission.UpdatePermissionUseCase,
 		app.Permission.HardDeletePermissionUseCase,
+		app.Permission.PatchPermissionUseCase,
 	)
 
 	permissionRoutes.RegisterPermissionRoutes(router, permissionHandler)
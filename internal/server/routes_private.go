@@ -17,6 +17,7 @@ func registerPrivateRoutes(r *gin.Engine, app *container.AppContainer) {
 		middleware.ResponseInterceptor(),
 		middleware.SecureHeaders(),
 		middleware.AuthMiddleware(app.RBACService, app.Config), // ← Auth happens ONCE here
+		middleware.Idempotency(app.IdempotencyStore, app.Config.IdempotencyKeyTTL, app.Config.IdempotencyLockTTL),
 	)
 
 
@@ -26,4 +27,6 @@ func registerPrivateRoutes(r *gin.Engine, app *container.AppContainer) {
 	registerRoleRoutes(private, app)
 	registerOrganizationRoutes(private, app)
 	registerLocationRoutes(private, app)
+	registerExportRoutes(private, app)
+	registerTrashRoutes(private, app)
 }
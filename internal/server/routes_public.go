@@ -46,9 +46,17 @@ func registerPublicRoutes(r *gin.Engine, app *container.AppContainer) {
 		app.User.UpdateUserStatusUseCase,
 		app.FileService,
 		app.User.FindUserByEmailUsecase,
+		app.ImagePipeline,
+		app.UploadScanner,
+		app.User.PatchUserUseCase,
+		app.User.ExpandUsersUseCase,
+		app.User.ExportUsersUseCase,
+		app.User.ImportUsersUseCase,
+		app.User.AssignUserRoleUseCase,
 	)
 
 	public.POST("/users/login", userHandler.Login)
 
+	registerFileRoutes(public, app)
 	registerSwaggerRoutes(public, app)
 }
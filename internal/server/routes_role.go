@@ -19,9 +19,11 @@ func registerRoleRoutes(router *gin.RouterGroup, app *container.AppContainer) {
 		app.Role.HardDeleteRoleUseCase,
 		app.Role.BulkRestoreRolesUseCase,
 		app.Permission.ListPermissionsUseCase,
-		// app.RBACService,
+		app.Role.PatchRoleUseCase,
+		app.Role.ExportRolesUseCase,
+		app.RBACService,
 		// app.PermissionValidator,
 	)
 
-	routes.RegisterRoleRoutes(router, roleHandler, app)
+	routes.RegisterRoleRoutes(router, roleHandler, app, newExportHandler(app), newBulkHandler(app))
 }
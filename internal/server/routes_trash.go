@@ -0,0 +1,18 @@
+package server
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/presentation/http/handlers"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/presentation/http/routes"
+	"github.com/gin-gonic/gin"
+)
+
+func registerTrashRoutes(router *gin.RouterGroup, app *container.AppContainer) {
+	trashHandler := handlers.NewTrashHandler(
+		app.Trash.ListTrashUseCase,
+		app.Trash.RestoreTrashItemUseCase,
+		app.Trash.PurgeTrashItemUseCase,
+	)
+
+	routes.RegisterTrashRoutes(router, trashHandler, app)
+}
@@ -0,0 +1,14 @@
+package server
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	uploadHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/presentation/http/handlers"
+)
+
+// newUploadHandler builds the direct upload handler each resource mounts its upload routes on
+func newUploadHandler(app *container.AppContainer) *uploadHandlers.UploadHandler {
+	return uploadHandlers.NewUploadHandler(
+		app.Upload.CreateUploadSessionUseCase,
+		app.Upload.ConfirmUploadSessionUseCase,
+	)
+}
@@ -21,7 +21,14 @@ func registerUserRoutes(router *gin.RouterGroup, app *container.AppContainer) {
 		app.User.UpdateUserStatusUseCase,
 		app.FileService,
 		app.User.FindUserByEmailUsecase,
+		app.ImagePipeline,
+		app.UploadScanner,
+		app.User.PatchUserUseCase,
+		app.User.ExpandUsersUseCase,
+		app.User.ExportUsersUseCase,
+		app.User.ImportUsersUseCase,
+		app.User.AssignUserRoleUseCase,
 	)
 
-	userRoutes.RegisterUserRoutes(router, userHandler, app)
+	userRoutes.RegisterUserRoutes(router, userHandler, app, newUploadHandler(app), newExportHandler(app), newBulkHandler(app))
 }
@@ -1,7 +1,11 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
 
@@ -9,8 +13,12 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// StartServer initializes and starts the Gin HTTP server
-func StartServer(app *container.AppContainer) error {
+// shutdownTimeout is how long in-flight requests get to finish once ctx is cancelled
+const shutdownTimeout = 30 * time.Second
+
+// StartServer initializes and starts the Gin HTTP server. It returns once ctx is
+// cancelled and the requests in flight have finished.
+func StartServer(ctx context.Context, app *container.AppContainer) error {
 	// Gin engine
 	r := gin.New()
 
@@ -24,5 +32,25 @@ func StartServer(app *container.AppContainer) error {
 	registerRoutes(r, app)
 
 	// Start server
-	return r.Run(fmt.Sprintf(":%s", app.Config.Port))
-}
\ No newline at end of file
+	srv := &http.Server{Addr: fmt.Sprintf(":%s", app.Config.Port), Handler: r}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
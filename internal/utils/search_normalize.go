@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeSearchText lowercases s, transliterates Devanagari to Latin, strips
+// diacritics and collapses everything that is not a letter or digit into single spaces.
+// "Ḍārjiliṅ" and "दार्जिलिंग" both come out as plain ASCII words.
+func NormalizeSearchText(s string) string {
+	s = transliterateDevanagari(s)
+
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	if err == nil {
+		s = stripped
+	}
+
+	var b strings.Builder
+	space := true
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// phoneticRules folds common variations in romanized Indian place names onto one spelling.
+// Order matters: digraphs are reduced before vowels are merged.
+var phoneticRules = strings.NewReplacer(
+	"chh", "c", "ch", "c", "sh", "s", "kh", "k", "gh", "g", "jh", "j",
+	"th", "t", "dh", "d", "ph", "f", "bh", "b", "w", "v", "z", "j", "q", "k",
+	"aa", "a", "ee", "i", "ii", "i", "oo", "u", "uu", "u", "ou", "u", "ey", "e", "y", "i",
+)
+
+// PhoneticKey returns a spelling-insensitive key for each word of s, so that
+// "Darjeeling", "Darjiling" and "Darjeling" share the same key.
+func PhoneticKey(s string) string {
+	words := strings.Fields(NormalizeSearchText(s))
+	for i, word := range words {
+		word = phoneticRules.Replace(word)
+		words[i] = collapseRepeats(word)
+	}
+	return strings.Join(words, " ")
+}
+
+// Trigrams returns the distinct, sorted character trigrams of the phonetic key of s.
+// Each word is padded so that short words and word boundaries still produce trigrams.
+func Trigrams(s string) []string {
+	seen := make(map[string]struct{})
+	for _, word := range strings.Fields(PhoneticKey(s)) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			seen[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+
+	out := make([]string, 0, len(seen))
+	for gram := range seen {
+		out = append(out, gram)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// TrigramSimilarity returns the Jaccard similarity of two sorted trigram sets
+func TrigramSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	i, j, shared := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			shared++
+			i++
+			j++
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+func collapseRepeats(s string) string {
+	var b strings.Builder
+	var prev rune
+	for i, r := range s {
+		if i > 0 && r == prev {
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+var (
+	devanagariVowels = map[rune]string{
+		'अ': "a", 'आ': "aa", 'इ': "i", 'ई': "ee", 'उ': "u", 'ऊ': "oo", 'ऋ': "ri",
+		'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au",
+	}
+	devanagariMatras = map[rune]string{
+		'ा': "aa", 'ि': "i", 'ी': "ee", 'ु': "u", 'ू': "oo", 'ृ': "ri",
+		'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au",
+	}
+	devanagariConsonants = map[rune]string{
+		'क': "k", 'ख': "kh", 'ग': "g", 'घ': "gh", 'ङ': "n",
+		'च': "ch", 'छ': "chh", 'ज': "j", 'झ': "jh", 'ञ': "n",
+		'ट': "t", 'ठ': "th", 'ड': "d", 'ढ': "dh", 'ण': "n",
+		'त': "t", 'थ': "th", 'द': "d", 'ध': "dh", 'न': "n",
+		'प': "p", 'फ': "ph", 'ब': "b", 'भ': "bh", 'म': "m",
+		'य': "y", 'र': "r", 'ल': "l", 'व': "v", 'श': "sh", 'ष': "sh", 'स': "s", 'ह': "h",
+	}
+)
+
+const (
+	devanagariVirama   = '्'
+	devanagariAnusvara = 'ं'
+	devanagariVisarga  = 'ः'
+	devanagariNukta    = '़'
+)
+
+// transliterateDevanagari converts Devanagari script to a simple Latin romanization,
+// leaving every other rune untouched. Consonants carry an inherent "a" unless followed
+// by a matra or virama, and a trailing inherent "a" is dropped as in spoken Hindi.
+func transliterateDevanagari(s string) string {
+	if !strings.ContainsFunc(s, func(r rune) bool { return r >= 0x0900 && r <= 0x097F }) {
+		return s
+	}
+
+	src := []rune(norm.NFC.String(s))
+	var b strings.Builder
+	for i := 0; i < len(src); i++ {
+		r := src[i]
+		if consonant, ok := devanagariConsonants[r]; ok {
+			b.WriteString(consonant)
+			next := rune(0)
+			if i+1 < len(src) {
+				next = src[i+1]
+			}
+			if next == devanagariNukta && i+2 < len(src) {
+				i++
+				next = src[i+1]
+			}
+			_, isMatra := devanagariMatras[next]
+			atWordEnd := next == 0 || !(next >= 0x0900 && next <= 0x097F)
+			if !isMatra && next != devanagariVirama && !atWordEnd {
+				b.WriteString("a")
+			}
+			continue
+		}
+		if vowel, ok := devanagariVowels[r]; ok {
+			b.WriteString(vowel)
+			continue
+		}
+		if matra, ok := devanagariMatras[r]; ok {
+			b.WriteString(matra)
+			continue
+		}
+		switch r {
+		case devanagariVirama, devanagariNukta:
+		case devanagariAnusvara:
+			b.WriteString("n")
+		case devanagariVisarga:
+			b.WriteString("h")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
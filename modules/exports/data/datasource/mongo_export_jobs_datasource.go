@@ -0,0 +1,66 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/data/mongodb/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoExportJobDatasource handles raw MongoDB operations for export jobs.
+type MongoExportJobDatasource struct {
+	collection *mongo.Collection
+}
+
+// NewMongoExportJobDatasource creates a new datasource. Indexes are created by migrations.
+func NewMongoExportJobDatasource(db *mongo.Database) *MongoExportJobDatasource {
+	coll := db.Collection((&model.ExportJobModel{}).CollectionName())
+	return &MongoExportJobDatasource{collection: coll}
+}
+
+// Insert inserts a new job.
+func (ds *MongoExportJobDatasource) Insert(ctx context.Context, m *model.ExportJobModel) error {
+	res, err := ds.collection.InsertOne(ctx, m)
+	if err != nil {
+		return err
+	}
+	m.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// Replace overwrites the job document.
+func (ds *MongoExportJobDatasource) Replace(ctx context.Context, m *model.ExportJobModel) error {
+	_, err := ds.collection.ReplaceOne(ctx, bson.M{"_id": m.ID}, m)
+	return err
+}
+
+// FindByID finds a job by its ObjectID.
+// Returns (nil, nil) if not found.
+func (ds *MongoExportJobDatasource) FindByID(ctx context.Context, id primitive.ObjectID) (*model.ExportJobModel, error) {
+	var m model.ExportJobModel
+	err := ds.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&m)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+// FailUnfinished marks the pending and running jobs created before createdBefore as failed.
+func (ds *MongoExportJobDatasource) FailUnfinished(ctx context.Context, createdBefore time.Time, message string) (int64, error) {
+	filter := bson.M{
+		"status":    bson.M{"$in": bson.A{"pending", "running"}},
+		"createdAt": bson.M{"$lt": createdBefore},
+	}
+	update := bson.M{"$set": bson.M{"status": "failed", "message": message, "completedAt": time.Now()}}
+	res, err := ds.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
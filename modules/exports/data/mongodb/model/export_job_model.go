@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExportJobModel is the Mongo schema for background export jobs
+type ExportJobModel struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Resource    string             `bson:"resource"`
+	Format      string             `bson:"format"`
+	Columns     []string           `bson:"columns"`
+	Status      string             `bson:"status"`
+	TotalRows   int64              `bson:"totalRows"`
+	Rows        int64              `bson:"rows"`
+	FileName    string             `bson:"fileName"`
+	FileKey     string             `bson:"fileKey,omitempty"`
+	Message     string             `bson:"message,omitempty"`
+	CreatedBy   string             `bson:"createdBy,omitempty"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+	StartedAt   *time.Time         `bson:"startedAt,omitempty"`
+	CompletedAt *time.Time         `bson:"completedAt,omitempty"`
+	ExpiresAt   time.Time          `bson:"expiresAt"`
+}
+
+func (m *ExportJobModel) CollectionName() string {
+	return "export_jobs"
+}
+
+// FromEntity maps domain→model
+func FromEntity(e *entity.ExportJob) *ExportJobModel {
+	return &ExportJobModel{
+		ID:          e.ID,
+		Resource:    e.Resource,
+		Format:      e.Format,
+		Columns:     e.Columns,
+		Status:      e.Status,
+		TotalRows:   e.TotalRows,
+		Rows:        e.Rows,
+		FileName:    e.FileName,
+		FileKey:     e.FileKey,
+		Message:     e.Message,
+		CreatedBy:   e.CreatedBy,
+		CreatedAt:   e.CreatedAt,
+		StartedAt:   e.StartedAt,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+	}
+}
+
+// ToEntity maps model→domain
+func (m *ExportJobModel) ToEntity() entity.ExportJob {
+	return entity.ExportJob{
+		ID:          m.ID,
+		Resource:    m.Resource,
+		Format:      m.Format,
+		Columns:     m.Columns,
+		Status:      m.Status,
+		TotalRows:   m.TotalRows,
+		Rows:        m.Rows,
+		FileName:    m.FileName,
+		FileKey:     m.FileKey,
+		Message:     m.Message,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Ensure interface compliance
+var _ repository.ExportJobRepository = (*ExportJobRepositoryMongo)(nil)
+
+// ExportJobRepositoryMongo implements ExportJobRepository using MongoDB.
+type ExportJobRepositoryMongo struct {
+	datasource *datasource.MongoExportJobDatasource
+}
+
+// NewExportJobRepositoryMongo creates a new ExportJobRepositoryMongo.
+func NewExportJobRepositoryMongo(ds *datasource.MongoExportJobDatasource) *ExportJobRepositoryMongo {
+	return &ExportJobRepositoryMongo{datasource: ds}
+}
+
+// Create inserts a new job and back-fills its ID.
+func (r *ExportJobRepositoryMongo) Create(ctx context.Context, job *entity.ExportJob) error {
+	m := model.FromEntity(job)
+	if err := r.datasource.Insert(ctx, m); err != nil {
+		return err
+	}
+	job.ID = m.ID
+	return nil
+}
+
+// Update replaces the job document.
+func (r *ExportJobRepositoryMongo) Update(ctx context.Context, job *entity.ExportJob) error {
+	return r.datasource.Replace(ctx, model.FromEntity(job))
+}
+
+// FindByID returns the job, or nil if it does not exist.
+func (r *ExportJobRepositoryMongo) FindByID(ctx context.Context, id primitive.ObjectID) (*entity.ExportJob, error) {
+	m, err := r.datasource.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+	e := m.ToEntity()
+	return &e, nil
+}
+
+// FailUnfinished marks the pending and running jobs created before createdBefore as failed.
+func (r *ExportJobRepositoryMongo) FailUnfinished(ctx context.Context, createdBefore time.Time, message string) (int64, error) {
+	return r.datasource.FailUnfinished(ctx, createdBefore, message)
+}
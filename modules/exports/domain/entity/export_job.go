@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Export job statuses
+const (
+	ExportStatusPending   = "pending"
+	ExportStatusRunning   = "running"
+	ExportStatusCompleted = "completed"
+	ExportStatusFailed    = "failed"
+)
+
+// ExportJob tracks an export too large to stream in the response, written to storage in
+// the background. The job and its download link expire together.
+type ExportJob struct {
+	ID          primitive.ObjectID `json:"id"`
+	Resource    string             `json:"resource" example:"users"`
+	Format      string             `json:"format" example:"xlsx"`
+	Columns     []string           `json:"columns"`
+	Status      string             `json:"status" example:"completed"`
+	TotalRows   int64              `json:"totalRows"` // Rows matching when the job started
+	Rows        int64              `json:"rows"`      // Rows written to the file
+	FileName    string             `json:"fileName" example:"users-20260101-120000.xlsx"`
+	FileKey     string             `json:"-"`
+	DownloadURL string             `json:"downloadUrl,omitempty"` // Resolved from FileKey when completed, never stored
+	Message     string             `json:"message,omitempty"`
+	CreatedBy   string             `json:"createdBy,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	StartedAt   *time.Time         `json:"startedAt,omitempty"`
+	CompletedAt *time.Time         `json:"completedAt,omitempty"`
+	ExpiresAt   time.Time          `json:"expiresAt"`
+}
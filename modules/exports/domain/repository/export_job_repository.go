@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ExportJobRepository persists background export jobs
+type ExportJobRepository interface {
+	// Create inserts a new job and sets its ID on the entity.
+	Create(ctx context.Context, job *entity.ExportJob) error
+
+	// Update replaces the job's status, counters and file.
+	Update(ctx context.Context, job *entity.ExportJob) error
+
+	// FindByID returns the job, or nil if it does not exist.
+	FindByID(ctx context.Context, id primitive.ObjectID) (*entity.ExportJob, error)
+
+	// FailUnfinished marks the pending and running jobs created before createdBefore as
+	// failed with message, returning the number marked.
+	FailUnfinished(ctx context.Context, createdBefore time.Time, message string) (int64, error)
+}
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GetExportJobUseCase fetches an export job's status and file
+type GetExportJobUseCase struct {
+	repo repository.ExportJobRepository
+}
+
+func NewGetExportJobUseCase(repo repository.ExportJobRepository) *GetExportJobUseCase {
+	return &GetExportJobUseCase{repo: repo}
+}
+
+// Execute returns the job, or nil if it does not exist or has expired
+func (uc *GetExportJobUseCase) Execute(ctx context.Context, id primitive.ObjectID) (*entity.ExportJob, error) {
+	job, err := uc.repo.FindByID(ctx, id)
+	if err != nil || job == nil {
+		return nil, err
+	}
+	// The TTL index removes expired jobs only periodically
+	if time.Now().After(job.ExpiresAt) {
+		return nil, nil
+	}
+	return job, nil
+}
@@ -0,0 +1,113 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/jobs"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/repository"
+)
+
+// exportJobTimeout bounds how long a single export may run in the background
+const exportJobTimeout = 30 * time.Minute
+
+// StartExportUseCase stores an export job and writes its file to storage in the background
+type StartExportUseCase struct {
+	repo       repository.ExportJobRepository
+	files      services.FileService
+	ttl        time.Duration
+	background *jobs.Group
+}
+
+// NewStartExportUseCase creates the use case. Jobs and their files are kept for ttl and
+// written in background, which cancels them on shutdown.
+func NewStartExportUseCase(repo repository.ExportJobRepository, files services.FileService, ttl time.Duration, background *jobs.Group) *StartExportUseCase {
+	return &StartExportUseCase{repo: repo, files: files, ttl: ttl, background: background}
+}
+
+// Execute stores a pending job and writes rows asynchronously. Poll the job for the
+// download link. rows must not depend on the request context, which ends with the response.
+func (uc *StartExportUseCase) Execute(ctx context.Context, job *entity.ExportJob, columns export.Columns, rows export.Rows) (*entity.ExportJob, error) {
+	job.Status = entity.ExportStatusPending
+	job.Columns = columns.Keys()
+	job.CreatedAt = time.Now()
+	job.ExpiresAt = job.CreatedAt.Add(uc.ttl)
+
+	if err := uc.repo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	snapshot := *job
+	uc.background.Go(func(ctx context.Context) {
+		uc.run(ctx, &snapshot, columns, rows)
+	})
+
+	return job, nil
+}
+
+// FailInterrupted marks the jobs a crashed server left pending or running as failed.
+// Only jobs older than the export timeout are touched, as younger ones may still be
+// running on another instance.
+func (uc *StartExportUseCase) FailInterrupted(ctx context.Context) (int64, error) {
+	return uc.repo.FailUnfinished(ctx, time.Now().Add(-exportJobTimeout), "export was interrupted by a server restart")
+}
+
+func (uc *StartExportUseCase) run(ctx context.Context, job *entity.ExportJob, columns export.Columns, rows export.Rows) {
+	ctx, cancel := context.WithTimeout(ctx, exportJobTimeout)
+	defer cancel()
+
+	started := time.Now()
+	job.Status = entity.ExportStatusRunning
+	job.StartedAt = &started
+	uc.save(ctx, job)
+
+	err := uc.write(ctx, job, columns, rows)
+
+	completed := time.Now()
+	job.CompletedAt = &completed
+	if err != nil {
+		job.Status = entity.ExportStatusFailed
+		job.Message = fmt.Sprintf("export stopped after %d rows: %v", job.Rows, err)
+	} else {
+		job.Status = entity.ExportStatusCompleted
+	}
+	uc.save(context.Background(), job)
+}
+
+// write exports rows to a temporary file, so nothing is held in memory, then stores it
+func (uc *StartExportUseCase) write(ctx context.Context, job *entity.ExportJob, columns export.Columns, rows export.Rows) error {
+	file, err := os.CreateTemp("", "export-*."+job.Format)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	format := export.Format(job.Format)
+	job.Rows, err = export.Write(ctx, file, format, columns, rows)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		return err
+	}
+
+	key, err := uc.files.UploadFile(ctx, file, job.FileName, format.ContentType())
+	if err != nil {
+		return err
+	}
+	job.FileKey = key
+	return nil
+}
+
+func (uc *StartExportUseCase) save(ctx context.Context, job *entity.ExportJob) {
+	if err := uc.repo.Update(ctx, job); err != nil {
+		log.Printf("Failed to save export job %s: %v", job.ID.Hex(), err)
+	}
+}
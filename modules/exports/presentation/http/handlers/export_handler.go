@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/domain/usecases"
+	orgEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+)
+
+// SourceBuilder resolves the rows an export request selects from the resource's list
+// filters and the caller's scope
+type SourceBuilder func(c *gin.Context) (*export.Source, error)
+
+// ExportHandler serves the export endpoints mounted under each resource
+type ExportHandler struct {
+	StartExportUseCase  *usecases.StartExportUseCase
+	GetExportJobUseCase *usecases.GetExportJobUseCase
+	FileService         services.FileService
+	SyncMaxRows         int64 // larger exports run as background jobs
+	FeatureFlags        middleware.FeatureFlagChecker
+}
+
+// NewExportHandler creates a new ExportHandler
+func NewExportHandler(
+	startUC *usecases.StartExportUseCase,
+	getJobUC *usecases.GetExportJobUseCase,
+	fileService services.FileService,
+	syncMaxRows int64,
+	featureFlags middleware.FeatureFlagChecker,
+) *ExportHandler {
+	return &ExportHandler{
+		StartExportUseCase:  startUC,
+		GetExportJobUseCase: getJobUC,
+		FileService:         fileService,
+		SyncMaxRows:         syncMaxRows,
+		FeatureFlags:        featureFlags,
+	}
+}
+
+// RequireEnabled refuses exports to organizations without the data export feature. It is
+// mounted in front of every Export route.
+func (h *ExportHandler) RequireEnabled() gin.HandlerFunc {
+	return middleware.RequireFeatureFlag(h.FeatureFlags, orgEntity.FeatureFlagDataExport)
+}
+
+// Export godoc
+//
+//	@Summary		Export a listing
+//	@Description	Export everything the list endpoint would return for the same filters and scope, as CSV or XLSX. Up to EXPORT_SYNC_MAX_ROWS rows are streamed in the response; larger exports, or any with async=true, run as a background job and return 202 with the job to poll for the download link.
+//	@Tags			exports
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Produce		json
+//	@Param			format			query		string	false	"File format"	Enums(csv, xlsx)	default(csv)
+//	@Param			columns			query		string	false	"Comma separated columns to include, in order. All columns when omitted"	example(id,name,createdAt)
+//	@Param			async			query		bool	false	"Always run as a background job"	default(false)
+//	@Param			sort			query		string	false	"Comma separated sort fields, prefixed with - for descending"	example(-createdAt)
+//	@Param			filter			query		string	false	"Filter expression, clauses field:operator:value separated by ;"	example(createdAt:gte:2026-01-01)
+//	@Param			includeDeleted	query		bool	false	"Include soft-deleted records"	default(false)
+//	@Success		200				{file}		file	"The export file"
+//	@Success		202				{object}	models.SwaggerStandardResponse{data=entity.ExportJob}
+//	@Failure		400				{object}	models.SwaggerErrorResponse
+//	@Failure		403				{object}	models.SwaggerErrorResponse	"Data export is not enabled for the organization"
+//	@Failure		500				{object}	models.SwaggerErrorResponse
+//	@Router			/organizations/export [get]
+//	@Router			/roles/export [get]
+//	@Router			/users/export [get]
+func (h *ExportHandler) Export(resource string, columns export.Columns, source SourceBuilder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, err := export.ParseFormat(c.Query("format"))
+		if err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+			return
+		}
+		selected, err := columns.Select(c.Query("columns"))
+		if err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+			return
+		}
+		async, err := strconv.ParseBool(c.DefaultQuery("async", "false"))
+		if err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, "async must be true or false", nil, http.StatusBadRequest))
+			return
+		}
+
+		src, err := source(c)
+		if err != nil {
+			middleware.HandleError(c, middleware.NewListAppError(err, "Failed to export "+resource))
+			return
+		}
+		total, err := src.Count(c.Request.Context())
+		if err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to export "+resource, err, http.StatusInternalServerError))
+			return
+		}
+
+		fileName := format.FileName(resource, time.Now())
+		if async || total > h.SyncMaxRows {
+			job := &entity.ExportJob{
+				Resource:  resource,
+				Format:    string(format),
+				FileName:  fileName,
+				TotalRows: total,
+			}
+			if authCtx := middleware.GetAuthContext(c.Request.Context()); authCtx != nil {
+				job.CreatedBy = authCtx.UserID.Hex()
+			}
+
+			job, err = h.StartExportUseCase.Execute(c.Request.Context(), job, selected, src.Rows)
+			if err != nil {
+				middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to start export", err, http.StatusInternalServerError))
+				return
+			}
+			c.JSON(http.StatusAccepted, job)
+			return
+		}
+
+		// From here on the body goes straight to the client, errors can only abort the stream
+		middleware.EnableStreaming(c)
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fileName))
+		c.Header("Content-Type", format.ContentType())
+		c.Status(http.StatusOK)
+
+		if _, err := export.Write(c.Request.Context(), c.Writer, format, selected, src.Rows); err != nil {
+			// Headers are already sent, so the client only sees a truncated file
+			logger.Log.Error("Export aborted", zap.String("resource", resource), zap.String("format", string(format)), zap.Error(err))
+			c.Abort()
+		}
+	}
+}
+
+// GetExportJob godoc
+//
+//	@Summary		Get export job
+//	@Description	Get the status of a background export and, once completed, a link to download the file. Jobs are visible to the user who started them and to global admins until they expire.
+//	@Tags			exports
+//	@Produce		json
+//	@Param			id	path		string	true	"Export job ID"
+//	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.ExportJob}
+//	@Failure		400	{object}	models.SwaggerErrorResponse
+//	@Failure		404	{object}	models.SwaggerErrorResponse
+//	@Failure		500	{object}	models.SwaggerErrorResponse
+//	@Router			/exports/{id} [get]
+func (h *ExportHandler) GetExportJob(c *gin.Context) {
+	jobID, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid export job ID", err, http.StatusBadRequest))
+		return
+	}
+
+	job, err := h.GetExportJobUseCase.Execute(c.Request.Context(), jobID)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch export job", err, http.StatusInternalServerError))
+		return
+	}
+	// Other users' jobs are reported as missing rather than forbidden
+	if job == nil || !canReadJob(c, job) {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "Export job not found", nil, http.StatusNotFound))
+		return
+	}
+
+	if job.Status == entity.ExportStatusCompleted && job.FileKey != "" {
+		job.DownloadURL = services.ResolveFileURL(h.FileService, job.FileKey)
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// canReadJob reports whether the caller started the job or is a global admin
+func canReadJob(c *gin.Context, job *entity.ExportJob) bool {
+	authCtx := middleware.GetAuthContext(c.Request.Context())
+	if authCtx == nil {
+		return false
+	}
+	return authCtx.RoleScope == roleEntity.RoleScopeGlobal || authCtx.UserID.Hex() == job.CreatedBy
+}
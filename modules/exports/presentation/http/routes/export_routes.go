@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/presentation/http/handlers"
+)
+
+// RegisterExportRoutes registers the export job routes. Exports themselves are started
+// under each resource; jobs are checked against their creator instead of a permission.
+func RegisterExportRoutes(router *gin.RouterGroup, handler *handlers.ExportHandler) {
+	exportGroup := router.Group(constants.ExportBasePath)
+	{
+		exportGroup.GET(constants.GetExportJobPath, handler.GetExportJob)
+	}
+}
@@ -0,0 +1,66 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoLocationImportJobDatasource handles raw MongoDB operations for import jobs.
+type MongoLocationImportJobDatasource struct {
+	collection *mongo.Collection
+}
+
+// NewMongoLocationImportJobDatasource creates a new datasource. Indexes are created by migrations.
+func NewMongoLocationImportJobDatasource(db *mongo.Database) *MongoLocationImportJobDatasource {
+	coll := db.Collection((&model.LocationImportJobModel{}).CollectionName())
+	return &MongoLocationImportJobDatasource{collection: coll}
+}
+
+// Insert inserts a new job.
+func (ds *MongoLocationImportJobDatasource) Insert(ctx context.Context, m *model.LocationImportJobModel) error {
+	res, err := ds.collection.InsertOne(ctx, m)
+	if err != nil {
+		return err
+	}
+	m.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// Replace overwrites the job document.
+func (ds *MongoLocationImportJobDatasource) Replace(ctx context.Context, m *model.LocationImportJobModel) error {
+	_, err := ds.collection.ReplaceOne(ctx, bson.M{"_id": m.ID}, m)
+	return err
+}
+
+// FindByID finds a job by its ObjectID.
+// Returns (nil, nil) if not found.
+func (ds *MongoLocationImportJobDatasource) FindByID(ctx context.Context, id primitive.ObjectID) (*model.LocationImportJobModel, error) {
+	var m model.LocationImportJobModel
+	err := ds.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&m)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+// FailUnfinished marks the pending and running jobs created before createdBefore as failed.
+func (ds *MongoLocationImportJobDatasource) FailUnfinished(ctx context.Context, createdBefore time.Time, message string) (int64, error) {
+	filter := bson.M{
+		"status":    bson.M{"$in": bson.A{"pending", "running"}},
+		"createdAt": bson.M{"$lt": createdBefore},
+	}
+	update := bson.M{"$set": bson.M{"status": "failed", "message": message, "completedAt": time.Now()}}
+	res, err := ds.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
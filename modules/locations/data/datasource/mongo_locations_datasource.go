@@ -2,11 +2,14 @@ package datasource
 
 import (
 	"context"
-	"log"
+	"regexp"
+	"strings"
 	"time"
 
-	indexes "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/indexes"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -18,12 +21,9 @@ type MongoLocationDatasource struct {
 	collection *mongo.Collection
 }
 
-// NewMongoLocationDatasource creates a new datasource, sets up indexes.
+// NewMongoLocationDatasource creates a new datasource. Indexes and backfills are run by migrations.
 func NewMongoLocationDatasource(db *mongo.Database) *MongoLocationDatasource {
 	coll := db.Collection((&model.LocationModel{}).CollectionName())
-	if err := indexes.SetupLocationIndexes(coll); err != nil {
-		log.Printf("Failed to setup location indexes: %v", err)
-	}
 	return &MongoLocationDatasource{collection: coll}
 }
 
@@ -40,33 +40,18 @@ func (ds *MongoLocationDatasource) Insert(ctx context.Context, lm *model.Locatio
 	return nil
 }
 
-// FindByFilters retrieves location documents matching filters (excluding soft-deleted),
-// paginated and sorted by createdAt desc.
-func (ds *MongoLocationDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, page, limit int) ([]model.LocationModel, int64, error) {
+// FindByFilters retrieves the page of location documents matching filters (excluding
+// soft-deleted) selected by opts.
+func (ds *MongoLocationDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, opts models.ListOptions) ([]model.LocationModel, *models.PageInfo, error) {
 	// always exclude soft-deleted
 	filters["deletedAt"] = nil
 
-	total, err := ds.collection.CountDocuments(ctx, filters)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	opts := options.Find().
-		SetSkip(int64((page - 1) * limit)).
-		SetLimit(int64(limit)).
-		SetSort(bson.D{{Key: "createdAt", Value: -1}})
-
-	cur, err := ds.collection.Find(ctx, filters, opts)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer cur.Close(ctx)
-
 	var out []model.LocationModel
-	if err := cur.All(ctx, &out); err != nil {
-		return nil, 0, err
+	info, err := database.FindPage(ctx, ds.collection, filters, opts, &out)
+	if err != nil {
+		return nil, nil, err
 	}
-	return out, total, nil
+	return out, info, nil
 }
 
 // FindByID finds a location by its ObjectID.
@@ -86,20 +71,70 @@ func (ds *MongoLocationDatasource) FindByID(ctx context.Context, id primitive.Ob
 // Update replaces an existing location document (sets UpdatedAt).
 func (ds *MongoLocationDatasource) Update(ctx context.Context, lm *model.LocationModel) error {
 	lm.UpdatedAt = time.Now()
-	_, err := ds.collection.UpdateOne(
+	raw, err := bson.Marshal(lm)
+	if err != nil {
+		return err
+	}
+	var set bson.M
+	if err := bson.Unmarshal(raw, &set); err != nil {
+		return err
+	}
+	// Popularity is only ever incremented; writing back the value read earlier would drop
+	// the views recorded since
+	delete(set, "popularity")
+	update := bson.M{"$set": set}
+	// omitempty fields are skipped by $set, so clearing them has to be explicit
+	unset := bson.M{}
+	if lm.ParentID == nil {
+		unset["parentId"] = ""
+	}
+	if lm.Coordinates == nil {
+		unset["coordinates"] = ""
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	// Only overwrite the version the caller read, so concurrent edits are not lost
+	expected := lm.Version
+	lm.Version++
+	res, err := ds.collection.UpdateOne(
 		ctx,
-		bson.M{"_id": lm.ID},
-		bson.M{"$set": lm},
+		database.VersionedFilter(lm.ID, expected),
+		update,
 	)
+	if err == nil && res.MatchedCount == 0 {
+		err = models.ErrVersionConflict
+	}
+	if err != nil {
+		lm.Version = expected
+	}
 	return err
 }
 
+// Patch writes only the fields of updated that differ from original, while the stored
+// document is still at the version of original
+func (ds *MongoLocationDatasource) Patch(ctx context.Context, original, updated *model.LocationModel) error {
+	set, unset, err := database.ChangedFields(original, updated)
+	if err != nil || len(set)+len(unset) == 0 {
+		return err
+	}
+	updated.UpdatedAt = time.Now()
+	set["updatedAt"] = updated.UpdatedAt
+
+	filter := database.VersionedFilter(original.ID, original.Version)
+	if err := database.PatchDocument(ctx, ds.collection, filter, set, unset); err != nil {
+		return err
+	}
+	updated.Version = original.Version + 1
+	return nil
+}
+
 // SoftDelete marks a document as deleted by setting deletedAt and updatedAt.
 func (ds *MongoLocationDatasource) SoftDelete(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	res, err := ds.collection.UpdateOne(
 		ctx,
 		bson.M{"_id": id, "deletedAt": nil},
-		bson.M{"$set": bson.M{"deletedAt": time.Now(), "updatedAt": time.Now()}},
+		database.SoftDeleteUpdate(ctx),
 	)
 	if err != nil {
 		return false, err
@@ -112,7 +147,7 @@ func (ds *MongoLocationDatasource) Restore(ctx context.Context, id primitive.Obj
 	res, err := ds.collection.UpdateOne(
 		ctx,
 		bson.M{"_id": id, "deletedAt": bson.M{"$ne": nil}},
-		bson.M{"$set": bson.M{"deletedAt": nil, "updatedAt": time.Now()}},
+		database.RestoreUpdate(),
 	)
 	if err != nil {
 		return false, err
@@ -132,7 +167,7 @@ func (ds *MongoLocationDatasource) HardDelete(ctx context.Context, id primitive.
 // BulkSoftDelete marks multiple documents as deleted, then returns the IDs that were actually updated.
 func (ds *MongoLocationDatasource) BulkSoftDelete(ctx context.Context, ids []primitive.ObjectID) ([]primitive.ObjectID, error) {
 	filter := bson.M{"_id": bson.M{"$in": ids}, "deletedAt": nil}
-	update := bson.M{"$set": bson.M{"deletedAt": time.Now(), "updatedAt": time.Now()}}
+	update := database.SoftDeleteUpdate(ctx)
 
 	if _, err := ds.collection.UpdateMany(ctx, filter, update); err != nil {
 		return nil, err
@@ -165,12 +200,7 @@ func (ds *MongoLocationDatasource) BulkRestore(ctx context.Context, ids []primit
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": bson.M{"$ne": nil}, // Only restore soft-deleted items
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -199,4 +229,335 @@ func (ds *MongoLocationDatasource) BulkRestore(ctx context.Context, ids []primit
 	}
 
 	return updatedIDs, nil
-}
\ No newline at end of file
+}
+
+// FindByIDs retrieves every document whose _id is in ids.
+func (ds *MongoLocationDatasource) FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]model.LocationModel, error) {
+	cur, err := ds.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var out []model.LocationModel
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DistinctChildTypes returns the distinct types of non-deleted direct children of parentID.
+func (ds *MongoLocationDatasource) DistinctChildTypes(ctx context.Context, parentID primitive.ObjectID) ([]string, error) {
+	values, err := ds.collection.Distinct(ctx, "type", bson.M{"parentId": parentID, "deletedAt": nil})
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]string, 0, len(values))
+	for _, v := range values {
+		if t, ok := v.(string); ok {
+			types = append(types, t)
+		}
+	}
+	return types, nil
+}
+
+// RewriteDescendantPaths swaps the ancestor prefix above id for newPath on every descendant of id.
+// The rewrite runs server-side as a pipeline update so the whole subtree moves in one round trip.
+func (ds *MongoLocationDatasource) RewriteDescendantPaths(ctx context.Context, id primitive.ObjectID, newPath []primitive.ObjectID) (int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"ancestors": bson.M{"$concatArrays": bson.A{
+				newPath,
+				bson.M{"$slice": bson.A{
+					"$ancestors",
+					bson.M{"$add": bson.A{bson.M{"$indexOfArray": bson.A{"$ancestors", id}}, 1}},
+					bson.M{"$size": "$ancestors"},
+				}},
+			}},
+			"updatedAt": time.Now(),
+			"version":   bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", 0}}, 1}},
+		}}},
+		{{Key: "$set", Value: bson.M{"depth": bson.M{"$size": "$ancestors"}}}},
+	}
+
+	res, err := ds.collection.UpdateMany(ctx, bson.M{"ancestors": id}, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// HardDeleteDescendants permanently removes every document below id in the hierarchy.
+func (ds *MongoLocationDatasource) HardDeleteDescendants(ctx context.Context, id primitive.ObjectID) (int64, error) {
+	res, err := ds.collection.DeleteMany(ctx, bson.M{"ancestors": id})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// FindNear retrieves documents matching filters sorted by distance from point (closest first).
+// maxDistanceKm <= 0 means unbounded. Each returned model carries its distance in km.
+// filters decides whether deleted documents are included and is not modified.
+func (ds *MongoLocationDatasource) FindNear(ctx context.Context, filters map[string]interface{}, lng, lat, maxDistanceKm float64, page, limit int) ([]model.LocationModel, int64, error) {
+	point := bson.M{"type": "Point", "coordinates": bson.A{lng, lat}}
+
+	// $geoNear cannot be counted, so count with the equivalent $geoWithin filter
+	query := bson.M{}
+	countFilter := bson.M{}
+	for k, v := range filters {
+		query[k] = v
+		countFilter[k] = v
+	}
+	geoNear := bson.M{
+		"near":               point,
+		"key":                "coordinates",
+		"distanceField":      "distance",
+		"distanceMultiplier": 0.001,
+		"spherical":          true,
+		"query":              query,
+	}
+	if maxDistanceKm > 0 {
+		geoNear["maxDistance"] = maxDistanceKm * 1000
+		countFilter["$and"] = append(andClauses(countFilter), bson.M{"coordinates": bson.M{
+			"$geoWithin": bson.M{"$centerSphere": bson.A{bson.A{lng, lat}, maxDistanceKm / entity.EarthRadiusKm}},
+		}})
+	}
+
+	total, err := ds.collection.CountDocuments(ctx, countFilter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: geoNear}},
+		{{Key: "$skip", Value: int64((page - 1) * limit)}},
+		{{Key: "$limit", Value: int64(limit)}},
+	}
+
+	cur, err := ds.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cur.Close(ctx)
+
+	var out []model.LocationModel
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
+
+// andClauses returns the existing $and clauses of a filter, if any
+func andClauses(filter bson.M) []interface{} {
+	if existing, ok := filter["$and"].([]interface{}); ok {
+		return existing
+	}
+	return []interface{}{}
+}
+
+// TextSearch runs a $text query and returns documents ordered by text score.
+func (ds *MongoLocationDatasource) TextSearch(ctx context.Context, search string, filters map[string]interface{}, limit int) ([]model.LocationModel, error) {
+	filters["deletedAt"] = nil
+	filters["$text"] = bson.M{"$search": search}
+
+	opts := options.Find().
+		SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "popularity", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cur, err := ds.collection.Find(ctx, filters, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var out []model.LocationModel
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrigramSearch returns documents whose search trigrams overlap trigrams with a Jaccard
+// similarity of at least minSimilarity, best matches first.
+func (ds *MongoLocationDatasource) TrigramSearch(ctx context.Context, trigrams []string, filters map[string]interface{}, minSimilarity float64, limit int) ([]model.LocationModel, error) {
+	filters["deletedAt"] = nil
+	filters["searchTrigrams"] = bson.M{"$in": trigrams}
+
+	shared := bson.M{"$size": bson.M{"$setIntersection": bson.A{"$searchTrigrams", trigrams}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filters}},
+		{{Key: "$addFields", Value: bson.M{"score": bson.M{"$divide": bson.A{
+			shared,
+			bson.M{"$subtract": bson.A{bson.M{"$add": bson.A{len(trigrams), bson.M{"$size": "$searchTrigrams"}}}, shared}},
+		}}}}},
+		{{Key: "$match", Value: bson.M{"score": bson.M{"$gte": minSimilarity}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "score", Value: -1}, {Key: "popularity", Value: -1}}}},
+		{{Key: "$limit", Value: int64(limit)}},
+	}
+
+	cur, err := ds.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var out []model.LocationModel
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FindByPrefix returns documents whose name or an alias has words matching the normalized
+// prefix: every complete word of it, and a word starting with its last one, most popular
+// first. The anchored regex on the searchTokens array can use its index.
+func (ds *MongoLocationDatasource) FindByPrefix(ctx context.Context, prefix string, limit int) ([]model.LocationModel, error) {
+	words := strings.Fields(prefix)
+	if len(words) == 0 {
+		return []model.LocationModel{}, nil
+	}
+	last := words[len(words)-1]
+	conditions := bson.A{bson.M{"searchTokens": bson.M{"$regex": "^" + regexp.QuoteMeta(last)}}}
+	if len(words) > 1 {
+		conditions = append(conditions, bson.M{"searchTokens": bson.M{"$all": words[:len(words)-1]}})
+	}
+	filter := bson.M{"deletedAt": nil, "$and": conditions}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "popularity", Value: -1}, {Key: "name", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cur, err := ds.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var out []model.LocationModel
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IncrementPopularity bumps the popularity counter without touching updatedAt. The
+// version is bumped too, as popularity is part of the location clients cache by ETag.
+func (ds *MongoLocationDatasource) IncrementPopularity(ctx context.Context, id primitive.ObjectID) error {
+	_, err := ds.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"popularity": 1, database.VersionField: 1}})
+	return err
+}
+
+// FindByNameAndType finds the non-deleted document with this unique key.
+// Returns (nil, nil) if not found.
+func (ds *MongoLocationDatasource) FindByNameAndType(ctx context.Context, name, locationType string) (*model.LocationModel, error) {
+	var lm model.LocationModel
+	err := ds.collection.FindOne(ctx, bson.M{"name": name, "type": locationType, "deletedAt": nil}).Decode(&lm)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &lm, nil
+}
+
+// UpsertByNameAndType writes lm onto the non-deleted document with the same (name, type),
+// inserting it when none exists. Media, popularity and createdAt survive an update.
+func (ds *MongoLocationDatasource) UpsertByNameAndType(ctx context.Context, lm *model.LocationModel) (primitive.ObjectID, bool, error) {
+	now := time.Now()
+	lm.UpdatedAt = now
+
+	set := bson.M{
+		"name":           lm.Name,
+		"type":           lm.Type,
+		"ancestors":      lm.Ancestors,
+		"depth":          lm.Depth,
+		"country":        lm.Country,
+		"state":          lm.State,
+		"district":       lm.District,
+		"pincode":        lm.Pincode,
+		"tags":           lm.Tags,
+		"description":    lm.Description,
+		"aliases":        lm.Aliases,
+		"searchName":     lm.SearchName,
+		"searchAliases":  lm.SearchAliases,
+		"searchKeys":     lm.SearchKeys,
+		"searchTrigrams": lm.SearchTrigrams,
+		"updatedAt":      now,
+	}
+	unset := bson.M{}
+	for field, value := range map[string]interface{}{"parentId": lm.ParentID, "coordinates": lm.Coordinates, "geojson": lm.GeoJSON} {
+		if isNilValue(value) {
+			unset[field] = ""
+		} else {
+			set[field] = value
+		}
+	}
+
+	update := bson.M{
+		"$set": set,
+		"$inc": database.IncrementVersion(),
+		"$setOnInsert": bson.M{
+			"mediaUrls":  lm.MediaURLs,
+			"media":      lm.Media,
+			"popularity": 0,
+			"createdBy":  lm.CreatedBy,
+			"createdAt":  now,
+		},
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	filter := bson.M{"name": lm.Name, "type": lm.Type, "deletedAt": nil}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var out model.LocationModel
+	if err := ds.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&out); err != nil {
+		return primitive.NilObjectID, false, err
+	}
+	// createdAt and updatedAt are only identical on the insert path
+	return out.ID, out.CreatedAt.Equal(out.UpdatedAt), nil
+}
+
+// Stream iterates over documents matching filters (excluding soft-deleted unless the
+// filter says otherwise), sorted by name.
+func (ds *MongoLocationDatasource) Stream(ctx context.Context, filters map[string]interface{}, fn func(*model.LocationModel) error) error {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}}).
+		SetBatchSize(500)
+
+	cur, err := ds.collection.Find(ctx, filters, opts)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var lm model.LocationModel
+		if err := cur.Decode(&lm); err != nil {
+			return err
+		}
+		if err := fn(&lm); err != nil {
+			return err
+		}
+	}
+	return cur.Err()
+}
+
+// isNilValue reports whether v is nil or a typed nil pointer
+func isNilValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	switch t := v.(type) {
+	case *primitive.ObjectID:
+		return t == nil
+	case *model.GeoPointModel:
+		return t == nil
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MigrateLocationCoordinates rewrites legacy {lat, lng} coordinates into GeoJSON Points.
+// Documents at 0,0 (the old "not provided" value) lose the field instead, so they stay
+// out of the 2dsphere index. It is idempotent and must run before the geo index is built.
+func MigrateLocationCoordinates(ctx context.Context, coll *mongo.Collection) (int64, error) {
+	filter := bson.M{"coordinates.lat": bson.M{"$exists": true}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"coordinates": bson.M{"$cond": bson.A{
+				bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$coordinates.lat", 0}},
+					bson.M{"$eq": bson.A{"$coordinates.lng", 0}},
+				}},
+				"$$REMOVE",
+				bson.M{
+					"type":        "Point",
+					"coordinates": bson.A{"$coordinates.lng", "$coordinates.lat"},
+				},
+			}},
+		}}},
+	}
+
+	res, err := coll.UpdateMany(ctx, filter, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BackfillLocationSearchFields computes the denormalized search fields for documents
+// written before search was introduced. It is idempotent.
+func BackfillLocationSearchFields(ctx context.Context, coll *mongo.Collection) (int64, error) {
+	cur, err := coll.Find(ctx, bson.M{"searchTrigrams": bson.M{"$exists": false}})
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	var updated int64
+	for cur.Next(ctx) {
+		var lm model.LocationModel
+		if err := cur.Decode(&lm); err != nil {
+			return updated, err
+		}
+		lm.ApplySearchFields()
+
+		_, err := coll.UpdateOne(ctx, bson.M{"_id": lm.ID}, bson.M{"$set": bson.M{
+			"searchName":     lm.SearchName,
+			"searchAliases":  lm.SearchAliases,
+			"searchKeys":     lm.SearchKeys,
+			"searchTrigrams": lm.SearchTrigrams,
+		}})
+		if err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, cur.Err()
+}
+
+// BackfillLocationSearchTokens computes the search tokens for documents written before
+// autocomplete matched on them. It is idempotent.
+func BackfillLocationSearchTokens(ctx context.Context, coll *mongo.Collection) (int64, error) {
+	cur, err := coll.Find(ctx, bson.M{"searchTokens": bson.M{"$exists": false}})
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	var updated int64
+	for cur.Next(ctx) {
+		var lm model.LocationModel
+		if err := cur.Decode(&lm); err != nil {
+			return updated, err
+		}
+		lm.ApplySearchFields()
+
+		_, err := coll.UpdateOne(ctx, bson.M{"_id": lm.ID}, bson.M{"$set": bson.M{"searchTokens": lm.SearchTokens}})
+		if err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, cur.Err()
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LocationImportJobModel is the Mongo schema for bulk import jobs
+type LocationImportJobModel struct {
+	ID          primitive.ObjectID      `bson:"_id,omitempty"`
+	Status      string                  `bson:"status"`
+	Format      string                  `bson:"format"`
+	FileName    string                  `bson:"fileName"`
+	DryRun      bool                    `bson:"dryRun"`
+	TotalRows   int                     `bson:"totalRows"`
+	Processed   int                     `bson:"processed"`
+	Created     int                     `bson:"created"`
+	Updated     int                     `bson:"updated"`
+	Failed      int                     `bson:"failed"`
+	Errors      []entity.ImportRowError `bson:"errors"`
+	Message     string                  `bson:"message,omitempty"`
+	CreatedBy   string                  `bson:"createdBy,omitempty"`
+	CreatedAt   time.Time               `bson:"createdAt"`
+	StartedAt   *time.Time              `bson:"startedAt,omitempty"`
+	CompletedAt *time.Time              `bson:"completedAt,omitempty"`
+}
+
+func (m *LocationImportJobModel) CollectionName() string {
+	return "location_import_jobs"
+}
+
+// ImportJobFromEntity maps domain→model
+func ImportJobFromEntity(e *entity.LocationImportJob) *LocationImportJobModel {
+	return &LocationImportJobModel{
+		ID:          e.ID,
+		Status:      e.Status,
+		Format:      e.Format,
+		FileName:    e.FileName,
+		DryRun:      e.DryRun,
+		TotalRows:   e.TotalRows,
+		Processed:   e.Processed,
+		Created:     e.Created,
+		Updated:     e.Updated,
+		Failed:      e.Failed,
+		Errors:      e.Errors,
+		Message:     e.Message,
+		CreatedBy:   e.CreatedBy,
+		CreatedAt:   e.CreatedAt,
+		StartedAt:   e.StartedAt,
+		CompletedAt: e.CompletedAt,
+	}
+}
+
+// ToEntity maps model→domain
+func (m *LocationImportJobModel) ToEntity() entity.LocationImportJob {
+	errors := m.Errors
+	if errors == nil {
+		errors = []entity.ImportRowError{}
+	}
+	return entity.LocationImportJob{
+		ID:          m.ID,
+		Status:      m.Status,
+		Format:      m.Format,
+		FileName:    m.FileName,
+		DryRun:      m.DryRun,
+		TotalRows:   m.TotalRows,
+		Processed:   m.Processed,
+		Created:     m.Created,
+		Updated:     m.Updated,
+		Failed:      m.Failed,
+		Errors:      errors,
+		Message:     m.Message,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+	}
+}
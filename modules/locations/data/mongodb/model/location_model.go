@@ -1,8 +1,12 @@
 package model
 
 import (
+	"sort"
+	"strings"
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/utils"
+
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -12,26 +16,38 @@ type LocationModel struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	Name        string             `bson:"name" json:"name"`
 	Type        string             `bson:"type" json:"type"`
+	ParentID    *primitive.ObjectID `bson:"parentId,omitempty" json:"parentId,omitempty"`
+	Ancestors   []primitive.ObjectID `bson:"ancestors" json:"ancestors"`
+	Depth       int                `bson:"depth" json:"depth"`
 	Country     string             `bson:"country" json:"country"`
 	State       string             `bson:"state" json:"state"`
 	District    string             `bson:"district" json:"district"`
 	Pincode     string             `bson:"pincode" json:"pincode"`
-	Coordinates struct {
-		Lat float64 `bson:"lat" json:"lat"`
-		Lng float64 `bson:"lng" json:"lng"`
-	} `bson:"coordinates" json:"coordinates"`
+	Coordinates *GeoPointModel     `bson:"coordinates,omitempty" json:"coordinates,omitempty"`
 	GeoJSON     interface{}        `bson:"geojson,omitempty" json:"geojson,omitempty"`
 	Tags        []string           `bson:"tags" json:"tags"`
 	Description string             `bson:"description" json:"description"`
 	Aliases     []string           `bson:"aliases" json:"aliases"`
-	MediaURLs   struct {
-		Photos []string `bson:"photos" json:"photos"`
-		Videos []string `bson:"videos" json:"videos"`
-	} `bson:"mediaUrls" json:"mediaUrls"`
+	MediaURLs   MediaURLsModel     `bson:"mediaUrls" json:"mediaUrls"`
+	Media       []MediaItemModel   `bson:"media" json:"media"`
+	Popularity  int64              `bson:"popularity" json:"popularity"`
 	CreatedBy   primitive.ObjectID `bson:"createdBy" json:"createdBy"`
 	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
 	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+	Version     int64              `bson:"version" json:"version"`
 	DeletedAt   *time.Time         `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
+
+	// Denormalized search fields, derived from name and aliases on every write
+	SearchName     string   `bson:"searchName" json:"-"`
+	SearchAliases  []string `bson:"searchAliases" json:"-"`
+	SearchTokens   []string `bson:"searchTokens" json:"-"` // distinct words of searchName and searchAliases
+	SearchKeys     []string `bson:"searchKeys" json:"-"`
+	SearchTrigrams []string `bson:"searchTrigrams" json:"-"`
+
+	// Distance is only present on documents returned by $geoNear (in km)
+	Distance *float64 `bson:"distance,omitempty" json:"-"`
+	// Score is only present on documents returned by search queries
+	Score *float64 `bson:"score,omitempty" json:"-"`
 }
 
 func (m *LocationModel) CollectionName() string {
@@ -40,58 +56,146 @@ func (m *LocationModel) CollectionName() string {
 
 // FromEntity maps domain→model
 func FromEntity(e *entity.Location) *LocationModel {
-	return &LocationModel{
+	m := &LocationModel{
 		ID:        e.ID,
 		Name:      e.Name,
 		Type:      e.Type,
+		ParentID:  e.ParentID,
+		Ancestors: e.Ancestors,
+		Depth:     e.Depth,
 		Country:   e.Country,
 		State:     e.State,
 		District:  e.District,
 		Pincode:   e.Pincode,
-		Coordinates: struct {
-			Lat float64 `bson:"lat" json:"lat"`
-			Lng float64 `bson:"lng" json:"lng"`
-		}{
-			Lat: e.Coordinates.Lat,
-			Lng: e.Coordinates.Lng,
-		},
+		Coordinates: NewGeoPointModel(e.Coordinates),
 		GeoJSON:    e.GeoJSON,
 		Tags:       e.Tags,
 		Description: e.Description,
 		Aliases:    e.Aliases,
-		MediaURLs: struct {
-			Photos []string `bson:"photos" json:"photos"`
-			Videos []string `bson:"videos" json:"videos"`
-		}{
-			Photos: e.MediaURLs.Photos,
-			Videos: e.MediaURLs.Videos,
-		},
+		MediaURLs:  MediaURLsModel{Photos: e.MediaURLs.Photos, Videos: e.MediaURLs.Videos},
+		Media:      NewMediaItemModels(e.Media),
+		Popularity: e.Popularity,
 		CreatedBy: e.CreatedBy,
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
+		Version:   e.Version,
 		DeletedAt: e.DeletedAt,
 	}
+	m.ApplySearchFields()
+	return m
+}
+
+// ApplySearchFields recomputes the denormalized search fields from name and aliases
+func (m *LocationModel) ApplySearchFields() {
+	m.SearchName = utils.NormalizeSearchText(m.Name)
+
+	m.SearchAliases = make([]string, 0, len(m.Aliases))
+	tokens := map[string]struct{}{}
+	for _, word := range strings.Fields(m.SearchName) {
+		tokens[word] = struct{}{}
+	}
+	keys := map[string]struct{}{utils.PhoneticKey(m.Name): {}}
+	trigrams := map[string]struct{}{}
+	for _, gram := range utils.Trigrams(m.Name) {
+		trigrams[gram] = struct{}{}
+	}
+	for _, alias := range m.Aliases {
+		if normalized := utils.NormalizeSearchText(alias); normalized != "" {
+			m.SearchAliases = append(m.SearchAliases, normalized)
+			for _, word := range strings.Fields(normalized) {
+				tokens[word] = struct{}{}
+			}
+		}
+		keys[utils.PhoneticKey(alias)] = struct{}{}
+		for _, gram := range utils.Trigrams(alias) {
+			trigrams[gram] = struct{}{}
+		}
+	}
+
+	m.SearchTokens = make([]string, 0, len(tokens))
+	for token := range tokens {
+		m.SearchTokens = append(m.SearchTokens, token)
+	}
+	sort.Strings(m.SearchTokens)
+
+	m.SearchKeys = make([]string, 0, len(keys))
+	for key := range keys {
+		if key != "" {
+			m.SearchKeys = append(m.SearchKeys, key)
+		}
+	}
+	sort.Strings(m.SearchKeys)
+
+	m.SearchTrigrams = make([]string, 0, len(trigrams))
+	for gram := range trigrams {
+		m.SearchTrigrams = append(m.SearchTrigrams, gram)
+	}
+	sort.Strings(m.SearchTrigrams)
 }
 
 // ToEntity maps model→domain
 func (m *LocationModel) ToEntity() entity.Location {
-	return entity.Location{
+	loc := entity.Location{
 		ID:          m.ID,
 		Name:        m.Name,
 		Type:        m.Type,
+		ParentID:    m.ParentID,
+		Ancestors:   m.Ancestors,
+		Depth:       m.Depth,
 		Country:     m.Country,
 		State:       m.State,
 		District:    m.District,
 		Pincode:     m.Pincode,
-		Coordinates: entity.Coordinates{Lat: m.Coordinates.Lat, Lng: m.Coordinates.Lng},
+		Coordinates: m.Coordinates.ToCoordinates(),
 		GeoJSON:     m.GeoJSON,
 		Tags:        m.Tags,
 		Description: m.Description,
 		Aliases:     m.Aliases,
 		MediaURLs:   entity.MediaURLs{Photos: m.MediaURLs.Photos, Videos: m.MediaURLs.Videos},
+		Media:       m.mediaItems(),
+		Popularity:  m.Popularity,
 		CreatedBy:   m.CreatedBy,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		Version:     m.Version,
 		DeletedAt:   m.DeletedAt,
+		DistanceKm:  m.Distance,
+		SearchScore: m.Score,
+	}
+	if len(loc.Media) > 0 {
+		loc.NormalizeMedia()
+	}
+	return loc
+}
+
+// GeoPointModel is a GeoJSON Point stored under the 2dsphere-indexed coordinates field
+type GeoPointModel struct {
+	Type        string    `bson:"type" json:"type"`
+	Coordinates []float64 `bson:"coordinates" json:"coordinates"` // [lng, lat]
+
+	// Legacy {lat, lng} shape, only read from documents that predate the GeoJSON migration
+	LegacyLat float64 `bson:"lat,omitempty" json:"-"`
+	LegacyLng float64 `bson:"lng,omitempty" json:"-"`
+}
+
+// NewGeoPointModel builds a GeoJSON Point, or nil when the coordinates are unset
+func NewGeoPointModel(c entity.Coordinates) *GeoPointModel {
+	if !c.IsSet() {
+		return nil
+	}
+	return &GeoPointModel{
+		Type:        "Point",
+		Coordinates: []float64{c.Lng, c.Lat},
+	}
+}
+
+// ToCoordinates maps the point back to lat/lng
+func (p *GeoPointModel) ToCoordinates() entity.Coordinates {
+	if p == nil {
+		return entity.Coordinates{}
+	}
+	if len(p.Coordinates) == 2 {
+		return entity.Coordinates{Lat: p.Coordinates[1], Lng: p.Coordinates[0]}
 	}
+	return entity.Coordinates{Lat: p.LegacyLat, Lng: p.LegacyLng}
 }
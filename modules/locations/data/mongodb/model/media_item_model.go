@@ -0,0 +1,116 @@
+package model
+
+import (
+	"crypto/md5"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+)
+
+// MediaURLsModel is the flat list of media URLs, derived from Media on every write
+type MediaURLsModel struct {
+	Photos []string `bson:"photos" json:"photos"`
+	Videos []string `bson:"videos" json:"videos"`
+
+	// Photo variants written before media items existed, only read from older documents
+	LegacyPhotoVariants []models.ProcessedImage `bson:"photoVariants,omitempty" json:"-"`
+}
+
+// MediaItemModel is a photo or video embedded in a location document
+type MediaItemModel struct {
+	ID        primitive.ObjectID   `bson:"_id" json:"id"`
+	Type      string               `bson:"type" json:"type"`
+	URL       string               `bson:"url" json:"url"`
+	Variants  models.ImageVariants `bson:"variants,omitempty" json:"variants,omitempty"`
+	Caption   string               `bson:"caption,omitempty" json:"caption,omitempty"`
+	AltText   string               `bson:"altText,omitempty" json:"altText,omitempty"`
+	Credit    string               `bson:"credit,omitempty" json:"credit,omitempty"`
+	License   string               `bson:"license,omitempty" json:"license,omitempty"`
+	SortOrder int                  `bson:"sortOrder" json:"sortOrder"`
+	IsCover   bool                 `bson:"isCover" json:"isCover"`
+	CreatedAt time.Time            `bson:"createdAt" json:"createdAt"`
+}
+
+// NewMediaItemModels maps domain media items to their embedded models
+func NewMediaItemModels(items []entity.MediaItem) []MediaItemModel {
+	out := make([]MediaItemModel, 0, len(items))
+	for _, item := range items {
+		out = append(out, MediaItemModel{
+			ID:        item.ID,
+			Type:      item.Type,
+			URL:       item.URL,
+			Variants:  item.Variants,
+			Caption:   item.Caption,
+			AltText:   item.AltText,
+			Credit:    item.Credit,
+			License:   item.License,
+			SortOrder: item.SortOrder,
+			IsCover:   item.IsCover,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+	return out
+}
+
+// ToEntity maps the model back to a domain media item
+func (m MediaItemModel) ToEntity() entity.MediaItem {
+	return entity.MediaItem{
+		ID:        m.ID,
+		Type:      m.Type,
+		URL:       m.URL,
+		Variants:  m.Variants,
+		Caption:   m.Caption,
+		AltText:   m.AltText,
+		Credit:    m.Credit,
+		License:   m.License,
+		SortOrder: m.SortOrder,
+		IsCover:   m.IsCover,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// mediaItems returns the media items of the document. Documents written before media
+// items existed only have mediaUrls; their items are built from the URLs with IDs derived
+// from the location and URL, so they stay stable until the location is next saved.
+func (m *LocationModel) mediaItems() []entity.MediaItem {
+	if m.Media != nil {
+		items := make([]entity.MediaItem, 0, len(m.Media))
+		for _, item := range m.Media {
+			items = append(items, item.ToEntity())
+		}
+		return items
+	}
+
+	variants := make(map[string]models.ImageVariants, len(m.MediaURLs.LegacyPhotoVariants))
+	for _, image := range m.MediaURLs.LegacyPhotoVariants {
+		variants[image.URL] = image.Variants
+	}
+
+	var items []entity.MediaItem
+	add := func(mediaType string, urls []string) {
+		for _, url := range urls {
+			items = append(items, entity.MediaItem{
+				ID:        legacyMediaID(m.ID, mediaType, url),
+				Type:      mediaType,
+				URL:       url,
+				Variants:  variants[url],
+				SortOrder: len(items),
+				CreatedAt: m.CreatedAt,
+			})
+		}
+	}
+	add(entity.MediaTypePhoto, m.MediaURLs.Photos)
+	add(entity.MediaTypeVideo, m.MediaURLs.Videos)
+	return items
+}
+
+// legacyMediaID derives a stable media item ID from the location ID and the media URL
+func legacyMediaID(locationID primitive.ObjectID, mediaType, url string) primitive.ObjectID {
+	sum := md5.Sum([]byte(locationID.Hex() + "|" + mediaType + "|" + url))
+	var id primitive.ObjectID
+	copy(id[:], sum[:len(id)])
+	return id
+}
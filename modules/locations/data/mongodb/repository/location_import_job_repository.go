@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Ensure interface compliance
+var _ repository.LocationImportJobRepository = (*LocationImportJobRepositoryMongo)(nil)
+
+// LocationImportJobRepositoryMongo implements LocationImportJobRepository using MongoDB.
+type LocationImportJobRepositoryMongo struct {
+	datasource *datasource.MongoLocationImportJobDatasource
+}
+
+// NewLocationImportJobRepositoryMongo creates a new LocationImportJobRepositoryMongo.
+func NewLocationImportJobRepositoryMongo(ds *datasource.MongoLocationImportJobDatasource) *LocationImportJobRepositoryMongo {
+	return &LocationImportJobRepositoryMongo{datasource: ds}
+}
+
+// Create inserts a new job and back-fills its ID.
+func (r *LocationImportJobRepositoryMongo) Create(ctx context.Context, job *entity.LocationImportJob) error {
+	m := model.ImportJobFromEntity(job)
+	if err := r.datasource.Insert(ctx, m); err != nil {
+		return err
+	}
+	job.ID = m.ID
+	return nil
+}
+
+// Update replaces the job document.
+func (r *LocationImportJobRepositoryMongo) Update(ctx context.Context, job *entity.LocationImportJob) error {
+	return r.datasource.Replace(ctx, model.ImportJobFromEntity(job))
+}
+
+// FindByID returns the job, or nil if it does not exist.
+func (r *LocationImportJobRepositoryMongo) FindByID(ctx context.Context, id primitive.ObjectID) (*entity.LocationImportJob, error) {
+	m, err := r.datasource.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+	e := m.ToEntity()
+	return &e, nil
+}
+
+// FailUnfinished marks the pending and running jobs created before createdBefore as failed.
+func (r *LocationImportJobRepositoryMongo) FailUnfinished(ctx context.Context, createdBefore time.Time, message string) (int64, error) {
+	return r.datasource.FailUnfinished(ctx, createdBefore, message)
+}
@@ -28,17 +28,27 @@ func NewLocationRepositoryMongo(ds *datasource.MongoLocationDatasource) *Locatio
 // FindAll retrieves locations matching the filter with pagination.
 // Soft-deleted records are excluded unless filter overrides them.
 func (r *LocationRepositoryMongo) FindAll(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Location, int64, error) {
-	models, total, err := r.datasource.FindByFilters(ctx, filter, page, limit)
+	locations, info, err := r.FindPage(ctx, filter, models.ListOptions{Page: page, Limit: limit, Count: models.CountExact})
 	if err != nil {
 		return nil, 0, err
 	}
+	return locations, *info.Total, nil
+}
 
-	locations := make([]*entity.Location, len(models))
-	for i, m := range models {
+// FindPage retrieves the page of locations matching the filter selected by opts.
+// Soft-deleted records are excluded.
+func (r *LocationRepositoryMongo) FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Location, *models.PageInfo, error) {
+	found, info, err := r.datasource.FindByFilters(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	locations := make([]*entity.Location, len(found))
+	for i, m := range found {
 		e := m.ToEntity()
 		locations[i] = &e
 	}
-	return locations, total, nil
+	return locations, info, nil
 }
 
 // FindByID fetches a single location by its ID.
@@ -70,7 +80,22 @@ func (r *LocationRepositoryMongo) Create(ctx context.Context, loc *entity.Locati
 // Update replaces an existing location document.
 func (r *LocationRepositoryMongo) Update(ctx context.Context, loc *entity.Location) error {
 	m := model.FromEntity(loc)
-	return r.datasource.Update(ctx, m)
+	if err := r.datasource.Update(ctx, m); err != nil {
+		return err
+	}
+	loc.Version = m.Version
+	return nil
+}
+
+// Patch writes only the fields of updated that differ from original.
+func (r *LocationRepositoryMongo) Patch(ctx context.Context, original, updated *entity.Location) error {
+	updatedModel := model.FromEntity(updated)
+	if err := r.datasource.Patch(ctx, model.FromEntity(original), updatedModel); err != nil {
+		return err
+	}
+	updated.UpdatedAt = updatedModel.UpdatedAt
+	updated.Version = updatedModel.Version
+	return nil
 }
 
 // SoftDelete marks a location as deleted by setting deletedAt.
@@ -191,3 +216,127 @@ func (r *LocationRepositoryMongo) BulkRestore(ctx context.Context, ids []string)
 
 	return result, nil
 }
+
+// FindByIDs fetches the given locations, in no particular order.
+func (r *LocationRepositoryMongo) FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*entity.Location, error) {
+	if len(ids) == 0 {
+		return []*entity.Location{}, nil
+	}
+
+	models, err := r.datasource.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]*entity.Location, len(models))
+	for i, m := range models {
+		e := m.ToEntity()
+		locations[i] = &e
+	}
+	return locations, nil
+}
+
+// FindChildTypes returns the distinct types of the non-deleted direct children of a location.
+func (r *LocationRepositoryMongo) FindChildTypes(ctx context.Context, parentID primitive.ObjectID) ([]string, error) {
+	return r.datasource.DistinctChildTypes(ctx, parentID)
+}
+
+// RewriteDescendantPaths replaces the ancestor path above id for every descendant of id.
+func (r *LocationRepositoryMongo) RewriteDescendantPaths(ctx context.Context, id primitive.ObjectID, newPath []primitive.ObjectID) (int64, error) {
+	return r.datasource.RewriteDescendantPaths(ctx, id, newPath)
+}
+
+// HardDeleteDescendants permanently removes every descendant of id.
+func (r *LocationRepositoryMongo) HardDeleteDescendants(ctx context.Context, id primitive.ObjectID) (int64, error) {
+	return r.datasource.HardDeleteDescendants(ctx, id)
+}
+
+// FindNear retrieves locations matching the filter ordered by distance from near.
+func (r *LocationRepositoryMongo) FindNear(ctx context.Context, filter map[string]interface{}, near entity.Coordinates, maxDistanceKm float64, page, limit int) ([]*entity.Location, int64, error) {
+	models, total, err := r.datasource.FindNear(ctx, filter, near.Lng, near.Lat, maxDistanceKm, page, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	locations := make([]*entity.Location, len(models))
+	for i, m := range models {
+		e := m.ToEntity()
+		locations[i] = &e
+	}
+	return locations, total, nil
+}
+
+// SearchText runs a weighted full-text search, most relevant first.
+func (r *LocationRepositoryMongo) SearchText(ctx context.Context, search string, filter map[string]interface{}, limit int) ([]*entity.Location, error) {
+	models, err := r.datasource.TextSearch(ctx, search, filter, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(models), nil
+}
+
+// SearchTrigrams finds locations by trigram similarity, best matches first.
+func (r *LocationRepositoryMongo) SearchTrigrams(ctx context.Context, trigrams []string, filter map[string]interface{}, minSimilarity float64, limit int) ([]*entity.Location, error) {
+	if len(trigrams) == 0 {
+		return []*entity.Location{}, nil
+	}
+	models, err := r.datasource.TrigramSearch(ctx, trigrams, filter, minSimilarity, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(models), nil
+}
+
+// FindByPrefix returns locations whose normalized name or alias has a word starting with prefix.
+func (r *LocationRepositoryMongo) FindByPrefix(ctx context.Context, prefix string, limit int) ([]*entity.Location, error) {
+	models, err := r.datasource.FindByPrefix(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(models), nil
+}
+
+// IncrementPopularity records a view of the location.
+func (r *LocationRepositoryMongo) IncrementPopularity(ctx context.Context, id primitive.ObjectID) error {
+	return r.datasource.IncrementPopularity(ctx, id)
+}
+
+func toEntities(models []model.LocationModel) []*entity.Location {
+	locations := make([]*entity.Location, len(models))
+	for i, m := range models {
+		e := m.ToEntity()
+		locations[i] = &e
+	}
+	return locations
+}
+
+// FindByNameAndType returns the non-deleted location with this unique key, or nil.
+func (r *LocationRepositoryMongo) FindByNameAndType(ctx context.Context, name, locationType string) (*entity.Location, error) {
+	m, err := r.datasource.FindByNameAndType(ctx, name, locationType)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+	e := m.ToEntity()
+	return &e, nil
+}
+
+// UpsertByNameAndType inserts or overwrites the location with the same (name, type).
+func (r *LocationRepositoryMongo) UpsertByNameAndType(ctx context.Context, loc *entity.Location) (bool, error) {
+	id, created, err := r.datasource.UpsertByNameAndType(ctx, model.FromEntity(loc))
+	if err != nil {
+		return false, err
+	}
+	loc.ID = id
+	return created, nil
+}
+
+// Stream calls fn for every location matching the filter, sorted by name.
+func (r *LocationRepositoryMongo) Stream(ctx context.Context, filter map[string]interface{}, fn func(*entity.Location) error) error {
+	return r.datasource.Stream(ctx, filter, func(m *model.LocationModel) error {
+		e := m.ToEntity()
+		return fn(&e)
+	})
+}
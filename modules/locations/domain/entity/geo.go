@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EarthRadiusKm is the equatorial radius MongoDB uses, to turn kilometres into radians
+// for $centerSphere
+const EarthRadiusKm = 6378.1
+
+// Geo validation errors
+var (
+	ErrInvalidGeoJSON     = errors.New("geojson must be a valid Polygon or MultiPolygon")
+	ErrInvalidCoordinates = errors.New("coordinates must have lat between -90 and 90 and lng between -180 and 180")
+)
+
+// IsSet reports whether the coordinates carry a real position.
+// 0,0 is treated as "not provided", matching the create DTO.
+func (c Coordinates) IsSet() bool {
+	return !(c.Lat == 0 && c.Lng == 0)
+}
+
+// IsValid reports whether the coordinates are within WGS84 bounds
+func (c Coordinates) IsValid() bool {
+	return c.Lat >= -90 && c.Lat <= 90 && c.Lng >= -180 && c.Lng <= 180
+}
+
+// ValidateGeoJSONBoundary checks that v is a GeoJSON Polygon or MultiPolygon object
+// with closed linear rings of at least four valid [lng, lat] positions.
+func ValidateGeoJSONBoundary(v interface{}) error {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return ErrInvalidGeoJSON
+	}
+
+	switch obj["type"] {
+	case "Polygon":
+		return validatePolygon(obj["coordinates"])
+	case "MultiPolygon":
+		polygons, ok := obj["coordinates"].([]interface{})
+		if !ok || len(polygons) == 0 {
+			return ErrInvalidGeoJSON
+		}
+		for _, polygon := range polygons {
+			if err := validatePolygon(polygon); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return ErrInvalidGeoJSON
+	}
+}
+
+func validatePolygon(v interface{}) error {
+	rings, ok := v.([]interface{})
+	if !ok || len(rings) == 0 {
+		return ErrInvalidGeoJSON
+	}
+
+	for i, ring := range rings {
+		positions, ok := ring.([]interface{})
+		if !ok || len(positions) < 4 {
+			return fmt.Errorf("%w: ring %d needs at least 4 positions", ErrInvalidGeoJSON, i)
+		}
+
+		var first, last [2]float64
+		for j, position := range positions {
+			lng, lat, ok := parsePosition(position)
+			if !ok {
+				return fmt.Errorf("%w: ring %d position %d is not a [lng, lat] pair", ErrInvalidGeoJSON, i, j)
+			}
+			if !(Coordinates{Lat: lat, Lng: lng}).IsValid() {
+				return fmt.Errorf("%w: ring %d position %d is out of range", ErrInvalidGeoJSON, i, j)
+			}
+			if j == 0 {
+				first = [2]float64{lng, lat}
+			}
+			last = [2]float64{lng, lat}
+		}
+
+		if first != last {
+			return fmt.Errorf("%w: ring %d is not closed", ErrInvalidGeoJSON, i)
+		}
+	}
+	return nil
+}
+
+func parsePosition(v interface{}) (float64, float64, bool) {
+	pair, ok := v.([]interface{})
+	if !ok || len(pair) < 2 {
+		return 0, 0, false
+	}
+	lng, ok1 := pair[0].(float64)
+	lat, ok2 := pair[1].(float64)
+	return lng, lat, ok1 && ok2
+}
@@ -12,7 +12,8 @@ type Coordinates struct {
 	Lng float64 `json:"lng" bson:"lng"`
 }
 
-// MediaURLs holds arrays of media links
+// MediaURLs holds arrays of media links. It is derived from Location.Media and kept
+// for clients that only need the URLs.
 type MediaURLs struct {
 	Photos []string `json:"photos" bson:"photos"`
 	Videos []string `json:"videos" bson:"videos"`
@@ -23,6 +24,9 @@ type Location struct {
 	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
 	Name        string             `json:"name" bson:"name"`
 	Type        string             `json:"type" bson:"type"` // city | scenic_spot | ...
+	ParentID    *primitive.ObjectID `json:"parentId,omitempty" bson:"parentId,omitempty"`
+	Ancestors   []primitive.ObjectID `json:"ancestors" bson:"ancestors"` // root first, direct parent last
+	Depth       int                `json:"depth" bson:"depth"`
 	Country     string             `json:"country" bson:"country"`
 	State       string             `json:"state" bson:"state"`
 	District    string             `json:"district" bson:"district"`
@@ -33,10 +37,52 @@ type Location struct {
 	Description string             `json:"description" bson:"description"`
 	Aliases     []string           `json:"aliases" bson:"aliases"`
 	MediaURLs   MediaURLs          `json:"mediaUrls" bson:"mediaUrls"`
+	Media       []MediaItem        `json:"media" bson:"media"`
+	Popularity  int64              `json:"popularity" bson:"popularity"`
 	CreatedBy   primitive.ObjectID `json:"createdBy" bson:"createdBy"`
 	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt"`
 	UpdatedAt   time.Time          `json:"updatedAt" bson:"updatedAt"`
+	Version     int64              `json:"version" bson:"version"`
 	DeletedAt   *time.Time         `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+
+	// SearchScore is only populated by search queries (higher is more relevant)
+	SearchScore *float64 `json:"score,omitempty" bson:"-"`
+
+	// DistanceKm is only populated by distance-sorted queries
+	DistanceKm *float64 `json:"distanceKm,omitempty" bson:"-"`
+}
+
+// IsRoot returns true if the location has no parent
+func (l *Location) IsRoot() bool {
+	return l.ParentID == nil
+}
+
+// IsDescendantOf returns true if id appears in the location's ancestor path
+func (l *Location) IsDescendantOf(id primitive.ObjectID) bool {
+	for _, ancestorID := range l.Ancestors {
+		if ancestorID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// PathUnder returns the ancestor path a child of this location should carry
+func (l *Location) PathUnder() []primitive.ObjectID {
+	path := make([]primitive.ObjectID, 0, len(l.Ancestors)+1)
+	path = append(path, l.Ancestors...)
+	return append(path, l.ID)
+}
+
+// LocationSuggestion is a lightweight autocomplete result
+type LocationSuggestion struct {
+	ID         primitive.ObjectID `json:"id"`
+	Name       string             `json:"name"`
+	Type       string             `json:"type"`
+	State      string             `json:"state,omitempty"`
+	MatchedOn  string             `json:"matchedOn"` // name | alias | fuzzy
+	Popularity int64              `json:"popularity"`
+	Score      float64            `json:"score"`
 }
 
 // IsDeleted returns true if soft-deleted
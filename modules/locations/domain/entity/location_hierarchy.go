@@ -0,0 +1,52 @@
+package entity
+
+import "errors"
+
+// Location types
+const (
+	TypeCountry    = "country"
+	TypeState      = "state"
+	TypeRegion     = "region"
+	TypeDistrict   = "district"
+	TypeCity       = "city"
+	TypeVillage    = "village"
+	TypeScenicSpot = "scenic_spot"
+	TypeTransitHub = "transit_hub"
+)
+
+// Hierarchy errors
+var (
+	ErrParentNotFound    = errors.New("parent location not found")
+	ErrInvalidParentType = errors.New("location type is not allowed under the parent's type")
+	ErrCircularHierarchy = errors.New("a location cannot be moved under itself or one of its descendants")
+	ErrChildTypeConflict = errors.New("existing children are not allowed under the new location type")
+)
+
+// allowedParentTypes lists, for each location type, the types it may be nested under.
+// Locations without a parent are always allowed so existing flat data keeps working.
+var allowedParentTypes = map[string][]string{
+	TypeCountry:    {},
+	TypeState:      {TypeCountry},
+	TypeRegion:     {TypeCountry, TypeState},
+	TypeDistrict:   {TypeState, TypeRegion},
+	TypeCity:       {TypeState, TypeRegion, TypeDistrict},
+	TypeVillage:    {TypeDistrict, TypeCity},
+	TypeScenicSpot: {TypeRegion, TypeDistrict, TypeCity, TypeVillage},
+	TypeTransitHub: {TypeDistrict, TypeCity, TypeVillage},
+}
+
+// IsValidLocationType reports whether t is a known location type
+func IsValidLocationType(t string) bool {
+	_, ok := allowedParentTypes[t]
+	return ok
+}
+
+// CanBeChildOf reports whether a location of childType may be nested under parentType
+func CanBeChildOf(childType, parentType string) bool {
+	for _, allowed := range allowedParentTypes[childType] {
+		if allowed == parentType {
+			return true
+		}
+	}
+	return false
+}
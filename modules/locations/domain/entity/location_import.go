@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Import job statuses
+const (
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+)
+
+// Import/export formats
+const (
+	FormatCSV     = "csv"
+	FormatGeoJSON = "geojson"
+)
+
+// MaxImportRowErrors caps how many row errors a job keeps, so a broken file can't bloat the document
+const MaxImportRowErrors = 1000
+
+// LocationImportJob tracks a bulk location import
+type LocationImportJob struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Status      string             `json:"status" bson:"status"`
+	Format      string             `json:"format" bson:"format"`
+	FileName    string             `json:"fileName" bson:"fileName"`
+	DryRun      bool               `json:"dryRun" bson:"dryRun"`
+	TotalRows   int                `json:"totalRows" bson:"totalRows"`
+	Processed   int                `json:"processed" bson:"processed"`
+	Created     int                `json:"created" bson:"created"`
+	Updated     int                `json:"updated" bson:"updated"`
+	Failed      int                `json:"failed" bson:"failed"`
+	Errors      []ImportRowError   `json:"errors" bson:"errors"`
+	Message     string             `json:"message,omitempty" bson:"message,omitempty"`
+	CreatedBy   string             `json:"createdBy,omitempty" bson:"createdBy,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt"`
+	StartedAt   *time.Time         `json:"startedAt,omitempty" bson:"startedAt,omitempty"`
+	CompletedAt *time.Time         `json:"completedAt,omitempty" bson:"completedAt,omitempty"`
+}
+
+// ImportRowError describes why a single row was rejected
+type ImportRowError struct {
+	Row     int    `json:"row" bson:"row"` // 1-based data row (CSV header and GeoJSON wrapper excluded)
+	Name    string `json:"name,omitempty" bson:"name,omitempty"`
+	Message string `json:"message" bson:"message"`
+}
+
+// LocationImportRow is one parsed input row. Either Location or Error is set.
+// ParentName/ParentType reference a parent by its unique key when no parentId is given.
+type LocationImportRow struct {
+	Row        int
+	Location   *Location
+	ParentName string
+	ParentType string
+	Error      string
+}
+
+// AddError records a row error, respecting MaxImportRowErrors
+func (j *LocationImportJob) AddError(row int, name, message string) {
+	j.Failed++
+	if len(j.Errors) < MaxImportRowErrors {
+		j.Errors = append(j.Errors, ImportRowError{Row: row, Name: name, Message: message})
+	}
+}
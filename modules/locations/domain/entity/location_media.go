@@ -0,0 +1,265 @@
+package entity
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+)
+
+// Media item types
+const (
+	MediaTypePhoto = "photo"
+	MediaTypeVideo = "video"
+)
+
+// Media validation errors
+var (
+	ErrMediaNotFound     = errors.New("media item not found")
+	ErrInvalidMediaOrder = errors.New("media order must list every media item of the location exactly once")
+	ErrCoverMustBePhoto  = errors.New("only a photo can be the cover image")
+)
+
+// MediaItem is a photo or video attached to a location
+type MediaItem struct {
+	ID        primitive.ObjectID   `json:"id" bson:"_id"`
+	Type      string               `json:"type" bson:"type" example:"photo"` // photo | video
+	URL       string               `json:"url" bson:"url"`
+	Variants  models.ImageVariants `json:"variants,omitempty" bson:"variants,omitempty"`
+	Caption   string               `json:"caption,omitempty" bson:"caption,omitempty"`
+	AltText   string               `json:"altText,omitempty" bson:"altText,omitempty"`
+	Credit    string               `json:"credit,omitempty" bson:"credit,omitempty"`
+	License   string               `json:"license,omitempty" bson:"license,omitempty"`
+	SortOrder int                  `json:"sortOrder" bson:"sortOrder"`
+	IsCover   bool                 `json:"isCover" bson:"isCover"`
+	CreatedAt time.Time            `json:"createdAt" bson:"createdAt"`
+}
+
+// IsPhoto reports whether the item is a photo
+func (m *MediaItem) IsPhoto() bool {
+	return m.Type == MediaTypePhoto
+}
+
+// StoredURLs returns the storage key of the item and of each distinct variant, for deleting from storage
+func (m *MediaItem) StoredURLs() []string {
+	image := models.ProcessedImage{URL: m.URL, Variants: m.Variants}
+	return image.URLs()
+}
+
+// ResolveFileURLs replaces the stored keys of the item and its variants with the URLs returned by resolve
+func (m *MediaItem) ResolveFileURLs(resolve func(string) string) {
+	m.URL = resolve(m.URL)
+	m.Variants = m.Variants.Map(resolve)
+}
+
+// MediaItemUpdate holds the metadata fields to change on a media item; nil fields are kept
+type MediaItemUpdate struct {
+	Caption *string
+	AltText *string
+	Credit  *string
+	License *string
+}
+
+// NewPhotoItem creates a media item for a processed photo
+func NewPhotoItem(image models.ProcessedImage) MediaItem {
+	return MediaItem{Type: MediaTypePhoto, URL: image.URL, Variants: image.Variants}
+}
+
+// NewVideoItem creates a media item for a video URL
+func NewVideoItem(url string) MediaItem {
+	return MediaItem{Type: MediaTypeVideo, URL: url}
+}
+
+// AddMedia appends items after the existing media. The first photo becomes the cover
+// when the location has none.
+func (l *Location) AddMedia(items ...MediaItem) {
+	now := time.Now()
+	for _, item := range items {
+		if item.ID.IsZero() {
+			item.ID = primitive.NewObjectID()
+		}
+		if item.CreatedAt.IsZero() {
+			item.CreatedAt = now
+		}
+		item.IsCover = false
+		item.SortOrder = len(l.Media)
+		l.Media = append(l.Media, item)
+	}
+	l.NormalizeMedia()
+}
+
+// FindMedia returns the media item with the given ID, or nil
+func (l *Location) FindMedia(id primitive.ObjectID) *MediaItem {
+	for i := range l.Media {
+		if l.Media[i].ID == id {
+			return &l.Media[i]
+		}
+	}
+	return nil
+}
+
+// UpdateMedia applies metadata changes to a media item
+func (l *Location) UpdateMedia(id primitive.ObjectID, update MediaItemUpdate) (*MediaItem, error) {
+	item := l.FindMedia(id)
+	if item == nil {
+		return nil, ErrMediaNotFound
+	}
+	if update.Caption != nil {
+		item.Caption = *update.Caption
+	}
+	if update.AltText != nil {
+		item.AltText = *update.AltText
+	}
+	if update.Credit != nil {
+		item.Credit = *update.Credit
+	}
+	if update.License != nil {
+		item.License = *update.License
+	}
+	return item, nil
+}
+
+// RemoveMedia removes a media item and returns it. If it was the cover, the first
+// remaining photo becomes the cover.
+func (l *Location) RemoveMedia(id primitive.ObjectID) (MediaItem, error) {
+	for i, item := range l.Media {
+		if item.ID == id {
+			l.Media = append(l.Media[:i], l.Media[i+1:]...)
+			l.NormalizeMedia()
+			return item, nil
+		}
+	}
+	return MediaItem{}, ErrMediaNotFound
+}
+
+// ReorderMedia sets the display order to the given IDs, which must list every item once
+func (l *Location) ReorderMedia(ids []primitive.ObjectID) error {
+	if len(ids) != len(l.Media) {
+		return ErrInvalidMediaOrder
+	}
+	position := make(map[primitive.ObjectID]int, len(ids))
+	for i, id := range ids {
+		if _, dup := position[id]; dup {
+			return ErrInvalidMediaOrder
+		}
+		position[id] = i
+	}
+	for _, item := range l.Media {
+		if _, ok := position[item.ID]; !ok {
+			return ErrInvalidMediaOrder
+		}
+	}
+
+	for i := range l.Media {
+		l.Media[i].SortOrder = position[l.Media[i].ID]
+	}
+	l.NormalizeMedia()
+	return nil
+}
+
+// SetCoverMedia makes the given photo the cover image
+func (l *Location) SetCoverMedia(id primitive.ObjectID) error {
+	item := l.FindMedia(id)
+	if item == nil {
+		return ErrMediaNotFound
+	}
+	if !item.IsPhoto() {
+		return ErrCoverMustBePhoto
+	}
+	for i := range l.Media {
+		l.Media[i].IsCover = l.Media[i].ID == id
+	}
+	l.NormalizeMedia()
+	return nil
+}
+
+// CoverMedia returns the cover photo, or nil when the location has no photos
+func (l *Location) CoverMedia() *MediaItem {
+	for i := range l.Media {
+		if l.Media[i].IsCover {
+			return &l.Media[i]
+		}
+	}
+	return nil
+}
+
+// SetMediaURLs replaces the media with the given URLs, keeping the ID and metadata of
+// items whose URL is still listed. It returns the items that were dropped.
+func (l *Location) SetMediaURLs(photos, videos []string) []MediaItem {
+	existing := make(map[string]MediaItem, len(l.Media))
+	for _, item := range l.Media {
+		existing[item.Type+"|"+item.URL] = item
+	}
+
+	media := make([]MediaItem, 0, len(photos)+len(videos))
+	add := func(mediaType string, urls []string) {
+		for _, url := range urls {
+			key := mediaType + "|" + url
+			item, ok := existing[key]
+			if !ok {
+				item = MediaItem{ID: primitive.NewObjectID(), Type: mediaType, URL: url, CreatedAt: time.Now()}
+			}
+			delete(existing, key)
+			item.SortOrder = len(media)
+			media = append(media, item)
+		}
+	}
+	add(MediaTypePhoto, photos)
+	add(MediaTypeVideo, videos)
+
+	var removed []MediaItem
+	for _, item := range l.Media {
+		if _, ok := existing[item.Type+"|"+item.URL]; ok {
+			removed = append(removed, item)
+		}
+	}
+
+	l.Media = media
+	l.NormalizeMedia()
+	return removed
+}
+
+// ResolveFileURLs replaces the stored media keys with the URLs returned by resolve, for responses
+func (l *Location) ResolveFileURLs(resolve func(string) string) {
+	for i := range l.Media {
+		l.Media[i].ResolveFileURLs(resolve)
+	}
+	l.NormalizeMedia()
+}
+
+// NormalizeMedia sorts items by their order, renumbers them from zero, keeps exactly
+// one photo as the cover and refreshes the derived MediaURLs
+func (l *Location) NormalizeMedia() {
+	sort.SliceStable(l.Media, func(i, j int) bool { return l.Media[i].SortOrder < l.Media[j].SortOrder })
+
+	coverSeen := false
+	firstPhoto := -1
+	for i := range l.Media {
+		l.Media[i].SortOrder = i
+		if l.Media[i].IsPhoto() && firstPhoto < 0 {
+			firstPhoto = i
+		}
+		if l.Media[i].IsCover {
+			if coverSeen || !l.Media[i].IsPhoto() {
+				l.Media[i].IsCover = false
+			} else {
+				coverSeen = true
+			}
+		}
+	}
+	if !coverSeen && firstPhoto >= 0 {
+		l.Media[firstPhoto].IsCover = true
+	}
+
+	l.MediaURLs = MediaURLs{Photos: []string{}, Videos: []string{}}
+	for _, item := range l.Media {
+		if item.IsPhoto() {
+			l.MediaURLs.Photos = append(l.MediaURLs.Photos, item.URL)
+		} else {
+			l.MediaURLs.Videos = append(l.MediaURLs.Videos, item.URL)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LocationImportJobRepository persists bulk import jobs
+type LocationImportJobRepository interface {
+	// Create inserts a new job and sets its ID on the entity.
+	Create(ctx context.Context, job *entity.LocationImportJob) error
+
+	// Update replaces the job's progress, counters and errors.
+	Update(ctx context.Context, job *entity.LocationImportJob) error
+
+	// FindByID returns the job, or nil if it does not exist.
+	FindByID(ctx context.Context, id primitive.ObjectID) (*entity.LocationImportJob, error)
+
+	// FailUnfinished marks the pending and running jobs created before createdBefore as
+	// failed with message, returning the number marked.
+	FailUnfinished(ctx context.Context, createdBefore time.Time, message string) (int64, error)
+}
@@ -14,6 +14,10 @@ type LocationRepository interface {
 	// By default it should exclude soft-deleted locations unless filter includes them.
 	FindAll(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Location, int64, error)
 
+	// FindPage retrieves the page of locations matching the filter selected by opts, by
+	// page number or cursor. Soft-deleted locations are excluded.
+	FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Location, *models.PageInfo, error)
+
 	// FindByID finds a single location by its ObjectID.
 	// Should return nil if not found or soft-deleted (unless specifically included in filter).
 	FindByID(ctx context.Context, id primitive.ObjectID) (*entity.Location, error)
@@ -24,6 +28,10 @@ type LocationRepository interface {
 	// Update applies changes to an existing location document.
 	Update(ctx context.Context, loc *entity.Location) error
 
+	// Patch writes only the fields of updated that differ from original. It fails with
+	// models.ErrVersionConflict if the location changed after original was read.
+	Patch(ctx context.Context, original, updated *entity.Location) error
+
 	// SoftDelete marks a location as deleted by setting deletedAt timestamp.
 	SoftDelete(ctx context.Context, id primitive.ObjectID) (bool, error)
 
@@ -38,4 +46,46 @@ type LocationRepository interface {
 
 	// HardDelete permanently removes a location from the database.
 	HardDelete(ctx context.Context, id primitive.ObjectID) (bool, error)
+
+	// FindNear retrieves locations matching the filter ordered by distance from near (closest first).
+	// maxDistanceKm <= 0 means unbounded; each result has DistanceKm set.
+	FindNear(ctx context.Context, filter map[string]interface{}, near entity.Coordinates, maxDistanceKm float64, page, limit int) ([]*entity.Location, int64, error)
+
+	// SearchText runs a weighted full-text search, most relevant first; SearchScore is set on each result.
+	SearchText(ctx context.Context, search string, filter map[string]interface{}, limit int) ([]*entity.Location, error)
+
+	// SearchTrigrams finds locations whose name or aliases share enough trigrams with the given set,
+	// best matches first; SearchScore holds the similarity.
+	SearchTrigrams(ctx context.Context, trigrams []string, filter map[string]interface{}, minSimilarity float64, limit int) ([]*entity.Location, error)
+
+	// FindByPrefix returns locations whose normalized name or alias has a word starting with prefix.
+	FindByPrefix(ctx context.Context, prefix string, limit int) ([]*entity.Location, error)
+
+	// IncrementPopularity records a view of the location.
+	IncrementPopularity(ctx context.Context, id primitive.ObjectID) error
+
+	// FindByNameAndType returns the non-deleted location with this unique key, or nil.
+	FindByNameAndType(ctx context.Context, name, locationType string) (*entity.Location, error)
+
+	// UpsertByNameAndType inserts loc or overwrites the non-deleted location with the same
+	// (name, type). Media, popularity and createdAt of an existing location are kept.
+	// loc.ID is set in both cases; created reports whether a new document was inserted.
+	UpsertByNameAndType(ctx context.Context, loc *entity.Location) (created bool, err error)
+
+	// Stream calls fn for every location matching the filter, sorted by name, without
+	// loading the whole result into memory. Iteration stops at the first error from fn.
+	Stream(ctx context.Context, filter map[string]interface{}, fn func(*entity.Location) error) error
+
+	// FindByIDs fetches the given locations, in no particular order.
+	FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*entity.Location, error)
+
+	// FindChildTypes returns the distinct types of the non-deleted direct children of a location.
+	FindChildTypes(ctx context.Context, parentID primitive.ObjectID) ([]string, error)
+
+	// RewriteDescendantPaths replaces the ancestor path above id for every descendant of id
+	// with newPath (the moved location's own new path plus id), returning the number updated.
+	RewriteDescendantPaths(ctx context.Context, id primitive.ObjectID, newPath []primitive.ObjectID) (int64, error)
+
+	// HardDeleteDescendants permanently removes every descendant of id, returning the number removed
+	HardDeleteDescendants(ctx context.Context, id primitive.ObjectID) (int64, error)
 }
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/utils"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// autocompleteCandidates is how many prefix matches are fetched before ranking
+const autocompleteCandidates = 50
+
+// AutocompleteLocationsUseCase suggests locations for a partially typed query
+type AutocompleteLocationsUseCase struct{ Repo repo.LocationRepository }
+
+func NewAutocompleteLocationsUseCase(r repo.LocationRepository) *AutocompleteLocationsUseCase {
+	return &AutocompleteLocationsUseCase{Repo: r}
+}
+
+// Execute ranks prefix matches by relevance (name start > word start > alias) plus a
+// logarithmic popularity boost. Typos fall back to trigram matches at a lower weight.
+func (uc *AutocompleteLocationsUseCase) Execute(ctx context.Context, query string, limit int) ([]en.LocationSuggestion, error) {
+	prefix := utils.NormalizeSearchText(query)
+	if prefix == "" {
+		return []en.LocationSuggestion{}, nil
+	}
+
+	candidates, err := uc.Repo.FindByPrefix(ctx, prefix, autocompleteCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]en.LocationSuggestion, 0, len(candidates))
+	seen := make(map[string]struct{}, len(candidates))
+	for _, loc := range candidates {
+		relevance, matchedOn := prefixRelevance(loc, prefix)
+		if relevance == 0 {
+			continue
+		}
+		seen[loc.ID.Hex()] = struct{}{}
+		suggestions = append(suggestions, toSuggestion(loc, matchedOn, relevance))
+	}
+
+	if len(suggestions) < limit {
+		fuzzy, err := uc.Repo.SearchTrigrams(ctx, utils.Trigrams(prefix), map[string]interface{}{}, minTrigramSimilarity, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, loc := range fuzzy {
+			if _, dup := seen[loc.ID.Hex()]; dup {
+				continue
+			}
+			similarity := 0.0
+			if loc.SearchScore != nil {
+				similarity = *loc.SearchScore
+			}
+			suggestions = append(suggestions, toSuggestion(loc, "fuzzy", 0.5*similarity))
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// prefixRelevance scores how well prefix matches the location's name or aliases
+func prefixRelevance(loc *en.Location, prefix string) (float64, string) {
+	name := utils.NormalizeSearchText(loc.Name)
+	if strings.HasPrefix(name, prefix) {
+		return 1.0, "name"
+	}
+	if hasWordPrefix(name, prefix) {
+		return 0.8, "name"
+	}
+	for _, alias := range loc.Aliases {
+		if hasWordPrefix(utils.NormalizeSearchText(alias), prefix) {
+			return 0.6, "alias"
+		}
+	}
+	return 0, ""
+}
+
+func toSuggestion(loc *en.Location, matchedOn string, relevance float64) en.LocationSuggestion {
+	// Popularity can lift a suggestion by at most ~0.5, so relevance still dominates
+	boost := math.Min(0.1*math.Log10(float64(loc.Popularity)+1), 0.5)
+	return en.LocationSuggestion{
+		ID:         loc.ID,
+		Name:       loc.Name,
+		Type:       loc.Type,
+		State:      loc.State,
+		MatchedOn:  matchedOn,
+		Popularity: loc.Popularity,
+		Score:      math.Round((relevance+boost)*1000) / 1000,
+	}
+}
+
+// hasWordPrefix reports whether any word of s starts with prefix
+func hasWordPrefix(s, prefix string) bool {
+	if strings.HasPrefix(s, prefix) {
+		return true
+	}
+	return strings.Contains(s, " "+prefix)
+}
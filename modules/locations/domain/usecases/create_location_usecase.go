@@ -36,6 +36,11 @@ func (uc *CreateLocationUseCase) Execute(ctx context.Context, loc *en.Location)
 		return errors.New("location with the same name already exists")
 	}
 
+	// Validate the parent and build the ancestor path
+	if err := attachToParent(ctx, uc.Repo, loc); err != nil {
+		return err
+	}
+
 	// Set default timestamps
 	loc.Name = locationName // normalize
 	loc.CreatedAt = time.Now()
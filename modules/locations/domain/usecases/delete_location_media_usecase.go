@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// DeleteLocationMediaUseCase removes a single media item and its stored files
+type DeleteLocationMediaUseCase struct {
+	Repo  repo.LocationRepository
+	Files services.FileService
+}
+
+func NewDeleteLocationMediaUseCase(r repo.LocationRepository, files services.FileService) *DeleteLocationMediaUseCase {
+	return &DeleteLocationMediaUseCase{Repo: r, Files: files}
+}
+
+// Execute removes the item from the location, then deletes the file and its variants
+// from storage. Storage failures are logged rather than returned, as the item is
+// already gone from the location.
+func (uc *DeleteLocationMediaUseCase) Execute(ctx context.Context, locationID, mediaID primitive.ObjectID) (*en.Location, error) {
+	loc, err := uc.Repo.FindByID(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil || loc.IsDeleted() {
+		return nil, errors.New("location not found")
+	}
+
+	removed, err := loc.RemoveMedia(mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Update(ctx, loc); err != nil {
+		return nil, err
+	}
+
+	if uc.Files != nil {
+		for _, url := range removed.StoredURLs() {
+			if err := uc.Files.DeleteFile(ctx, url); err != nil {
+				log.Printf("Failed to delete media file %s of location %s: %v", url, locationID.Hex(), err)
+			}
+		}
+	}
+	return loc, nil
+}
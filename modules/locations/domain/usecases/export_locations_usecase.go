@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// ExportLocationsUseCase streams every location matching a filter
+type ExportLocationsUseCase struct{ Repo repo.LocationRepository }
+
+func NewExportLocationsUseCase(r repo.LocationRepository) *ExportLocationsUseCase {
+	return &ExportLocationsUseCase{Repo: r}
+}
+
+// Execute calls write for each matching location, in name order
+func (uc *ExportLocationsUseCase) Execute(ctx context.Context, filter map[string]interface{}, write func(*en.Location) error) error {
+	return uc.Repo.Stream(ctx, filter, write)
+}
@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// GetLocationAncestorsUseCase resolves a location's ancestor path into locations
+type GetLocationAncestorsUseCase struct{ Repo repo.LocationRepository }
+
+func NewGetLocationAncestorsUseCase(r repo.LocationRepository) *GetLocationAncestorsUseCase {
+	return &GetLocationAncestorsUseCase{Repo: r}
+}
+
+// Execute returns the ancestors ordered from the root down to the direct parent
+func (uc *GetLocationAncestorsUseCase) Execute(ctx context.Context, loc *en.Location) ([]*en.Location, error) {
+	found, err := uc.Repo.FindByIDs(ctx, loc.Ancestors)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*en.Location, len(found))
+	for _, ancestor := range found {
+		byID[ancestor.ID.Hex()] = ancestor
+	}
+
+	ancestors := make([]*en.Location, 0, len(loc.Ancestors))
+	for _, id := range loc.Ancestors {
+		if ancestor, ok := byID[id.Hex()]; ok {
+			ancestors = append(ancestors, ancestor)
+		}
+	}
+	return ancestors, nil
+}
@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GetLocationChildrenUseCase retrieves the direct children of a location
+type GetLocationChildrenUseCase struct{ Repo repo.LocationRepository }
+
+func NewGetLocationChildrenUseCase(r repo.LocationRepository) *GetLocationChildrenUseCase {
+	return &GetLocationChildrenUseCase{Repo: r}
+}
+
+func (uc *GetLocationChildrenUseCase) Execute(ctx context.Context, parentID primitive.ObjectID, page, limit int) ([]*en.Location, int64, error) {
+	filter := map[string]interface{}{
+		"parentId": parentID,
+	}
+	return uc.Repo.FindAll(ctx, filter, page, limit)
+}
@@ -0,0 +1,22 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GetLocationImportJobUseCase fetches an import job's status and row errors
+type GetLocationImportJobUseCase struct {
+	JobRepo repo.LocationImportJobRepository
+}
+
+func NewGetLocationImportJobUseCase(jobRepo repo.LocationImportJobRepository) *GetLocationImportJobUseCase {
+	return &GetLocationImportJobUseCase{JobRepo: jobRepo}
+}
+
+func (uc *GetLocationImportJobUseCase) Execute(ctx context.Context, id primitive.ObjectID) (*en.LocationImportJob, error) {
+	return uc.JobRepo.FindByID(ctx, id)
+}
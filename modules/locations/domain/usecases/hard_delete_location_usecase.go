@@ -3,25 +3,41 @@ package usecases
 import (
     "context"
 
+    "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
     repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
     "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-// HardDeleteLocationUseCase permanently removes a location.
+// HardDeleteLocationUseCase permanently removes a location and its subtree.
 type HardDeleteLocationUseCase struct {
-    repo repo.LocationRepository
+    repo     repo.LocationRepository
+    transact database.Transactor
 }
 
 // NewHardDeleteLocationUseCase creates a HardDeleteLocationUseCase.
-func NewHardDeleteLocationUseCase(r repo.LocationRepository) *HardDeleteLocationUseCase {
-    return &HardDeleteLocationUseCase{repo: r}
+func NewHardDeleteLocationUseCase(r repo.LocationRepository, transact database.Transactor) *HardDeleteLocationUseCase {
+    return &HardDeleteLocationUseCase{repo: r, transact: transact}
 }
 
-// Execute deletes the location with the given ID.
-// Returns true if a document was deleted.
+// Execute deletes the location with the given ID together with every location below
+// it, in one transaction, so no location is left pointing at a missing parent.
+// Returns true if the location was deleted.
 func (uc *HardDeleteLocationUseCase) Execute(
     ctx context.Context,
     id primitive.ObjectID,
 ) (bool, error) {
-    return uc.repo.HardDelete(ctx, id)
+    var deleted bool
+    err := uc.transact(ctx, func(ctx context.Context) error {
+        var err error
+        deleted, err = uc.repo.HardDelete(ctx, id)
+        if err != nil || !deleted {
+            return err
+        }
+        _, err = uc.repo.HardDeleteDescendants(ctx, id)
+        return err
+    })
+    if err != nil {
+        return false, err
+    }
+    return deleted, nil
 }
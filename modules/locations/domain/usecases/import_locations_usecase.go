@@ -0,0 +1,210 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/jobs"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// importJobTimeout bounds how long a single import may run in the background
+	importJobTimeout = 30 * time.Minute
+	// importProgressEvery controls how often progress is persisted
+	importProgressEvery = 100
+)
+
+// ImportLocationsUseCase validates parsed rows and upserts them on (name, type) in a background job
+type ImportLocationsUseCase struct {
+	Repo       repo.LocationRepository
+	JobRepo    repo.LocationImportJobRepository
+	Background *jobs.Group
+}
+
+func NewImportLocationsUseCase(r repo.LocationRepository, jobRepo repo.LocationImportJobRepository, background *jobs.Group) *ImportLocationsUseCase {
+	return &ImportLocationsUseCase{Repo: r, JobRepo: jobRepo, Background: background}
+}
+
+// Execute stores a pending job and processes the rows asynchronously. Poll the job to follow progress.
+// Rows are applied in order, so parents must appear before their children.
+func (uc *ImportLocationsUseCase) Execute(ctx context.Context, job *en.LocationImportJob, rows []en.LocationImportRow) (*en.LocationImportJob, error) {
+	job.Status = en.ImportStatusPending
+	job.TotalRows = len(rows)
+	job.Errors = []en.ImportRowError{}
+	job.CreatedAt = time.Now()
+
+	if err := uc.JobRepo.Create(ctx, job); err != nil {
+		return nil, err
+	}
+
+	// The request context ends with the response, the job must outlive it. Shutdown
+	// cancels it instead, and it is recorded as failed.
+	snapshot := *job
+	uc.Background.Go(func(ctx context.Context) {
+		uc.run(ctx, &snapshot, rows)
+	})
+
+	return job, nil
+}
+
+// FailInterrupted marks the jobs a crashed server left pending or running as failed.
+// Only jobs older than the import timeout are touched, as younger ones may still be
+// running on another instance.
+func (uc *ImportLocationsUseCase) FailInterrupted(ctx context.Context) (int64, error) {
+	return uc.JobRepo.FailUnfinished(ctx, time.Now().Add(-importJobTimeout), "import was interrupted by a server restart")
+}
+
+func (uc *ImportLocationsUseCase) run(ctx context.Context, job *en.LocationImportJob, rows []en.LocationImportRow) {
+	ctx, cancel := context.WithTimeout(ctx, importJobTimeout)
+	defer cancel()
+
+	started := time.Now()
+	job.Status = en.ImportStatusRunning
+	job.StartedAt = &started
+	uc.save(ctx, job)
+
+	// Locations created earlier in this import, by ID and by unique key. In a dry run
+	// they are never written, so children must resolve them from here.
+	byID := make(map[primitive.ObjectID]*en.Location)
+	byKey := make(map[string]*en.Location)
+
+	for _, row := range rows {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if err := uc.applyRow(ctx, job, row, byID, byKey); err != nil {
+			name := ""
+			if row.Location != nil {
+				name = row.Location.Name
+			}
+			job.AddError(row.Row, name, err.Error())
+		}
+
+		job.Processed++
+		if job.Processed%importProgressEvery == 0 {
+			uc.save(ctx, job)
+		}
+	}
+
+	completed := time.Now()
+	job.CompletedAt = &completed
+	if ctx.Err() != nil {
+		job.Status = en.ImportStatusFailed
+		job.Message = fmt.Sprintf("import stopped after %d of %d rows: %v", job.Processed, job.TotalRows, ctx.Err())
+	} else {
+		job.Status = en.ImportStatusCompleted
+	}
+	uc.save(context.Background(), job)
+}
+
+func (uc *ImportLocationsUseCase) applyRow(ctx context.Context, job *en.LocationImportJob, row en.LocationImportRow, byID map[primitive.ObjectID]*en.Location, byKey map[string]*en.Location) error {
+	if row.Error != "" {
+		return fmt.Errorf("%s", row.Error)
+	}
+	loc := row.Location
+	loc.Name = strings.TrimSpace(loc.Name)
+
+	existing, err := uc.findByKey(ctx, loc.Name, loc.Type, byKey)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		loc.ID = existing.ID
+	}
+
+	parent, err := uc.resolveParent(ctx, loc, row, byID, byKey)
+	if err != nil {
+		return err
+	}
+	if parent != nil {
+		if err := placeUnder(loc, parent); err != nil {
+			return err
+		}
+	} else {
+		loc.ParentID = nil
+		loc.Ancestors = []primitive.ObjectID{}
+		loc.Depth = 0
+	}
+
+	if job.DryRun {
+		if existing != nil {
+			job.Updated++
+		} else {
+			job.Created++
+			loc.ID = primitive.NewObjectID() // placeholder so later rows can nest under it
+		}
+	} else {
+		created, err := uc.Repo.UpsertByNameAndType(ctx, loc)
+		if err != nil {
+			return err
+		}
+		if created {
+			job.Created++
+		} else {
+			job.Updated++
+			// The location may have moved, so its subtree needs the new path
+			if _, err := uc.Repo.RewriteDescendantPaths(ctx, loc.ID, loc.PathUnder()); err != nil {
+				return err
+			}
+		}
+	}
+
+	byID[loc.ID] = loc
+	byKey[importKey(loc.Name, loc.Type)] = loc
+	return nil
+}
+
+// resolveParent finds the row's parent by ID or by (parentName, parentType), checking
+// locations from earlier rows first. It returns nil when the row has no parent.
+func (uc *ImportLocationsUseCase) resolveParent(ctx context.Context, loc *en.Location, row en.LocationImportRow, byID map[primitive.ObjectID]*en.Location, byKey map[string]*en.Location) (*en.Location, error) {
+	if loc.ParentID != nil {
+		if parent, ok := byID[*loc.ParentID]; ok {
+			return parent, nil
+		}
+		parent, err := uc.Repo.FindByID(ctx, *loc.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, en.ErrParentNotFound
+		}
+		return parent, nil
+	}
+
+	if row.ParentName == "" {
+		return nil, nil
+	}
+
+	parent, err := uc.findByKey(ctx, row.ParentName, row.ParentType, byKey)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, fmt.Errorf("%w: %s (%s)", en.ErrParentNotFound, row.ParentName, row.ParentType)
+	}
+	return parent, nil
+}
+
+func (uc *ImportLocationsUseCase) findByKey(ctx context.Context, name, locationType string, byKey map[string]*en.Location) (*en.Location, error) {
+	if loc, ok := byKey[importKey(name, locationType)]; ok {
+		return loc, nil
+	}
+	return uc.Repo.FindByNameAndType(ctx, name, locationType)
+}
+
+func (uc *ImportLocationsUseCase) save(ctx context.Context, job *en.LocationImportJob) {
+	if err := uc.JobRepo.Update(ctx, job); err != nil {
+		log.Printf("Failed to save location import job %s: %v", job.ID.Hex(), err)
+	}
+}
+
+func importKey(name, locationType string) string {
+	return strings.TrimSpace(name) + "\x00" + locationType
+}
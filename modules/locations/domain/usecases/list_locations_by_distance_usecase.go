@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// ListLocationsByDistanceUseCase retrieves a filtered list sorted by distance from a point
+type ListLocationsByDistanceUseCase struct{ Repo repo.LocationRepository }
+
+func NewListLocationsByDistanceUseCase(r repo.LocationRepository) *ListLocationsByDistanceUseCase {
+	return &ListLocationsByDistanceUseCase{Repo: r}
+}
+
+func (uc *ListLocationsByDistanceUseCase) Execute(ctx context.Context, filter map[string]interface{}, near en.Coordinates, radiusKm float64, page, limit int) ([]*en.Location, int64, error) {
+	return uc.Repo.FindNear(ctx, filter, near, radiusKm, page, limit)
+}
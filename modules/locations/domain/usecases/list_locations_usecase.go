@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
 	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
 )
@@ -14,6 +15,6 @@ func NewListLocationsUseCase(r repo.LocationRepository) *ListLocationsUseCase {
 	return &ListLocationsUseCase{Repo: r}
 }
 
-func (uc *ListLocationsUseCase) Execute(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*en.Location, int64, error) {
-	return uc.Repo.FindAll(ctx, filter, page, limit)
+func (uc *ListLocationsUseCase) Execute(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*en.Location, *models.PageInfo, error) {
+	return uc.Repo.FindPage(ctx, filter, opts)
 }
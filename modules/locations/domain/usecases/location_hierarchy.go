@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// attachToParent validates loc.ParentID and fills in the materialized ancestor path.
+// A nil ParentID makes loc a root.
+func attachToParent(ctx context.Context, r repo.LocationRepository, loc *en.Location) error {
+	if loc.ParentID == nil {
+		loc.Ancestors = []primitive.ObjectID{}
+		loc.Depth = 0
+		return nil
+	}
+
+	parent, err := r.FindByID(ctx, *loc.ParentID)
+	if err != nil {
+		return err
+	}
+	return placeUnder(loc, parent)
+}
+
+// placeUnder checks that loc may be nested under parent and sets its ancestor path
+func placeUnder(loc *en.Location, parent *en.Location) error {
+	if parent == nil || parent.IsDeleted() {
+		return en.ErrParentNotFound
+	}
+
+	if !loc.ID.IsZero() && (parent.ID == loc.ID || parent.IsDescendantOf(loc.ID)) {
+		return en.ErrCircularHierarchy
+	}
+
+	if !en.CanBeChildOf(loc.Type, parent.Type) {
+		return en.ErrInvalidParentType
+	}
+
+	parentID := parent.ID
+	loc.ParentID = &parentID
+	loc.Ancestors = parent.PathUnder()
+	loc.Depth = len(loc.Ancestors)
+	return nil
+}
@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MoveLocationUseCase re-parents a location together with its whole subtree
+type MoveLocationUseCase struct {
+	Repo     repo.LocationRepository
+	Transact database.Transactor
+}
+
+func NewMoveLocationUseCase(r repo.LocationRepository, transact database.Transactor) *MoveLocationUseCase {
+	return &MoveLocationUseCase{Repo: r, Transact: transact}
+}
+
+// Execute moves the location under newParentID (nil moves it to the root) and
+// rewrites the ancestor path of every descendant, in one transaction. Deleted
+// locations cannot be moved or be moved under.
+func (uc *MoveLocationUseCase) Execute(ctx context.Context, id primitive.ObjectID, newParentID *primitive.ObjectID) (*en.Location, error) {
+	var moved *en.Location
+	err := uc.Transact(ctx, func(ctx context.Context) error {
+		loc, err := uc.Repo.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if loc == nil || loc.IsDeleted() {
+			return errors.New("location not found")
+		}
+
+		// The parent is read in the transaction, so one deleted before it started is refused
+		loc.ParentID = newParentID
+		if err := attachToParent(ctx, uc.Repo, loc); err != nil {
+			return err
+		}
+
+		if err := uc.Repo.Update(ctx, loc); err != nil {
+			return err
+		}
+
+		if _, err := uc.Repo.RewriteDescendantPaths(ctx, loc.ID, loc.PathUnder()); err != nil {
+			return err
+		}
+
+		moved = loc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// PatchLocationUseCase saves a partial update of a location
+type PatchLocationUseCase struct{ Repo repo.LocationRepository }
+
+func NewPatchLocationUseCase(r repo.LocationRepository) *PatchLocationUseCase {
+	return &PatchLocationUseCase{Repo: r}
+}
+
+// Execute writes the fields of updated that differ from original, the location as it was read
+func (uc *PatchLocationUseCase) Execute(ctx context.Context, original, updated *en.Location) error {
+	// A type change must still fit under the parent and above the existing children
+	if updated.Type != original.Type {
+		if updated.ParentID != nil {
+			parent, err := uc.Repo.FindByID(ctx, *updated.ParentID)
+			if err != nil {
+				return err
+			}
+			if parent != nil && !en.CanBeChildOf(updated.Type, parent.Type) {
+				return en.ErrInvalidParentType
+			}
+		}
+
+		childTypes, err := uc.Repo.FindChildTypes(ctx, updated.ID)
+		if err != nil {
+			return err
+		}
+		for _, childType := range childTypes {
+			if !en.CanBeChildOf(childType, updated.Type) {
+				return en.ErrChildTypeConflict
+			}
+		}
+	}
+
+	return uc.Repo.Patch(ctx, original, updated)
+}
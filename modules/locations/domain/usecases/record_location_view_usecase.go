@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RecordLocationViewUseCase bumps a location's popularity, which ranks autocomplete suggestions
+type RecordLocationViewUseCase struct{ Repo repo.LocationRepository }
+
+func NewRecordLocationViewUseCase(r repo.LocationRepository) *RecordLocationViewUseCase {
+	return &RecordLocationViewUseCase{Repo: r}
+}
+
+func (uc *RecordLocationViewUseCase) Execute(ctx context.Context, id primitive.ObjectID) error {
+	return uc.Repo.IncrementPopularity(ctx, id)
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// ReorderLocationMediaUseCase changes the display order of a location's media
+type ReorderLocationMediaUseCase struct{ Repo repo.LocationRepository }
+
+func NewReorderLocationMediaUseCase(r repo.LocationRepository) *ReorderLocationMediaUseCase {
+	return &ReorderLocationMediaUseCase{Repo: r}
+}
+
+// Execute orders the media as listed in mediaIDs, which must contain every item once
+func (uc *ReorderLocationMediaUseCase) Execute(ctx context.Context, locationID primitive.ObjectID, mediaIDs []primitive.ObjectID) (*en.Location, error) {
+	loc, err := uc.Repo.FindByID(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil || loc.IsDeleted() {
+		return nil, errors.New("location not found")
+	}
+
+	if err := loc.ReorderMedia(mediaIDs); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Update(ctx, loc); err != nil {
+		return nil, err
+	}
+	return loc, nil
+}
@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/utils"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// minTrigramSimilarity is the Jaccard similarity a fuzzy match needs to be returned.
+// "Darjeling" vs "Darjeeling" scores about 0.54; unrelated names score near 0.
+const minTrigramSimilarity = 0.3
+
+// SearchLocationsUseCase runs a weighted full-text search with a typo-tolerant fallback
+type SearchLocationsUseCase struct{ Repo repo.LocationRepository }
+
+func NewSearchLocationsUseCase(r repo.LocationRepository) *SearchLocationsUseCase {
+	return &SearchLocationsUseCase{Repo: r}
+}
+
+// Execute returns up to limit locations matching query. Full-text matches come first;
+// when they don't fill the page, trigram matches on name and aliases are appended.
+func (uc *SearchLocationsUseCase) Execute(ctx context.Context, query string, filter map[string]interface{}, limit int) ([]*en.Location, error) {
+	normalized := utils.NormalizeSearchText(query)
+	if normalized == "" {
+		return []*en.Location{}, nil
+	}
+
+	// Search both the normalized words and their phonetic keys so spelling variants
+	// hit the searchKeys field of the text index
+	terms := normalized
+	if key := utils.PhoneticKey(normalized); key != normalized {
+		terms += " " + key
+	}
+
+	results, err := uc.Repo.SearchText(ctx, terms, copyFilter(filter), limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) >= limit {
+		return results, nil
+	}
+
+	fuzzy, err := uc.Repo.SearchTrigrams(ctx, utils.Trigrams(normalized), copyFilter(filter), minTrigramSimilarity, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(results))
+	for _, loc := range results {
+		seen[loc.ID.Hex()] = struct{}{}
+	}
+	for _, loc := range fuzzy {
+		if len(results) >= limit {
+			break
+		}
+		if _, dup := seen[loc.ID.Hex()]; dup {
+			continue
+		}
+		results = append(results, loc)
+	}
+
+	return results, nil
+}
+
+// copyFilter returns a shallow copy so each query can add its own keys
+func copyFilter(filter map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(filter))
+	for k, v := range filter {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// SetLocationCoverMediaUseCase chooses the cover photo of a location
+type SetLocationCoverMediaUseCase struct{ Repo repo.LocationRepository }
+
+func NewSetLocationCoverMediaUseCase(r repo.LocationRepository) *SetLocationCoverMediaUseCase {
+	return &SetLocationCoverMediaUseCase{Repo: r}
+}
+
+// Execute makes mediaID the cover; it must be a photo
+func (uc *SetLocationCoverMediaUseCase) Execute(ctx context.Context, locationID, mediaID primitive.ObjectID) (*en.Location, error) {
+	loc, err := uc.Repo.FindByID(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil || loc.IsDeleted() {
+		return nil, errors.New("location not found")
+	}
+
+	if err := loc.SetCoverMedia(mediaID); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Update(ctx, loc); err != nil {
+		return nil, err
+	}
+	return loc, nil
+}
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
+)
+
+// UpdateLocationMediaUseCase edits the caption, alt text, credit and licence of a media item
+type UpdateLocationMediaUseCase struct{ Repo repo.LocationRepository }
+
+func NewUpdateLocationMediaUseCase(r repo.LocationRepository) *UpdateLocationMediaUseCase {
+	return &UpdateLocationMediaUseCase{Repo: r}
+}
+
+// Execute applies the metadata update and returns the updated item
+func (uc *UpdateLocationMediaUseCase) Execute(ctx context.Context, locationID, mediaID primitive.ObjectID, update en.MediaItemUpdate) (*en.MediaItem, error) {
+	loc, err := uc.Repo.FindByID(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil || loc.IsDeleted() {
+		return nil, errors.New("location not found")
+	}
+
+	item, err := loc.UpdateMedia(mediaID, update)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Update(ctx, loc); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
@@ -15,5 +15,26 @@ func NewUpdateLocationUseCase(r repo.LocationRepository) *UpdateLocationUseCase
 }
 
 func (uc *UpdateLocationUseCase) Execute(ctx context.Context, loc *en.Location) error {
+	// A type change must still fit under the parent and above the existing children
+	if loc.ParentID != nil {
+		parent, err := uc.Repo.FindByID(ctx, *loc.ParentID)
+		if err != nil {
+			return err
+		}
+		if parent != nil && !en.CanBeChildOf(loc.Type, parent.Type) {
+			return en.ErrInvalidParentType
+		}
+	}
+
+	childTypes, err := uc.Repo.FindChildTypes(ctx, loc.ID)
+	if err != nil {
+		return err
+	}
+	for _, childType := range childTypes {
+		if !en.CanBeChildOf(childType, loc.Type) {
+			return en.ErrChildTypeConflict
+		}
+	}
+
 	return uc.Repo.Update(ctx, loc)
 }
\ No newline at end of file
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
 	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -18,11 +20,11 @@ func NewUploadLocationMediaUseCase(r repo.LocationRepository) *UploadLocationMed
 	return &UploadLocationMediaUseCase{repo: r}
 }
 
-// Execute handles persisting photo/video URLs to the location
+// Execute handles persisting photo/video URLs to the location. Photos keep their variants.
 func (uc *UploadLocationMediaUseCase) Execute(
 	ctx context.Context,
 	locationID string,
-	photos []string,
+	photos []models.ProcessedImage,
 	videos []string,
 ) error {
 	// Convert to ObjectID
@@ -41,8 +43,14 @@ func (uc *UploadLocationMediaUseCase) Execute(
 	}
 
 	// Append media (don't overwrite)
-	location.MediaURLs.Photos = append(location.MediaURLs.Photos, photos...)
-	location.MediaURLs.Videos = append(location.MediaURLs.Videos, videos...)
+	items := make([]en.MediaItem, 0, len(photos)+len(videos))
+	for _, photo := range photos {
+		items = append(items, en.NewPhotoItem(photo))
+	}
+	for _, video := range videos {
+		items = append(items, en.NewVideoItem(video))
+	}
+	location.AddMedia(items...)
 
 	// Persist update
 	return uc.repo.Update(ctx, location)
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// BulkLocationTagsDto is the data of the addTags and removeTags bulk operations
+type BulkLocationTagsDto struct {
+	Tags []string `json:"tags" example:"beach,family"`
+}
+
+// Validate validates the BulkLocationTagsDto
+func (dto *BulkLocationTagsDto) Validate() error {
+	if len(dto.Tags) == 0 {
+		return errors.New("at least one tag is required")
+	}
+	if slices.ContainsFunc(dto.Tags, func(tag string) bool { return strings.TrimSpace(tag) == "" }) {
+		return errors.New("tags cannot be empty")
+	}
+	return nil
+}
+
+// AddTo returns tags with the tags of the DTO appended, skipping ones already present
+func (dto *BulkLocationTagsDto) AddTo(tags []string) []string {
+	result := append([]string{}, tags...)
+	for _, tag := range dto.Tags {
+		if !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// RemoveFrom returns tags without the tags of the DTO
+func (dto *BulkLocationTagsDto) RemoveFrom(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !slices.Contains(dto.Tags, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
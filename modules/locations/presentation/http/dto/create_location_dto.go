@@ -6,12 +6,15 @@ import (
 	"slices"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // LocationType represents the type of location
 type LocationType string
 
 const (
+	Country      LocationType = "country"
+	State        LocationType = "state"
 	City         LocationType = "city"
 	ScenicSpot   LocationType = "scenic_spot"
 	TransitHub   LocationType = "transit_hub"
@@ -31,6 +34,7 @@ type CreateLocationDto struct {
 	Pincode     string      `json:"pincode" binding:"required" example:"734001"`
 
 	// Optional fields
+	ParentID    string         `json:"parentId,omitempty" example:"6824886e6b180b753cea43e9"`
 	Coordinates CoordinatesDto `json:"coordinates,omitempty"`
 	Geojson     interface{}    `json:"geojson,omitempty"`
 	Tags        []string       `json:"tags,omitempty"`
@@ -57,6 +61,16 @@ type MediaUrlsDto struct {
 	Videos []string `json:"videos,omitempty"`
 }
 
+// MapURLs applies fn to every photo and video URL
+func (dto *MediaUrlsDto) MapURLs(fn func(string) string) {
+	for i := range dto.Photos {
+		dto.Photos[i] = fn(dto.Photos[i])
+	}
+	for i := range dto.Videos {
+		dto.Videos[i] = fn(dto.Videos[i])
+	}
+}
+
 // Validate validates the location creation DTO
 func (dto *CreateLocationDto) Validate() error {
 	if dto.Name == "" {
@@ -65,6 +79,20 @@ func (dto *CreateLocationDto) Validate() error {
 	if dto.Country == "" || dto.State == "" || dto.District == "" || dto.Pincode == "" {
 		return errors.New("country, state, district, and pincode are required")
 	}
+	if !entity.IsValidLocationType(string(dto.Type)) {
+		return errors.New("invalid location type")
+	}
+	if dto.ParentID != "" && !primitive.IsValidObjectID(dto.ParentID) {
+		return errors.New("invalid parent location ID")
+	}
+	if !(entity.Coordinates{Lat: dto.Coordinates.Lat, Lng: dto.Coordinates.Lng}).IsValid() {
+		return entity.ErrInvalidCoordinates
+	}
+	if dto.Geojson != nil {
+		if err := entity.ValidateGeoJSONBoundary(dto.Geojson); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -80,6 +108,12 @@ func (dto *CreateLocationDto) ToEntity() *entity.Location {
 		Description: dto.Description,
 	}
 
+	if dto.ParentID != "" {
+		if parentID, err := primitive.ObjectIDFromHex(dto.ParentID); err == nil {
+			location.ParentID = &parentID
+		}
+	}
+
 	if dto.Geojson != nil {
 		location.GeoJSON = dto.Geojson
 	}
@@ -95,15 +129,14 @@ func (dto *CreateLocationDto) ToEntity() *entity.Location {
 		if slices.Contains(dto.MediaURLs.Photos, "") {
 				return nil
 			}
-		location.MediaURLs.Photos = dto.MediaURLs.Photos
 	}
 
 	if len(dto.MediaURLs.Videos) > 0 {
 		if slices.Contains(dto.MediaURLs.Videos, "") {
 				return nil
 			}
-		location.MediaURLs.Videos = dto.MediaURLs.Videos
 	}
+	location.SetMediaURLs(dto.MediaURLs.Photos, dto.MediaURLs.Videos)
 
 	if len(dto.Aliases) > 0 {
 		if slices.Contains(dto.Aliases, "") {
@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+)
+
+// SortByDistance is the sort value that orders results by distance from `near`
+const SortByDistance = "distance"
+
+var (
+	ErrInvalidNear     = errors.New("near must be \"lat,lng\" with lat between -90 and 90 and lng between -180 and 180")
+	ErrInvalidRadius   = errors.New("radiusKm must be a positive number and requires near")
+	ErrInvalidBBox     = errors.New("bbox must be \"minLng,minLat,maxLng,maxLat\" within WGS84 bounds")
+	ErrInvalidPolygon  = errors.New("polygon must be at least three \"lng,lat\" pairs separated by ';'")
+	ErrInvalidSort     = errors.New("sort=distance cannot be combined with after or before, use page")
+	ErrDistanceNoPoint = errors.New("sort=distance requires near")
+)
+
+// parseNear parses "lat,lng"
+func parseNear(raw string) (*entity.Coordinates, error) {
+	values, ok := parseFloats(raw, ",", 2)
+	if !ok {
+		return nil, ErrInvalidNear
+	}
+	c := entity.Coordinates{Lat: values[0], Lng: values[1]}
+	if !c.IsValid() {
+		return nil, ErrInvalidNear
+	}
+	return &c, nil
+}
+
+// parseBBox parses "minLng,minLat,maxLng,maxLat" (GeoJSON bbox order) into a closed polygon ring
+func parseBBox(raw string) ([][]float64, error) {
+	values, ok := parseFloats(raw, ",", 4)
+	if !ok {
+		return nil, ErrInvalidBBox
+	}
+	minLng, minLat, maxLng, maxLat := values[0], values[1], values[2], values[3]
+	if minLng >= maxLng || minLat >= maxLat ||
+		!(entity.Coordinates{Lat: minLat, Lng: minLng}).IsValid() ||
+		!(entity.Coordinates{Lat: maxLat, Lng: maxLng}).IsValid() {
+		return nil, ErrInvalidBBox
+	}
+	return [][]float64{
+		{minLng, minLat},
+		{maxLng, minLat},
+		{maxLng, maxLat},
+		{minLng, maxLat},
+		{minLng, minLat},
+	}, nil
+}
+
+// parsePolygon parses "lng,lat;lng,lat;..." into a closed polygon ring
+func parsePolygon(raw string) ([][]float64, error) {
+	var ring [][]float64
+	for _, pair := range strings.Split(raw, ";") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		values, ok := parseFloats(pair, ",", 2)
+		if !ok || !(entity.Coordinates{Lat: values[1], Lng: values[0]}).IsValid() {
+			return nil, ErrInvalidPolygon
+		}
+		ring = append(ring, values)
+	}
+
+	if len(ring) < 3 {
+		return nil, ErrInvalidPolygon
+	}
+
+	// Close the ring if the caller didn't
+	first, last := ring[0], ring[len(ring)-1]
+	if first[0] != last[0] || first[1] != last[1] {
+		ring = append(ring, []float64{first[0], first[1]})
+	}
+	if len(ring) < 4 {
+		return nil, ErrInvalidPolygon
+	}
+	return ring, nil
+}
+
+func parseFloats(raw, sep string, n int) ([]float64, bool) {
+	parts := strings.Split(raw, sep)
+	if len(parts) != n {
+		return nil, false
+	}
+	values := make([]float64, n)
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, false
+		}
+		values[i] = v
+	}
+	return values, true
+}
+
+// geoWithinPolygon builds a $geoWithin clause on the coordinates field
+func geoWithinPolygon(ring [][]float64) map[string]interface{} {
+	return map[string]interface{}{
+		"coordinates": map[string]interface{}{
+			"$geoWithin": map[string]interface{}{
+				"$geometry": map[string]interface{}{
+					"type":        "Polygon",
+					"coordinates": [][][]float64{ring},
+				},
+			},
+		},
+	}
+}
+
+// geoWithinRadius builds a $geoWithin/$centerSphere clause on the coordinates field
+func geoWithinRadius(near entity.Coordinates, radiusKm float64) map[string]interface{} {
+	return map[string]interface{}{
+		"coordinates": map[string]interface{}{
+			"$geoWithin": map[string]interface{}{
+				"$centerSphere": []interface{}{
+					[]float64{near.Lng, near.Lat},
+					radiusKm / entity.EarthRadiusKm,
+				},
+			},
+		},
+	}
+}
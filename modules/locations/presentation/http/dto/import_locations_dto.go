@@ -0,0 +1,293 @@
+package dto
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+)
+
+const (
+	// MaxImportFileSize is the largest import file accepted (20MB)
+	MaxImportFileSize = 20 << 20
+	// MaxImportRows is the largest number of data rows accepted in one import
+	MaxImportRows = 10000
+	// importListSeparator separates values of list columns (tags, aliases) in CSV
+	importListSeparator = "|"
+)
+
+// LocationCSVColumns is the column order used for CSV export and accepted for CSV import.
+// Import matches headers case-insensitively and ignores unknown columns, so an export can be re-imported.
+var LocationCSVColumns = []string{
+	"id", "name", "type", "country", "state", "district", "pincode",
+	"lat", "lng", "parentId", "parentName", "parentType",
+	"tags", "aliases", "description",
+}
+
+var (
+	ErrUnsupportedImportFormat = errors.New("unsupported format, use csv or geojson")
+	ErrImportTooManyRows       = fmt.Errorf("import exceeds the limit of %d rows", MaxImportRows)
+	ErrImportEmpty             = errors.New("import file contains no rows")
+)
+
+// ImportLocationsQueryDto holds the query parameters of an import request
+type ImportLocationsQueryDto struct {
+	Format string `form:"format" json:"format" example:"csv"`
+	DryRun bool   `form:"dryRun" json:"dryRun"`
+}
+
+// ResolveFormat returns the import format from the explicit format parameter,
+// falling back to the file extension
+func (dto *ImportLocationsQueryDto) ResolveFormat(fileName string) (string, error) {
+	format := strings.ToLower(strings.TrimSpace(dto.Format))
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(fileName)) {
+		case ".csv":
+			format = entity.FormatCSV
+		case ".geojson", ".json":
+			format = entity.FormatGeoJSON
+		}
+	}
+	if format != entity.FormatCSV && format != entity.FormatGeoJSON {
+		return "", ErrUnsupportedImportFormat
+	}
+	return format, nil
+}
+
+// ParseLocationImport reads an import file into rows. File-level problems (bad header,
+// malformed JSON, too many rows) return an error; row-level problems are recorded on the row.
+func ParseLocationImport(format string, r io.Reader) ([]entity.LocationImportRow, error) {
+	var (
+		rows []entity.LocationImportRow
+		err  error
+	)
+	switch format {
+	case entity.FormatCSV:
+		rows, err = parseLocationCSV(r)
+	case entity.FormatGeoJSON:
+		rows, err = parseLocationGeoJSON(r)
+	default:
+		return nil, ErrUnsupportedImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrImportEmpty
+	}
+	return rows, nil
+}
+
+// importRecord is the flat representation shared by both formats before validation
+type importRecord struct {
+	dto        CreateLocationDto
+	parentName string
+	parentType string
+}
+
+func (rec importRecord) toRow(row int) entity.LocationImportRow {
+	result := entity.LocationImportRow{
+		Row:        row,
+		ParentName: strings.TrimSpace(rec.parentName),
+		ParentType: strings.TrimSpace(rec.parentType),
+	}
+	if err := rec.dto.Validate(); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if result.ParentName != "" && !entity.IsValidLocationType(result.ParentType) {
+		result.Error = "parentType must be a valid location type when parentName is given"
+		return result
+	}
+	loc := rec.dto.ToEntity()
+	if loc == nil {
+		result.Error = "tags, aliases and media URLs must not contain empty values"
+		return result
+	}
+	result.Location = loc
+	return result
+}
+
+func parseLocationCSV(r io.Reader) ([]entity.LocationImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, ErrImportEmpty
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+	for _, required := range []string{"name", "type"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header is missing required column %q", required)
+		}
+	}
+
+	var rows []entity.LocationImportRow
+	for rowNum := 1; ; rowNum++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if rowNum > MaxImportRows {
+			return nil, ErrImportTooManyRows
+		}
+		if err != nil {
+			rows = append(rows, entity.LocationImportRow{Row: rowNum, Error: err.Error()})
+			continue
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[strings.ToLower(name)]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		rec := importRecord{
+			dto: CreateLocationDto{
+				Name:        get("name"),
+				Type:        LocationType(get("type")),
+				Country:     get("country"),
+				State:       get("state"),
+				District:    get("district"),
+				Pincode:     get("pincode"),
+				ParentID:    get("parentId"),
+				Tags:        splitImportList(get("tags")),
+				Aliases:     splitImportList(get("aliases")),
+				Description: get("description"),
+			},
+			parentName: get("parentName"),
+			parentType: get("parentType"),
+		}
+
+		if lat, lng := get("lat"), get("lng"); lat != "" || lng != "" {
+			latVal, latErr := strconv.ParseFloat(lat, 64)
+			lngVal, lngErr := strconv.ParseFloat(lng, 64)
+			if latErr != nil || lngErr != nil {
+				rows = append(rows, entity.LocationImportRow{Row: rowNum, Error: entity.ErrInvalidCoordinates.Error()})
+				continue
+			}
+			rec.dto.Coordinates = CoordinatesDto{Lat: latVal, Lng: lngVal}
+		}
+
+		rows = append(rows, rec.toRow(rowNum))
+	}
+	return rows, nil
+}
+
+// geoJSONFeatureCollection is the subset of a GeoJSON FeatureCollection the importer reads
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   map[string]interface{} `json:"geometry"`
+	Properties json.RawMessage        `json:"properties"`
+}
+
+type geoJSONImportProperties struct {
+	CreateLocationDto
+	ParentName string      `json:"parentName"`
+	ParentType string      `json:"parentType"`
+	Boundary   interface{} `json:"boundary"`
+}
+
+func parseLocationGeoJSON(r io.Reader) ([]entity.LocationImportRow, error) {
+	var fc geoJSONFeatureCollection
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, fmt.Errorf("invalid GeoJSON: %w", err)
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, errors.New("GeoJSON import must be a FeatureCollection")
+	}
+	if len(fc.Features) > MaxImportRows {
+		return nil, ErrImportTooManyRows
+	}
+
+	rows := make([]entity.LocationImportRow, 0, len(fc.Features))
+	for i, feature := range fc.Features {
+		rowNum := i + 1
+
+		var props geoJSONImportProperties
+		if len(feature.Properties) > 0 && string(feature.Properties) != "null" {
+			if err := json.Unmarshal(feature.Properties, &props); err != nil {
+				rows = append(rows, entity.LocationImportRow{Row: rowNum, Error: "invalid properties: " + err.Error()})
+				continue
+			}
+		}
+		if props.Boundary != nil {
+			props.Geojson = props.Boundary
+		}
+
+		if feature.Geometry != nil {
+			switch feature.Geometry["type"] {
+			case "Point":
+				point, ok := pointFromGeometry(feature.Geometry)
+				if !ok {
+					rows = append(rows, entity.LocationImportRow{Row: rowNum, Error: entity.ErrInvalidCoordinates.Error()})
+					continue
+				}
+				props.Coordinates = point
+			case "Polygon", "MultiPolygon":
+				props.Geojson = feature.Geometry
+			default:
+				rows = append(rows, entity.LocationImportRow{Row: rowNum, Error: fmt.Sprintf("unsupported geometry type %v", feature.Geometry["type"])})
+				continue
+			}
+		}
+
+		rec := importRecord{dto: props.CreateLocationDto, parentName: props.ParentName, parentType: props.ParentType}
+		rows = append(rows, rec.toRow(rowNum))
+	}
+	return rows, nil
+}
+
+// pointFromGeometry reads a GeoJSON Point ([lng, lat]) into a CoordinatesDto
+func pointFromGeometry(geometry map[string]interface{}) (CoordinatesDto, bool) {
+	coords, ok := geometry["coordinates"].([]interface{})
+	if !ok || len(coords) < 2 {
+		return CoordinatesDto{}, false
+	}
+	lng, lngOk := coords[0].(float64)
+	lat, latOk := coords[1].(float64)
+	if !lngOk || !latOk {
+		return CoordinatesDto{}, false
+	}
+	return CoordinatesDto{Lat: lat, Lng: lng}, true
+}
+
+func splitImportList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, importListSeparator)
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// JoinExportList is the inverse of the CSV list parsing used on import
+func JoinExportList(values []string) string {
+	return strings.Join(values, importListSeparator)
+}
@@ -3,9 +3,48 @@ package dto
 import (
 	"strconv"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// LocationResponseFields are the members the fields parameter can select for locations
+var LocationResponseFields = fieldset.Of(entity.Location{})
+
+// LocationFilterFields are the fields locations can be filtered by with the filter parameter
+var LocationFilterFields = listfilter.Fields{
+	"name":       {Path: "name", Type: listfilter.String},
+	"type":       {Path: "type", Type: listfilter.String},
+	"parentId":   {Path: "parentId", Type: listfilter.ObjectID},
+	"ancestors":  {Path: "ancestors", Type: listfilter.ObjectID},
+	"depth":      {Path: "depth", Type: listfilter.Number},
+	"country":    {Path: "country", Type: listfilter.String},
+	"state":      {Path: "state", Type: listfilter.String},
+	"district":   {Path: "district", Type: listfilter.String},
+	"pincode":    {Path: "pincode", Type: listfilter.String},
+	"tags":       {Path: "tags", Type: listfilter.String},
+	"aliases":    {Path: "aliases", Type: listfilter.String},
+	"popularity": {Path: "popularity", Type: listfilter.Number},
+	"createdBy":  {Path: "createdBy", Type: listfilter.ObjectID},
+	"createdAt":  {Path: "createdAt", Type: listfilter.Date},
+	"updatedAt":  {Path: "updatedAt", Type: listfilter.Date},
+}
+
+// LocationSortFields are the fields locations can be sorted by
+var LocationSortFields = pagination.SortFields{
+	"name":       "name",
+	"type":       "type",
+	"country":    "country",
+	"state":      "state",
+	"depth":      "depth",
+	"popularity": "popularity",
+	"createdAt":  "createdAt",
+	"updatedAt":  "updatedAt",
+}
+
 // ListLocationsDto defines the query parameters for listing locations
 type ListLocationsDto struct {
 	Page       int      `form:"page" json:"page" default:"1"`
@@ -15,9 +54,23 @@ type ListLocationsDto struct {
 	Type       string  `form:"type" json:"type"`
 	Country    string  `form:"country" json:"country"`
 	State      string  `form:"state" json:"state"`
+	ParentID   string  `form:"parentId" json:"parentId"`
 	Tags       []string `form:"tags" json:"tags"`
 	Aliases    []string `form:"aliases" json:"aliases"`
 	IncludeDeleted bool   `form:"includeDeleted" json:"includeDeleted"`
+
+	// Geo filters
+	Near     string  `form:"near" json:"near"`         // "lat,lng"
+	RadiusKm float64 `form:"radiusKm" json:"radiusKm"` // requires near
+	BBox     string  `form:"bbox" json:"bbox"`         // "minLng,minLat,maxLng,maxLat"
+	Polygon  string  `form:"polygon" json:"polygon"`   // "lng,lat;lng,lat;..."
+	Sort     string  `form:"sort" json:"sort"`         // "distance" or sort fields
+
+	radiusRaw   string
+	cursor      bool
+	nearPoint   *entity.Coordinates
+	bboxRing    [][]float64
+	polygonRing [][]float64
 }
 
 func NewListLocationsDto(c *gin.Context) ListLocationsDto {
@@ -57,15 +110,84 @@ func NewListLocationsDto(c *gin.Context) ListLocationsDto {
 	if state := c.Query("state"); state != "" {
 		dto.State = state
 	}
+	if parentID := c.Query("parentId"); parentID != "" {
+		dto.ParentID = parentID
+	}
 	if tags := c.QueryArray("tags"); len(tags) > 0 {
 		dto.Tags = tags
 	}
 	if aliases := c.QueryArray("aliases"); len(aliases) > 0 {
 		dto.Aliases = aliases
 	}
+	dto.Near = c.Query("near")
+	dto.radiusRaw = c.Query("radiusKm")
+	dto.BBox = c.Query("bbox")
+	dto.Polygon = c.Query("polygon")
+	dto.Sort = c.Query("sort")
+	dto.cursor = c.Query("after") != "" || c.Query("before") != ""
 	return dto
 }
 
+// Validate parses and checks the geo query parameters
+func (dto *ListLocationsDto) Validate() error {
+	if dto.Near != "" {
+		near, err := parseNear(dto.Near)
+		if err != nil {
+			return err
+		}
+		dto.nearPoint = near
+	}
+
+	if dto.radiusRaw != "" {
+		radius, err := strconv.ParseFloat(dto.radiusRaw, 64)
+		if err != nil || radius <= 0 || dto.nearPoint == nil {
+			return ErrInvalidRadius
+		}
+		dto.RadiusKm = radius
+	}
+
+	if dto.BBox != "" {
+		ring, err := parseBBox(dto.BBox)
+		if err != nil {
+			return err
+		}
+		dto.bboxRing = ring
+	}
+
+	if dto.Polygon != "" {
+		ring, err := parsePolygon(dto.Polygon)
+		if err != nil {
+			return err
+		}
+		dto.polygonRing = ring
+	}
+
+	// Other sorts are checked against LocationSortFields when the list options are read
+	if dto.Sort == SortByDistance {
+		if dto.nearPoint == nil {
+			return ErrDistanceNoPoint
+		}
+		if dto.cursor {
+			return ErrInvalidSort
+		}
+	}
+
+	return nil
+}
+
+// SortsByDistance reports whether results should be ordered by distance from NearPoint
+func (dto *ListLocationsDto) SortsByDistance() bool {
+	return dto.Sort == SortByDistance && dto.nearPoint != nil
+}
+
+// NearPoint returns the parsed `near` point (valid after Validate)
+func (dto *ListLocationsDto) NearPoint() entity.Coordinates {
+	if dto.nearPoint == nil {
+		return entity.Coordinates{}
+	}
+	return *dto.nearPoint
+}
+
 // ToFilterMap converts the ListLocationsDto to a map for filtering in the repository
 func (dto *ListLocationsDto) ToFilterMap() map[string]interface{} {
 	filter := make(map[string]interface{})
@@ -85,6 +207,11 @@ func (dto *ListLocationsDto) ToFilterMap() map[string]interface{} {
 	if dto.State != "" {
 		filter["state"] = dto.State
 	}
+	if dto.ParentID != "" {
+		if parentID, err := primitive.ObjectIDFromHex(dto.ParentID); err == nil {
+			filter["parentId"] = parentID
+		}
+	}
 	if len(dto.Tags) > 0 {
 		filter["tags"] = map[string]interface{}{
 			"$in": dto.Tags,
@@ -99,5 +226,21 @@ func (dto *ListLocationsDto) ToFilterMap() map[string]interface{} {
 		filter["deletedAt"] = nil
 	}
 
+	// Several geo predicates target the same field, so they are combined with $and
+	var geoClauses []interface{}
+	if dto.nearPoint != nil && dto.RadiusKm > 0 && !dto.SortsByDistance() {
+		// When sorting by distance the radius is applied by $geoNear instead
+		geoClauses = append(geoClauses, geoWithinRadius(*dto.nearPoint, dto.RadiusKm))
+	}
+	if dto.bboxRing != nil {
+		geoClauses = append(geoClauses, geoWithinPolygon(dto.bboxRing))
+	}
+	if dto.polygonRing != nil {
+		geoClauses = append(geoClauses, geoWithinPolygon(dto.polygonRing))
+	}
+	if len(geoClauses) > 0 {
+		filter["$and"] = geoClauses
+	}
+
 	return filter
 }
@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+)
+
+// Maximum lengths of media metadata fields
+const (
+	maxMediaCaptionLength = 500
+	maxMediaAltTextLength = 300
+	maxMediaCreditLength  = 200
+	maxMediaLicenseLength = 200
+)
+
+// ReorderLocationMediaDto lists every media item ID of a location in the new display order
+type ReorderLocationMediaDto struct {
+	MediaIDs []string `json:"mediaIds" binding:"required" example:"6824886e6b180b753cea43e9,6824886e6b180b753cea43ea"`
+}
+
+// Validate performs validation on the ReorderLocationMediaDto
+func (dto *ReorderLocationMediaDto) Validate() error {
+	if len(dto.MediaIDs) == 0 {
+		return errors.New("mediaIds must not be empty")
+	}
+	for _, id := range dto.MediaIDs {
+		if !primitive.IsValidObjectID(id) {
+			return fmt.Errorf("invalid media ID %q", id)
+		}
+	}
+	return nil
+}
+
+// ObjectIDs returns the parsed media IDs; call Validate first
+func (dto *ReorderLocationMediaDto) ObjectIDs() []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, 0, len(dto.MediaIDs))
+	for _, id := range dto.MediaIDs {
+		if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+			ids = append(ids, oid)
+		}
+	}
+	return ids
+}
+
+// UpdateLocationMediaDto updates the metadata of a media item. Omitted fields are kept,
+// an empty string clears a field.
+type UpdateLocationMediaDto struct {
+	Caption *string `json:"caption,omitempty" example:"Sunrise over Tiger Hill"`
+	AltText *string `json:"altText,omitempty" example:"Snow-capped peaks lit orange at dawn"`
+	Credit  *string `json:"credit,omitempty" example:"Photo by A. Sharma"`
+	License *string `json:"license,omitempty" example:"CC BY 4.0"`
+}
+
+// Validate performs validation on the UpdateLocationMediaDto
+func (dto *UpdateLocationMediaDto) Validate() error {
+	if dto.Caption == nil && dto.AltText == nil && dto.Credit == nil && dto.License == nil {
+		return errors.New("at least one of caption, altText, credit or license is required")
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+		max   int
+	}{
+		{"caption", dto.Caption, maxMediaCaptionLength},
+		{"altText", dto.AltText, maxMediaAltTextLength},
+		{"credit", dto.Credit, maxMediaCreditLength},
+		{"license", dto.License, maxMediaLicenseLength},
+	} {
+		if field.value != nil && len(*field.value) > field.max {
+			return fmt.Errorf("%s must be at most %d characters", field.name, field.max)
+		}
+	}
+	return nil
+}
+
+// ToUpdate converts the DTO to a domain media update with trimmed values
+func (dto *UpdateLocationMediaDto) ToUpdate() entity.MediaItemUpdate {
+	trim := func(value *string) *string {
+		if value == nil {
+			return nil
+		}
+		trimmed := strings.TrimSpace(*value)
+		return &trimmed
+	}
+	return entity.MediaItemUpdate{
+		Caption: trim(dto.Caption),
+		AltText: trim(dto.AltText),
+		Credit:  trim(dto.Credit),
+		License: trim(dto.License),
+	}
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MoveLocationDto defines the new parent for a location.
+// A null or empty parentId moves the location to the root.
+type MoveLocationDto struct {
+	ParentID *string `json:"parentId" example:"6824886e6b180b753cea43e9"`
+}
+
+// Validate performs validation on the MoveLocationDto
+func (dto *MoveLocationDto) Validate() error {
+	if dto.ParentID != nil && *dto.ParentID != "" && !primitive.IsValidObjectID(*dto.ParentID) {
+		return errors.New("invalid parent location ID")
+	}
+	return nil
+}
+
+// ParentObjectID returns the parsed parent ID, or nil for a move to the root
+func (dto *MoveLocationDto) ParentObjectID() *primitive.ObjectID {
+	if dto.ParentID == nil || *dto.ParentID == "" {
+		return nil
+	}
+	parentID, err := primitive.ObjectIDFromHex(*dto.ParentID)
+	if err != nil {
+		return nil
+	}
+	return &parentID
+}
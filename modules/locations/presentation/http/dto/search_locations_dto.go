@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+)
+
+var ErrSearchQueryTooShort = errors.New("q must be at least 2 characters")
+
+// SearchLocationsDto defines the query parameters for search and autocomplete
+type SearchLocationsDto struct {
+	Q     string `form:"q" json:"q"`
+	Type  string `form:"type" json:"type"`
+	State string `form:"state" json:"state"`
+	Limit int    `form:"limit" json:"limit" default:"10"`
+}
+
+func NewSearchLocationsDto(c *gin.Context) SearchLocationsDto {
+	dto := SearchLocationsDto{
+		Q:     strings.TrimSpace(c.Query("q")),
+		Type:  c.Query("type"),
+		State: c.Query("state"),
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 {
+		limit = 10
+	}
+	// Cap the maximum limit to prevent performance issues
+	if limit > 50 {
+		limit = 50
+	}
+	dto.Limit = limit
+	return dto
+}
+
+// Validate performs validation on the SearchLocationsDto
+func (dto *SearchLocationsDto) Validate() error {
+	if utf8.RuneCountInString(dto.Q) < 2 {
+		return ErrSearchQueryTooShort
+	}
+	return nil
+}
+
+// ToFilterMap converts the optional narrowing filters to a repository filter
+func (dto *SearchLocationsDto) ToFilterMap() map[string]interface{} {
+	filter := make(map[string]interface{})
+	if dto.Type != "" {
+		filter["type"] = dto.Type
+	}
+	if dto.State != "" {
+		filter["state"] = dto.State
+	}
+	return filter
+}
@@ -18,9 +18,9 @@ type UpdateLocationDto struct {
 	Pincode     *string       `json:"pincode,omitempty"`
 	Coordinates *CoordinatesDto `json:"coordinates,omitempty"`
 	Geojson     interface{}   `json:"geojson,omitempty"`
-	Tags        []string      `json:"tags,omitempty"`
+	Tags        []string      `json:"tags"`
 	Description *string       `json:"description,omitempty"`
-	Aliases     []string      `json:"aliases,omitempty"`
+	Aliases     []string      `json:"aliases"`
 	MediaUrls   *MediaUrlsDto `json:"mediaUrls,omitempty"`
 }
 
@@ -41,9 +41,44 @@ func (dto *UpdateLocationDto) Validate() error {
 	if dto.Pincode != nil && *dto.Pincode == "" {
 		return errors.New("location pincode cannot be empty")
 	}
+	if dto.Type != nil && !entity.IsValidLocationType(string(*dto.Type)) {
+		return errors.New("invalid location type")
+	}
+	if dto.Coordinates != nil && !(entity.Coordinates{Lat: dto.Coordinates.Lat, Lng: dto.Coordinates.Lng}).IsValid() {
+		return entity.ErrInvalidCoordinates
+	}
+	if dto.Geojson != nil {
+		if err := entity.ValidateGeoJSONBoundary(dto.Geojson); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// NewUpdateLocationDto returns every updatable field of a location. Partial updates are
+// applied to this document, then only the changed fields are applied back.
+func NewUpdateLocationDto(loc *entity.Location) *UpdateLocationDto {
+	current := *loc
+	locationType := LocationType(current.Type)
+	return &UpdateLocationDto{
+		Name:        &current.Name,
+		Type:        &locationType,
+		Country:     &current.Country,
+		State:       &current.State,
+		District:    &current.District,
+		Pincode:     &current.Pincode,
+		Coordinates: &CoordinatesDto{Lat: current.Coordinates.Lat, Lng: current.Coordinates.Lng},
+		Geojson:     current.GeoJSON,
+		Tags:        append([]string{}, current.Tags...),
+		Description: &current.Description,
+		Aliases:     append([]string{}, current.Aliases...),
+		MediaUrls: &MediaUrlsDto{
+			Photos: append([]string{}, current.MediaURLs.Photos...),
+			Videos: append([]string{}, current.MediaURLs.Videos...),
+		},
+	}
+}
+
 // ApplyUpdates applies the update DTO to an existing location entity
 func (dto *UpdateLocationDto) ApplyUpdates(loc *entity.Location) {
 	if dto.Name != nil {
@@ -83,10 +118,8 @@ func (dto *UpdateLocationDto) ApplyUpdates(loc *entity.Location) {
 		loc.Aliases = dto.Aliases
 	}
 	if dto.MediaUrls != nil {
-		loc.MediaURLs = entity.MediaURLs{
-			Photos: dto.MediaUrls.Photos,
-			Videos: dto.MediaUrls.Videos,
-		}
+		// Items whose URL is still listed keep their metadata
+		loc.SetMediaURLs(dto.MediaUrls.Photos, dto.MediaUrls.Videos)
 	}
 }
 
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
+)
+
+// BulkOps returns the operations of the locations bulk endpoint. They are held to the
+// ownership rule of the single location routes, WithOwnership("organizationId", "id"):
+// callers other than platform admins may only change the record whose ID is their
+// organization's; other IDs fail with a forbidden result.
+func (h *LocationHandler) BulkOps(c *gin.Context) bulk.Ops {
+	ops := bulk.Ops{
+		"update":     {Action: "update", Prepare: h.bulkUpdate},
+		"addTags":    {Action: "update", Prepare: h.bulkTags((*dto.BulkLocationTagsDto).AddTo)},
+		"removeTags": {Action: "update", Prepare: h.bulkTags((*dto.BulkLocationTagsDto).RemoveFrom)},
+		"delete":     {Action: "delete", Prepare: h.bulkDelete},
+		"restore":    {Action: "restore", Prepare: h.bulkRestore},
+	}
+
+	authCtx := middleware.GetAuthContext(c.Request.Context())
+	if authCtx != nil && authCtx.Role == "PLATFORM_ADMIN" {
+		return ops
+	}
+	return ops.Restrict(func(ctx context.Context, id primitive.ObjectID) error {
+		if authCtx == nil || authCtx.OrganizationID == nil || id != *authCtx.OrganizationID {
+			return middleware.NewAppError(middleware.ErrorCodeForbidden, "Access denied to this location", nil, http.StatusForbidden)
+		}
+		return nil
+	})
+}
+
+// bulkUpdate applies data to each location as a JSON Merge Patch, like PatchLocation
+func (h *LocationHandler) bulkUpdate(data json.RawMessage) (bulk.Apply, error) {
+	var fields map[string]json.RawMessage
+	if err := bulk.DecodeData(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("data must change at least one field")
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		location, err := h.bulkLocation(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var changes dto.UpdateLocationDto
+		if err := patch.ApplyTo(patch.ContentTypeMergePatch, dto.NewUpdateLocationDto(location), data, &changes); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest)
+		}
+		if err := changes.Validate(); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+		}
+		if changes.MediaUrls != nil {
+			changes.MediaUrls.MapURLs(h.fileKey)
+		}
+
+		original := *location
+		changes.ApplyUpdates(location)
+		return h.bulkPatch(ctx, &original, location)
+	}, nil
+}
+
+// bulkTags edits the tags of each location with edit
+func (h *LocationHandler) bulkTags(edit func(*dto.BulkLocationTagsDto, []string) []string) func(json.RawMessage) (bulk.Apply, error) {
+	return func(data json.RawMessage) (bulk.Apply, error) {
+		var tags dto.BulkLocationTagsDto
+		if err := bulk.DecodeData(data, &tags); err != nil {
+			return nil, err
+		}
+		if err := tags.Validate(); err != nil {
+			return nil, err
+		}
+
+		return func(ctx context.Context, id primitive.ObjectID) error {
+			location, err := h.bulkLocation(ctx, id)
+			if err != nil {
+				return err
+			}
+			original := *location
+			location.Tags = edit(&tags, location.Tags)
+			return h.bulkPatch(ctx, &original, location)
+		}, nil
+	}
+}
+
+func (h *LocationHandler) bulkDelete(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		deleted, err := h.DeleteLocationUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to delete location", err, http.StatusInternalServerError)
+		}
+		if !deleted {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Location not found or already deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+func (h *LocationHandler) bulkRestore(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		restored, err := h.RestoreLocationUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to restore location", err, http.StatusInternalServerError)
+		}
+		if !restored {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Location not found or not deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+// bulkLocation returns the active location with id, or a not found error
+func (h *LocationHandler) bulkLocation(ctx context.Context, id primitive.ObjectID) (*entity.Location, error) {
+	location, err := h.GetLocationUseCase.Execute(ctx, id)
+	if err != nil {
+		return nil, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch location", err, http.StatusInternalServerError)
+	}
+	if location == nil || location.IsDeleted() {
+		return nil, middleware.NewAppError(middleware.ErrorCodeNotFound, "Location not found", nil, http.StatusNotFound)
+	}
+	return location, nil
+}
+
+func (h *LocationHandler) bulkPatch(ctx context.Context, original, updated *entity.Location) error {
+	if err := h.PatchLocationUseCase.Execute(ctx, original, updated); err != nil {
+		if appErr := hierarchyError(err); appErr != nil {
+			return appErr
+		}
+		return middleware.NewVersionConflictAppError(err, "Failed to update location")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
 	"github.com/gin-gonic/gin"
@@ -17,7 +18,10 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			fields	query		string	false	"Comma separated members to return, the ID is always included"	example(name,type)
+//	@Param			If-None-Match	header		string	false	"ETag from an earlier read, answered with 304 if the location is unchanged"
 //	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Header			200	{string}	ETag	"Version of the location, send it as If-Match when updating"
 //	@Failure		400	{object}	models.SwaggerErrorResponse
 //	@Failure		404	{object}	models.SwaggerErrorResponse
 //	@Failure		500	{object}	models.SwaggerErrorResponse
@@ -25,6 +29,12 @@ import (
 func (h *LocationHandler) GetLocation(c *gin.Context) {
 	id := c.Param("id")
 
+	fields, err := fieldset.FromQuery(c, dto.LocationResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		middleware.HandleError(c, middleware.NewAppError(
@@ -57,7 +67,22 @@ func (h *LocationHandler) GetLocation(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, location)
+	if middleware.NotModified(c, location.Version) {
+		return
+	}
+
+	h.resolveFileURLs(location)
+	response, err := fieldset.Select(location, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch location",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // CreateLocation godoc
@@ -97,11 +122,16 @@ func (h *LocationHandler) CreateLocation(c *gin.Context) {
 		return
 	}
 
-	// Convert to entity
+	// Convert to entity, storing media URLs of this storage by key
+	createDto.MediaURLs.MapURLs(h.fileKey)
 	location := createDto.ToEntity()
 
 	// Call use case to create
 	if err := h.CreateLocationUseCase.Execute(c.Request.Context(), location); err != nil {
+		if appErr := hierarchyError(err); appErr != nil {
+			middleware.HandleError(c, appErr)
+			return
+		}
 		middleware.HandleError(c, middleware.NewAppError(
 			middleware.ErrorCodeInternalServer,
 			"Failed to create location",
@@ -111,6 +141,7 @@ func (h *LocationHandler) CreateLocation(c *gin.Context) {
 		return
 	}
 
+	h.resolveFileURLs(location)
 	c.JSON(http.StatusCreated, location)
 }
 
@@ -123,9 +154,13 @@ func (h *LocationHandler) CreateLocation(c *gin.Context) {
 //	@Produce		json
 //	@Param			id			path		string					true	"Location ID"
 //	@Param			location	body		dto.UpdateLocationDto	true	"Location data to update"
+//	@Param			If-Match	header		string	true	"ETag of the location being updated"
 //	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Header			200	{string}	ETag	"New version of the location"
 //	@Failure		400			{object}	models.SwaggerErrorResponse
 //	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The location was changed by another request"
+//	@Failure		428	{object}	models.SwaggerErrorResponse	"If-Match header missing"
 //	@Failure		500			{object}	models.SwaggerErrorResponse
 //	@Router			/locations/{id} [put]
 func (h *LocationHandler) UpdateLocation(c *gin.Context) {
@@ -164,6 +199,12 @@ func (h *LocationHandler) UpdateLocation(c *gin.Context) {
 		return
 	}
 
+	// Refuse to overwrite changes the client has not seen
+	if appErr := middleware.CheckIfMatch(c, existingLoc.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
 	// Parse update DTO
 	var updateDto dto.UpdateLocationDto
 	if err := c.ShouldBindJSON(&updateDto); err != nil {
@@ -187,20 +228,25 @@ func (h *LocationHandler) UpdateLocation(c *gin.Context) {
 		return
 	}
 
-	// Apply updates to the existing location
+	// Apply updates to the existing location. Media URLs of this storage, such as ones
+	// returned by a previous read, are stored by key so existing items are matched.
+	if updateDto.MediaUrls != nil {
+		updateDto.MediaUrls.MapURLs(h.fileKey)
+	}
 	updateDto.ApplyUpdates(existingLoc)
 
 	// Call use case to update
 	if err := h.UpdateLocationUseCase.Execute(c.Request.Context(), existingLoc); err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to update location",
-			err,
-			http.StatusInternalServerError,
-		))
+		if appErr := hierarchyError(err); appErr != nil {
+			middleware.HandleError(c, appErr)
+			return
+		}
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update location"))
 		return
 	}
 
+	middleware.SetETag(c, existingLoc.Version)
+	h.resolveFileURLs(existingLoc)
 	c.JSON(http.StatusOK, existingLoc)
 }
 
@@ -351,7 +397,7 @@ func (h *LocationHandler) RestoreLocation(c *gin.Context) {
 // HardDeleteLocation godoc
 //
 //	@Summary		Permanently delete a location
-//	@Description	Permanently delete a location by ID (removes it from the database)
+//	@Description	Permanently delete a location by ID (removes it from the database), together with every location below it
 //	@Tags			locations
 //	@Accept			json
 //	@Produce		json
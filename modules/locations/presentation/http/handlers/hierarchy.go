@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
+)
+
+// GetLocationChildren godoc
+//
+//	@Summary		List child locations
+//	@Description	Get the direct children of a location with pagination
+//	@Tags			locations
+//	@Produce		json
+//	@Param			id		path		string	true	"Location ID"		example("6824886e6b180b753cea43e9")
+//	@Param			page	query		int		false	"Page number"		default(1)
+//	@Param			limit	query		int		false	"Items per page"	default(10)	maximum(100)
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=dto.PaginatedLocationsResponse}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/children [get]
+func (h *LocationHandler) GetLocationChildren(c *gin.Context) {
+	location, ok := h.findLocation(c)
+	if !ok {
+		return
+	}
+
+	queryDto := dto.NewListLocationsDto(c)
+
+	children, total, err := h.GetLocationChildrenUseCase.Execute(c.Request.Context(), location.ID, queryDto.Page, queryDto.Limit)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to list child locations", err, http.StatusInternalServerError))
+		return
+	}
+
+	h.resolveFileURLs(children...)
+	c.JSON(http.StatusOK, gin.H{
+		"items":          children,
+		"page":           queryDto.Page,
+		"limit":          queryDto.Limit,
+		"total":          total,
+		"totalPages":     (total + int64(queryDto.Limit) - 1) / int64(queryDto.Limit),
+		"includeDeleted": false,
+	})
+}
+
+// GetLocationAncestors godoc
+//
+//	@Summary		List location ancestors
+//	@Description	Get the ancestors of a location ordered from the root down to the direct parent
+//	@Tags			locations
+//	@Produce		json
+//	@Param			id	path		string	true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Success		200	{object}	models.SwaggerStandardResponse{data=[]entity.Location}
+//	@Failure		400	{object}	models.SwaggerErrorResponse
+//	@Failure		404	{object}	models.SwaggerErrorResponse
+//	@Failure		500	{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/ancestors [get]
+func (h *LocationHandler) GetLocationAncestors(c *gin.Context) {
+	location, ok := h.findLocation(c)
+	if !ok {
+		return
+	}
+
+	ancestors, err := h.GetLocationAncestorsUseCase.Execute(c.Request.Context(), location)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch location ancestors", err, http.StatusInternalServerError))
+		return
+	}
+
+	h.resolveFileURLs(ancestors...)
+	c.JSON(http.StatusOK, ancestors)
+}
+
+// MoveLocation godoc
+//
+//	@Summary		Move a location
+//	@Description	Re-parent a location and its whole subtree; a null parentId moves it to the root
+//	@Tags			locations
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			If-Match	header	string				true	"ETag of the location being moved"
+//	@Param			body	body		dto.MoveLocationDto	true	"New parent"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Header			200		{string}	ETag	"Version of the moved location"
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		412		{object}	models.SwaggerErrorResponse
+//	@Failure		428		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/parent [put]
+func (h *LocationHandler) MoveLocation(c *gin.Context) {
+	var moveDto dto.MoveLocationDto
+	if err := c.ShouldBindJSON(&moveDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	if err := moveDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	location, ok := h.findLocation(c)
+	if !ok {
+		return
+	}
+	if appErr := middleware.CheckIfMatch(c, location.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	moved, err := h.MoveLocationUseCase.Execute(c.Request.Context(), location.ID, moveDto.ParentObjectID())
+	if err != nil {
+		if appErr := hierarchyError(err); appErr != nil {
+			middleware.HandleError(c, appErr)
+			return
+		}
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to move location"))
+		return
+	}
+
+	h.resolveFileURLs(moved)
+	middleware.SetETag(c, moved.Version)
+	c.JSON(http.StatusOK, moved)
+}
+
+// findLocation parses the :id param and loads the non-deleted location
+func (h *LocationHandler) findLocation(c *gin.Context) (*entity.Location, bool) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid location ID",
+			err,
+			http.StatusBadRequest,
+		))
+		return nil, false
+	}
+
+	location, err := h.GetLocationUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch location",
+			err,
+			http.StatusInternalServerError,
+		))
+		return nil, false
+	}
+
+	if location == nil || location.IsDeleted() {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"Location not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return nil, false
+	}
+
+	return location, true
+}
+
+// hierarchyError maps hierarchy validation errors to a 400 response, or returns nil
+func hierarchyError(err error) *middleware.AppError {
+	switch {
+	case errors.Is(err, entity.ErrParentNotFound),
+		errors.Is(err, entity.ErrInvalidParentType),
+		errors.Is(err, entity.ErrCircularHierarchy),
+		errors.Is(err, entity.ErrChildTypeConflict):
+		return middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
+)
+
+// exportFlushEvery controls how many locations are written between flushes
+const exportFlushEvery = 200
+
+// ImportLocations godoc
+//
+//	@Summary		Import locations
+//	@Description	Upload a CSV or GeoJSON FeatureCollection to create or update locations, matched on (name, type). Runs as a background job; poll the returned job for progress and per-row errors. Parents must appear before their children.
+//	@Tags			locations
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file	true	"CSV or GeoJSON file"
+//	@Param			format	query		string	false	"File format, inferred from the extension when omitted"	Enums(csv, geojson)
+//	@Param			dryRun	query		bool	false	"Validate and report without writing"	default(false)
+//	@Success		202		{object}	models.SwaggerStandardResponse{data=entity.LocationImportJob}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		403		{object}	models.SwaggerErrorResponse	"Bulk import is not enabled for the organization"
+//	@Failure		413		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/import [post]
+func (h *LocationHandler) ImportLocations(c *gin.Context) {
+	var queryDto dto.ImportLocationsQueryDto
+	if err := c.ShouldBindQuery(&queryDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid query parameters", err, http.StatusBadRequest))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "No import file provided", err, http.StatusBadRequest))
+		return
+	}
+	if fileHeader.Size > dto.MaxImportFileSize {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, fmt.Sprintf("Import file exceeds %dMB", dto.MaxImportFileSize>>20), nil, http.StatusRequestEntityTooLarge))
+		return
+	}
+
+	format, err := queryDto.ResolveFormat(fileHeader.Filename)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Failed to read import file", err, http.StatusBadRequest))
+		return
+	}
+	defer file.Close()
+
+	rows, err := dto.ParseLocationImport(format, io.LimitReader(file, dto.MaxImportFileSize))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	job := &entity.LocationImportJob{
+		Format:   format,
+		FileName: fileHeader.Filename,
+		DryRun:   queryDto.DryRun,
+	}
+	if authCtx := middleware.GetAuthContext(c.Request.Context()); authCtx != nil {
+		job.CreatedBy = authCtx.UserID.Hex()
+	}
+
+	job, err = h.ImportLocationsUseCase.Execute(c.Request.Context(), job, rows)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to start location import", err, http.StatusInternalServerError))
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetLocationImportJob godoc
+//
+//	@Summary		Get location import job
+//	@Description	Get the status, counters and row errors of a location import
+//	@Tags			locations
+//	@Produce		json
+//	@Param			jobId	path		string	true	"Import job ID"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.LocationImportJob}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/import/{jobId} [get]
+func (h *LocationHandler) GetLocationImportJob(c *gin.Context) {
+	jobID, err := primitive.ObjectIDFromHex(c.Param("jobId"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid import job ID", err, http.StatusBadRequest))
+		return
+	}
+
+	job, err := h.GetLocationImportJobUseCase.Execute(c.Request.Context(), jobID)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch import job", err, http.StatusInternalServerError))
+		return
+	}
+	if job == nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "Import job not found", nil, http.StatusNotFound))
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
+
+// ExportLocations godoc
+//
+//	@Summary		Export locations
+//	@Description	Stream all locations matching the list filters as CSV or a GeoJSON FeatureCollection. The CSV can be re-imported as is.
+//	@Tags			locations
+//	@Produce		text/csv
+//	@Produce		application/geo+json
+//	@Param			format			query	string		false	"Export format"	Enums(csv, geojson)	default(csv)
+//	@Param			name			query	string		false	"Filter by name (partial match, case-insensitive)"
+//	@Param			type			query	string		false	"Filter by location type"
+//	@Param			state			query	string		false	"Filter by state"
+//	@Param			country			query	string		false	"Filter by country"
+//	@Param			parentId		query	string		false	"Filter by parent location ID"
+//	@Param			tags			query	[]string	false	"Filter by tags"
+//	@Param			includeDeleted	query	bool		false	"Include soft-deleted locations"	default(false)
+//	@Param			bbox			query	string		false	"Bounding box as minLng,minLat,maxLng,maxLat"
+//	@Param			polygon			query	string		false	"Polygon as lng,lat pairs separated by ';'"
+//	@Success		200
+//	@Failure		400	{object}	models.SwaggerErrorResponse
+//	@Failure		403	{object}	models.SwaggerErrorResponse	"Data export is not enabled for the organization"
+//	@Router			/locations/export [get]
+func (h *LocationHandler) ExportLocations(c *gin.Context) {
+	format := c.DefaultQuery("format", entity.FormatCSV)
+	if format != entity.FormatCSV && format != entity.FormatGeoJSON {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, dto.ErrUnsupportedImportFormat.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	queryDto := dto.NewListLocationsDto(c)
+	if err := queryDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	// From here on the body goes straight to the client, errors can only abort the stream
+	middleware.EnableStreaming(c)
+	fileName := fmt.Sprintf("locations-%s.%s", time.Now().Format("20060102-150405"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fileName))
+
+	var err error
+	if format == entity.FormatGeoJSON {
+		c.Header("Content-Type", "application/geo+json")
+		c.Status(http.StatusOK)
+		err = h.exportGeoJSON(c, queryDto.ToFilterMap())
+	} else {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Status(http.StatusOK)
+		err = h.exportCSV(c, queryDto.ToFilterMap())
+	}
+	if err != nil {
+		// Headers are already sent, so the client only sees a truncated file
+		logger.Log.Error("Location export aborted", zap.String("format", format), zap.Error(err))
+		c.Abort()
+	}
+}
+
+func (h *LocationHandler) exportCSV(c *gin.Context, filter map[string]interface{}) error {
+	w := csv.NewWriter(c.Writer)
+	if err := w.Write(dto.LocationCSVColumns); err != nil {
+		return err
+	}
+
+	count := 0
+	err := h.ExportLocationsUseCase.Execute(c.Request.Context(), filter, func(loc *entity.Location) error {
+		record := []string{
+			loc.ID.Hex(), loc.Name, loc.Type, loc.Country, loc.State, loc.District, loc.Pincode,
+			"", "", "", "", "",
+			dto.JoinExportList(loc.Tags), dto.JoinExportList(loc.Aliases), loc.Description,
+		}
+		if loc.Coordinates.IsSet() {
+			record[7] = strconv.FormatFloat(loc.Coordinates.Lat, 'f', -1, 64)
+			record[8] = strconv.FormatFloat(loc.Coordinates.Lng, 'f', -1, 64)
+		}
+		if loc.ParentID != nil {
+			record[9] = loc.ParentID.Hex()
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+
+		count++
+		if count%exportFlushEvery == 0 {
+			w.Flush()
+			c.Writer.Flush()
+		}
+		return w.Error()
+	})
+	w.Flush()
+	c.Writer.Flush()
+	if err != nil {
+		return err
+	}
+	return w.Error()
+}
+
+func (h *LocationHandler) exportGeoJSON(c *gin.Context, filter map[string]interface{}) error {
+	if _, err := io.WriteString(c.Writer, `{"type":"FeatureCollection","features":[`); err != nil {
+		return err
+	}
+
+	count := 0
+	err := h.ExportLocationsUseCase.Execute(c.Request.Context(), filter, func(loc *entity.Location) error {
+		properties := gin.H{
+			"id":          loc.ID.Hex(),
+			"name":        loc.Name,
+			"type":        loc.Type,
+			"country":     loc.Country,
+			"state":       loc.State,
+			"district":    loc.District,
+			"pincode":     loc.Pincode,
+			"tags":        loc.Tags,
+			"aliases":     loc.Aliases,
+			"description": loc.Description,
+		}
+		if loc.ParentID != nil {
+			properties["parentId"] = loc.ParentID.Hex()
+		}
+
+		// The point is the feature geometry; a boundary is kept in the properties so both survive a round trip
+		var geometry interface{}
+		if loc.Coordinates.IsSet() {
+			geometry = gin.H{"type": "Point", "coordinates": []float64{loc.Coordinates.Lng, loc.Coordinates.Lat}}
+			if loc.GeoJSON != nil {
+				properties["boundary"] = loc.GeoJSON
+			}
+		} else if loc.GeoJSON != nil {
+			geometry = loc.GeoJSON
+		}
+
+		feature, err := json.Marshal(gin.H{"type": "Feature", "geometry": geometry, "properties": properties})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if _, err := io.WriteString(c.Writer, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := c.Writer.Write(feature); err != nil {
+			return err
+		}
+
+		count++
+		if count%exportFlushEvery == 0 {
+			c.Writer.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(c.Writer, "]}")
+	c.Writer.Flush()
+	return err
+}
@@ -5,7 +5,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
 )
 
@@ -18,6 +23,12 @@ import (
 //	@Produce		json
 //	@Param			page			query		int																		false	"Page number"		default(1)
 //	@Param			limit			query		int																		false	"Items per page"	default(10)	maximum(100)
+//	@Param			sort			query		string																	false	"Comma separated sort fields, prefixed with - for descending, or distance"	example(-createdAt)
+//	@Param			after			query		string																	false	"Cursor from nextCursor, returns the page after it"
+//	@Param			before			query		string																	false	"Cursor from prevCursor, returns the page before it"
+//	@Param			count			query		string																	false	"How total is computed, exact by default with page numbers and none with cursors"	Enums(exact, estimated, none)
+//	@Param			filter			query		string																	false	"Filter expression, clauses field:operator:value separated by ;"	example(type:in:city,village)
+//	@Param			fields			query		string																	false	"Comma separated members to return, the ID is always included"	example(name,type)
 //	@Param			name			query		string																	false	"Filter by name (partial match, case-insensitive)"
 //	@Param			type			query		string																	false	"Filter by organization type (exact match)"
 //	@Param			state			query		string																	false	"Filter by state (partial match, case-insensitive)"
@@ -25,6 +36,11 @@ import (
 //	@Param			tags			query		[]string																false	"Filter by tags (exact match, any)"
 //	@Param			aliases			query		[]string																false	"Filter by aliases (exact match, any)"
 //	@Param			includeDeleted	query		bool																	false	"Include soft-deleted locations"	default(false)
+//	@Param			parentId		query		string																	false	"Filter by parent location ID"
+//	@Param			near			query		string																	false	"Reference point as lat,lng"	example(26.7270,88.3950)
+//	@Param			radiusKm		query		number																	false	"Only locations within this many km of near"
+//	@Param			bbox			query		string																	false	"Bounding box as minLng,minLat,maxLng,maxLat"
+//	@Param			polygon			query		string																	false	"Polygon as lng,lat pairs separated by ';'"
 //	@Success		200				{object}	models.SwaggerStandardResponse{data=dto.PaginatedLocationsResponse}	"Successful response with paginated locations"
 //	@Failure		400				{object}	models.SwaggerErrorResponse
 //	@Failure		500				{object}	models.SwaggerErrorResponse
@@ -32,18 +48,58 @@ import (
 func (h *LocationHandler) ListLocations(c *gin.Context) {
 	queryDto := dto.NewListLocationsDto(c)
 
-	locations, total, err := h.ListLocationsUseCase.Execute(c.Request.Context(), queryDto.ToFilterMap(), queryDto.Page, queryDto.Limit)
+	if err := queryDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	fields, err := fieldset.FromQuery(c, dto.LocationResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to list locations"))
+		return
+	}
+
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.LocationFilterFields); err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to list locations"))
+		return
+	}
+
+	var (
+		locations []*entity.Location
+		pageInfo  *models.PageInfo
+	)
+	if queryDto.SortsByDistance() {
+		// $geoNear orders by a computed distance, so these pages are only selected by number
+		var total int64
+		locations, total, err = h.ListLocationsByDistanceUseCase.Execute(c.Request.Context(), filter, queryDto.NearPoint(), queryDto.RadiusKm, queryDto.Page, queryDto.Limit)
+		pageInfo = &models.PageInfo{
+			Page:    queryDto.Page,
+			Limit:   queryDto.Limit,
+			Total:   &total,
+			HasNext: int64(queryDto.Page*queryDto.Limit) < total,
+			HasPrev: queryDto.Page > 1,
+		}
+	} else {
+		listOpts, optsErr := pagination.FromQuery(c, queryDto.Page, queryDto.Limit, dto.LocationSortFields)
+		if optsErr != nil {
+			middleware.HandleError(c, middleware.NewListAppError(optsErr, "Failed to list locations"))
+			return
+		}
+		locations, pageInfo, err = h.ListLocationsUseCase.Execute(c.Request.Context(), filter, listOpts)
+	}
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to list locations", err, http.StatusInternalServerError))
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to list locations"))
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"items":          locations,
-		"page":           queryDto.Page,
-		"limit":          queryDto.Limit,
-		"total":          total,
-		"totalPages":     (total + int64(queryDto.Limit) - 1) / int64(queryDto.Limit),
-		"includeDeleted": queryDto.IncludeDeleted,
-	})
+	h.resolveFileURLs(locations...)
+	items, err := fieldset.SelectAll(locations, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to list locations"))
+		return
+	}
+	response := pagination.Response(items, pageInfo)
+	response["includeDeleted"] = queryDto.IncludeDeleted
+	c.JSON(http.StatusOK, response)
 }
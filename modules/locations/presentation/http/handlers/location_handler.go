@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/usecases"
 )
 
@@ -17,7 +18,24 @@ type LocationHandler struct {
 	RestoreLocationUseCase       *usecases.RestoreLocationUseCase
 	BulkRestoreLocationsUseCase  *usecases.BulkRestoreLocationsUseCase
 	HardDeleteLocationUseCase    *usecases.HardDeleteLocationUseCase
+	GetLocationChildrenUseCase   *usecases.GetLocationChildrenUseCase
+	GetLocationAncestorsUseCase  *usecases.GetLocationAncestorsUseCase
+	MoveLocationUseCase          *usecases.MoveLocationUseCase
+	ListLocationsByDistanceUseCase *usecases.ListLocationsByDistanceUseCase
+	SearchLocationsUseCase       *usecases.SearchLocationsUseCase
+	AutocompleteLocationsUseCase *usecases.AutocompleteLocationsUseCase
+	RecordLocationViewUseCase    *usecases.RecordLocationViewUseCase
+	ImportLocationsUseCase       *usecases.ImportLocationsUseCase
+	GetLocationImportJobUseCase  *usecases.GetLocationImportJobUseCase
+	ExportLocationsUseCase       *usecases.ExportLocationsUseCase
+	ReorderLocationMediaUseCase  *usecases.ReorderLocationMediaUseCase
+	UpdateLocationMediaUseCase   *usecases.UpdateLocationMediaUseCase
+	SetLocationCoverMediaUseCase *usecases.SetLocationCoverMediaUseCase
+	DeleteLocationMediaUseCase   *usecases.DeleteLocationMediaUseCase
 	fileService                    services.FileService
+	imagePipeline                  *services.ImagePipeline
+	uploadScanner                  *services.UploadScanPipeline
+	PatchLocationUseCase           *usecases.PatchLocationUseCase
 }
 
 // NewLocationHandler creates a new LocationHandler
@@ -33,6 +51,23 @@ func NewLocationHandler(
 	restoreUC *usecases.RestoreLocationUseCase,
 	bulkRestoreUC *usecases.BulkRestoreLocationsUseCase,
 	hardDeleteUC *usecases.HardDeleteLocationUseCase,
+	childrenUC *usecases.GetLocationChildrenUseCase,
+	ancestorsUC *usecases.GetLocationAncestorsUseCase,
+	moveUC *usecases.MoveLocationUseCase,
+	byDistanceUC *usecases.ListLocationsByDistanceUseCase,
+	searchUC *usecases.SearchLocationsUseCase,
+	autocompleteUC *usecases.AutocompleteLocationsUseCase,
+	recordViewUC *usecases.RecordLocationViewUseCase,
+	importUC *usecases.ImportLocationsUseCase,
+	importJobUC *usecases.GetLocationImportJobUseCase,
+	exportUC *usecases.ExportLocationsUseCase,
+	imagePipeline *services.ImagePipeline,
+	reorderMediaUC *usecases.ReorderLocationMediaUseCase,
+	updateMediaUC *usecases.UpdateLocationMediaUseCase,
+	setCoverMediaUC *usecases.SetLocationCoverMediaUseCase,
+	deleteMediaUC *usecases.DeleteLocationMediaUseCase,
+	uploadScanner *services.UploadScanPipeline,
+	patchUC *usecases.PatchLocationUseCase,
 ) *LocationHandler {
 	return &LocationHandler{
 		GetLocationUseCase:         GetLocationUseCase,
@@ -46,9 +81,45 @@ func NewLocationHandler(
 		RestoreLocationUseCase:         restoreUC,
 		BulkRestoreLocationsUseCase:    bulkRestoreUC,
 		HardDeleteLocationUseCase:      hardDeleteUC,
+		GetLocationChildrenUseCase:     childrenUC,
+		GetLocationAncestorsUseCase:    ancestorsUC,
+		MoveLocationUseCase:            moveUC,
+		ListLocationsByDistanceUseCase: byDistanceUC,
+		SearchLocationsUseCase:         searchUC,
+		AutocompleteLocationsUseCase:   autocompleteUC,
+		RecordLocationViewUseCase:      recordViewUC,
+		ImportLocationsUseCase:         importUC,
+		GetLocationImportJobUseCase:    importJobUC,
+		ExportLocationsUseCase:         exportUC,
+		imagePipeline:                  imagePipeline,
+		ReorderLocationMediaUseCase:    reorderMediaUC,
+		UpdateLocationMediaUseCase:     updateMediaUC,
+		SetLocationCoverMediaUseCase:   setCoverMediaUC,
+		DeleteLocationMediaUseCase:     deleteMediaUC,
+		uploadScanner:                  uploadScanner,
+		PatchLocationUseCase:           patchUC,
 	}
 }
 
+// resolveFileURLs replaces the stored media keys of locations with URLs for the response
+func (h *LocationHandler) resolveFileURLs(locations ...*entity.Location) {
+	for _, location := range locations {
+		if location != nil {
+			location.ResolveFileURLs(h.resolveFileURL)
+		}
+	}
+}
+
+// resolveFileURL returns the URL to serve for a stored file reference
+func (h *LocationHandler) resolveFileURL(ref string) string {
+	return services.ResolveFileURL(h.fileService, ref)
+}
+
+// fileKey returns the value to store for a media URL sent by a client
+func (h *LocationHandler) fileKey(ref string) string {
+	return services.FileKey(h.fileService, ref)
+}
+
 // Handler methods below (bind DTOs, validate, call use-cases, return JSON)
 // e.g.:
 // func (h *LocationHandler) ListLocations(c *gin.Context) { ... }
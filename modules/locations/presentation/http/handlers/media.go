@@ -1,14 +1,20 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
-	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
 )
 
 // UploadLocationMedia handles POST /locations/:id/media
@@ -48,46 +54,52 @@ func (h *LocationHandler) UploadLocationMedia(c *gin.Context) {
 		return
 	}
 
-	var photoURLs []string
-	var videoURLs []string
-
-	allowed := map[string]string{
-		".jpg": "image/jpeg", ".jpeg": "image/jpeg", ".png": "image/png",
-		".svg": "image/svg+xml", ".webp": "image/webp", ".gif": "image/gif",
-		".mp4": "video/mp4", ".mov": "video/quicktime",
-	}
-
+	// Scan every file before storing any, so a rejected file fails the whole request.
+	// Files are classified by their content rather than their extension.
+	var uploads []*services.ScanInput
 	for _, fileHeader := range files {
 		if fileHeader.Size > 10*1024*1024 {
 			continue // Skip large files (optional: collect skipped errors)
 		}
 
-		ext := strings.ToLower(filepath.Ext(fileHeader.Filename))
-		contentType, ok := allowed[ext]
-		if !ok {
-			continue // Skip unsupported types
-		}
-
 		file, err := fileHeader.Open()
 		if err != nil {
 			continue // Could log or collect failed uploads
 		}
 		defer file.Close()
 
-		url, err := h.fileService.UploadFile(c.Request.Context(), file, fileHeader.Filename, contentType)
-		if err != nil {
+		uploads = append(uploads, services.NewMultipartScanInput(file, fileHeader,
+			append(slices.Clone(services.ImageUploadTypes), services.VideoUploadTypes...)))
+	}
+	if err := h.uploadScanner.ScanAll(c.Request.Context(), uploads); err != nil {
+		middleware.HandleError(c, middleware.NewUploadScanAppError(err, "Failed to scan uploaded files"))
+		return
+	}
+
+	var photos []models.ProcessedImage
+	var videoURLs []string
+
+	// Videos are stored as uploaded; images go through the image pipeline
+	for _, upload := range uploads {
+		content := io.NewSectionReader(upload.Content, 0, upload.Size)
+		if strings.HasPrefix(upload.DetectedType, "video/") {
+			key, err := h.fileService.UploadFile(c.Request.Context(), content, upload.FileName, upload.DetectedType)
+			if err != nil {
+				continue
+			}
+			videoURLs = append(videoURLs, key)
 			continue
 		}
 
-		if strings.HasPrefix(contentType, "image/") {
-			photoURLs = append(photoURLs, url)
-		} else if strings.HasPrefix(contentType, "video/") {
-			videoURLs = append(videoURLs, url)
+		photo, err := h.imagePipeline.Process(c.Request.Context(), content, upload.FileName, services.ImageOptions{AllowSVG: true})
+		if err != nil {
+			continue // Skip files that are not valid images
 		}
+		photos = append(photos, *photo)
 	}
 
 	// If no files uploaded successfully, return error
-	if len(photoURLs) == 0 && len(videoURLs) == 0 {
+	if len(photos) == 0 && len(videoURLs) == 0 {
 		middleware.HandleError(c, middleware.NewAppError(
 			middleware.ErrorCodeInvalidRequest,
 			"None of the media files were valid or successfully uploaded",
@@ -98,7 +110,10 @@ func (h *LocationHandler) UploadLocationMedia(c *gin.Context) {
 	}
 
 	// Persist media URLs
-	if err := h.UploadLocationMediaUseCase.Execute(c.Request.Context(), oid.Hex(), photoURLs, videoURLs); err != nil {
+	if err := h.UploadLocationMediaUseCase.Execute(c.Request.Context(), oid.Hex(), photos, videoURLs); err != nil {
+		for i := range photos {
+			h.imagePipeline.DeleteImage(c.Request.Context(), &photos[i])
+		}
 		middleware.HandleError(c, middleware.NewAppError(
 			middleware.ErrorCodeInternalServer,
 			"Failed to save media URLs",
@@ -120,5 +135,195 @@ func (h *LocationHandler) UploadLocationMedia(c *gin.Context) {
 		return
 	}
 
+	h.resolveFileURLs(location)
 	c.JSON(http.StatusOK, location)
 }
+
+// ReorderLocationMedia godoc
+//
+//	@Summary		Reorder location media
+//	@Description	Set the display order of a location's photos and videos. The body must list every media item ID exactly once.
+//	@Tags			locations
+//	@Accept			json
+//	@Produce		json
+//	@Param			If-Match	header	string	true	"ETag of the location"
+//	@Param			id		path		string							true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			body	body		dto.ReorderLocationMediaDto		true	"Media IDs in display order"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		412		{object}	models.SwaggerErrorResponse
+//	@Failure		428		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/media/order [put]
+func (h *LocationHandler) ReorderLocationMedia(c *gin.Context) {
+	var reorderDto dto.ReorderLocationMediaDto
+	if err := c.ShouldBindJSON(&reorderDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid request body", err, http.StatusBadRequest))
+		return
+	}
+	if err := reorderDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	location, ok := h.findLocation(c)
+	if !ok {
+		return
+	}
+	if appErr := middleware.CheckIfMatch(c, location.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	updated, err := h.ReorderLocationMediaUseCase.Execute(c.Request.Context(), location.ID, reorderDto.ObjectIDs())
+	if err != nil {
+		middleware.HandleError(c, mediaError(err, "Failed to reorder media"))
+		return
+	}
+
+	h.resolveFileURLs(updated)
+	middleware.SetETag(c, updated.Version)
+	c.JSON(http.StatusOK, updated)
+}
+
+// UpdateLocationMedia godoc
+//
+//	@Summary		Update location media metadata
+//	@Description	Update the caption, alt text, credit or licence of a media item
+//	@Tags			locations
+//	@Accept			json
+//	@Produce		json
+//	@Param			If-Match	header	string	true	"ETag of the location"
+//	@Param			id		path		string							true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			mediaId	path		string							true	"Media item ID"
+//	@Param			body	body		dto.UpdateLocationMediaDto		true	"Metadata to change"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.MediaItem}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		412		{object}	models.SwaggerErrorResponse
+//	@Failure		428		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/media/{mediaId} [put]
+func (h *LocationHandler) UpdateLocationMedia(c *gin.Context) {
+	var updateDto dto.UpdateLocationMediaDto
+	if err := c.ShouldBindJSON(&updateDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid request body", err, http.StatusBadRequest))
+		return
+	}
+	if err := updateDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	location, mediaID, ok := h.findLocationMedia(c)
+	if !ok {
+		return
+	}
+	if appErr := middleware.CheckIfMatch(c, location.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	item, err := h.UpdateLocationMediaUseCase.Execute(c.Request.Context(), location.ID, mediaID, updateDto.ToUpdate())
+	if err != nil {
+		middleware.HandleError(c, mediaError(err, "Failed to update media"))
+		return
+	}
+
+	item.ResolveFileURLs(h.resolveFileURL)
+	c.JSON(http.StatusOK, item)
+}
+
+// SetLocationCoverMedia godoc
+//
+//	@Summary		Set location cover photo
+//	@Description	Make a photo the cover image of the location
+//	@Tags			locations
+//	@Produce		json
+//	@Param			If-Match	header	string	true	"ETag of the location"
+//	@Param			id		path		string	true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			mediaId	path		string	true	"Media item ID of a photo"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		412		{object}	models.SwaggerErrorResponse
+//	@Failure		428		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/media/{mediaId}/cover [put]
+func (h *LocationHandler) SetLocationCoverMedia(c *gin.Context) {
+	location, mediaID, ok := h.findLocationMedia(c)
+	if !ok {
+		return
+	}
+	if appErr := middleware.CheckIfMatch(c, location.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	updated, err := h.SetLocationCoverMediaUseCase.Execute(c.Request.Context(), location.ID, mediaID)
+	if err != nil {
+		middleware.HandleError(c, mediaError(err, "Failed to set cover photo"))
+		return
+	}
+
+	h.resolveFileURLs(updated)
+	middleware.SetETag(c, updated.Version)
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteLocationMedia godoc
+//
+//	@Summary		Delete location media
+//	@Description	Remove a photo or video from the location and delete it and its variants from storage. If it was the cover, the first remaining photo becomes the cover.
+//	@Tags			locations
+//	@Produce		json
+//	@Param			id		path		string	true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			mediaId	path		string	true	"Media item ID"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/media/{mediaId} [delete]
+func (h *LocationHandler) DeleteLocationMedia(c *gin.Context) {
+	location, mediaID, ok := h.findLocationMedia(c)
+	if !ok {
+		return
+	}
+
+	updated, err := h.DeleteLocationMediaUseCase.Execute(c.Request.Context(), location.ID, mediaID)
+	if err != nil {
+		middleware.HandleError(c, mediaError(err, "Failed to delete media"))
+		return
+	}
+
+	h.resolveFileURLs(updated)
+	c.JSON(http.StatusOK, updated)
+}
+
+// findLocationMedia loads the location and parses the :mediaId param
+func (h *LocationHandler) findLocationMedia(c *gin.Context) (*entity.Location, primitive.ObjectID, bool) {
+	location, ok := h.findLocation(c)
+	if !ok {
+		return nil, primitive.NilObjectID, false
+	}
+
+	mediaID, err := primitive.ObjectIDFromHex(c.Param("mediaId"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid media ID", err, http.StatusBadRequest))
+		return nil, primitive.NilObjectID, false
+	}
+	return location, mediaID, true
+}
+
+// mediaError maps media errors to responses, falling back to a server error
+func mediaError(err error, fallback string) *middleware.AppError {
+	switch {
+	case errors.Is(err, entity.ErrMediaNotFound):
+		return middleware.NewAppError(middleware.ErrorCodeNotFound, err.Error(), nil, http.StatusNotFound)
+	case errors.Is(err, entity.ErrInvalidMediaOrder), errors.Is(err, entity.ErrCoverMustBePhoto):
+		return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+	default:
+		return middleware.NewVersionConflictAppError(err, fallback)
+	}
+}
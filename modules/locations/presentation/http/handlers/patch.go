@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
+)
+
+// PatchLocation godoc
+//
+//	@Summary		Partially update a location
+//	@Description	Apply a JSON Merge Patch (application/merge-patch+json) or JSON Patch (application/json-patch+json) to the updatable fields of a location. Only the changed fields are validated and saved; removing a field clears it.
+//	@Tags			locations
+//	@Accept			application/merge-patch+json,application/json-patch+json
+//	@Produce		json
+//	@Param			id			path		string	true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Param			patch		body		dto.UpdateLocationDto	true	"Merge patch of the fields to change, or an array of JSON Patch operations"
+//	@Param			If-Match	header		string	false	"ETag of the location; when sent, the patch is refused if the location has changed since"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Location}
+//	@Header			200	{string}	ETag	"New version of the location"
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		409	{object}	models.SwaggerErrorResponse	"A JSON Patch test operation failed"
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The location was changed by another request"
+//	@Failure		415	{object}	models.SwaggerErrorResponse	"Unsupported patch format"
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id} [patch]
+func (h *LocationHandler) PatchLocation(c *gin.Context) {
+	location, ok := h.findLocation(c)
+	if !ok {
+		return
+	}
+	if appErr := middleware.CheckIfMatchIfPresent(c, location.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid request body", err, http.StatusBadRequest))
+		return
+	}
+
+	// Patch the updatable fields and keep only the ones the patch changed
+	var changes dto.UpdateLocationDto
+	if err := patch.ApplyTo(c.ContentType(), dto.NewUpdateLocationDto(location), body, &changes); err != nil {
+		middleware.HandleError(c, middleware.NewPatchAppError(c, err, "Failed to apply patch"))
+		return
+	}
+	if err := changes.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	// Media URLs of this storage, such as ones returned by a previous read, are stored
+	// by key so existing items are matched
+	if changes.MediaUrls != nil {
+		changes.MediaUrls.MapURLs(h.fileKey)
+	}
+	original := *location
+	changes.ApplyUpdates(location)
+
+	if err := h.PatchLocationUseCase.Execute(c.Request.Context(), &original, location); err != nil {
+		if appErr := hierarchyError(err); appErr != nil {
+			middleware.HandleError(c, appErr)
+			return
+		}
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update location"))
+		return
+	}
+
+	middleware.SetETag(c, location.Version)
+	h.resolveFileURLs(location)
+	c.JSON(http.StatusOK, location)
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/dto"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SearchLocations godoc
+//
+//	@Summary		Search locations
+//	@Description	Full-text search across name, aliases, tags and description, tolerant of typos and transliteration variants
+//	@Tags			locations
+//	@Produce		json
+//	@Param			q		query		string	true	"Search text"	example(darjeling)
+//	@Param			type	query		string	false	"Filter by location type"
+//	@Param			state	query		string	false	"Filter by state"
+//	@Param			limit	query		int		false	"Max results"	default(10)	maximum(50)
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=[]entity.Location}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/search [get]
+func (h *LocationHandler) SearchLocations(c *gin.Context) {
+	queryDto := dto.NewSearchLocationsDto(c)
+	if err := queryDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	locations, err := h.SearchLocationsUseCase.Execute(c.Request.Context(), queryDto.Q, queryDto.ToFilterMap(), queryDto.Limit)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to search locations", err, http.StatusInternalServerError))
+		return
+	}
+
+	h.resolveFileURLs(locations...)
+	c.JSON(http.StatusOK, gin.H{
+		"items": locations,
+		"query": queryDto.Q,
+		"limit": queryDto.Limit,
+	})
+}
+
+// AutocompleteLocations godoc
+//
+//	@Summary		Autocomplete locations
+//	@Description	Suggest locations for a partially typed name, ranked by relevance and popularity. Report the suggestion the user picks to POST /locations/{id}/select
+//	@Tags			locations
+//	@Produce		json
+//	@Param			q		query		string	true	"Partial name"	example(darj)
+//	@Param			limit	query		int		false	"Max suggestions"	default(10)	maximum(50)
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=[]entity.LocationSuggestion}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/autocomplete [get]
+func (h *LocationHandler) AutocompleteLocations(c *gin.Context) {
+	queryDto := dto.NewSearchLocationsDto(c)
+	if err := queryDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	suggestions, err := h.AutocompleteLocationsUseCase.Execute(c.Request.Context(), queryDto.Q, queryDto.Limit)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch suggestions", err, http.StatusInternalServerError))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"suggestions": suggestions,
+		"query":       queryDto.Q,
+	})
+}
+
+// RecordLocationSelection godoc
+//
+//	@Summary		Record an autocomplete selection
+//	@Description	Count a location picked from the autocomplete suggestions, which ranks it higher in later suggestions
+//	@Tags			locations
+//	@Param			id	path	string	true	"Location ID"	example("6824886e6b180b753cea43e9")
+//	@Success		204
+//	@Failure		400	{object}	models.SwaggerErrorResponse
+//	@Failure		500	{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/select [post]
+func (h *LocationHandler) RecordLocationSelection(c *gin.Context) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid location ID", err, http.StatusBadRequest))
+		return
+	}
+
+	if err := h.RecordLocationViewUseCase.Execute(c.Request.Context(), objectId); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to record selection", err, http.StatusInternalServerError))
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
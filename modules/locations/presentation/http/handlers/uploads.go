@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+)
+
+// LocationExists reports whether a location exists, for opening direct uploads
+func (h *LocationHandler) LocationExists(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	location, err := h.GetLocationUseCase.Execute(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return location != nil, nil
+}
+
+// AttachUploadedMedia adds a confirmed direct upload to the location's photos or videos
+func (h *LocationHandler) AttachUploadedMedia(ctx context.Context, session *uploadEntity.UploadSession) (interface{}, error) {
+	var photos []models.ProcessedImage
+	var videos []string
+	switch {
+	case session.IsImage():
+		// Direct uploads go through the same validation and variant generation as proxied ones
+		photo, err := h.imagePipeline.ProcessStored(ctx, session.Key, session.FileName, services.ImageOptions{AllowSVG: true})
+		if err != nil {
+			return nil, err
+		}
+		photos = []models.ProcessedImage{*photo}
+	case session.IsVideo():
+		videos = []string{session.Key}
+	default:
+		return nil, errors.New("unsupported media type")
+	}
+
+	if err := h.UploadLocationMediaUseCase.Execute(ctx, session.TargetID.Hex(), photos, videos); err != nil {
+		for i := range photos {
+			h.imagePipeline.DeleteImage(ctx, &photos[i])
+		}
+		return nil, err
+	}
+
+	// The raw image upload is replaced by its cleaned copy
+	if len(photos) > 0 {
+		_ = h.fileService.DeleteFile(ctx, session.Key)
+	}
+	location, err := h.GetLocationUseCase.Execute(ctx, session.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	h.resolveFileURLs(location)
+	return location, nil
+}
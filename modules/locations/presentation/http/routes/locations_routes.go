@@ -2,14 +2,18 @@ package routes
 
 import (
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/locations/presentation/http/handlers"
+	orgEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	uploadHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/presentation/http/handlers"
 	"github.com/gin-gonic/gin"
 )
 
 // RegisterLocationRoutes registers all location-related routes
-func RegisterLocationRoutes(rg *gin.RouterGroup, h *handlers.LocationHandler, app *container.AppContainer) {
+func RegisterLocationRoutes(rg *gin.RouterGroup, h *handlers.LocationHandler, app *container.AppContainer, uploads *uploadHandlers.UploadHandler, bulkOps *bulk.Handler) {
 	n := rg.Group(constants.LocationBasePath)
 
 	n.Use(middleware.AutoGuard(app.RBACService,
@@ -21,6 +25,19 @@ func RegisterLocationRoutes(rg *gin.RouterGroup, h *handlers.LocationHandler, ap
 		n.GET(constants.ListLocationsPath, h.ListLocations)
 		n.POST(constants.CreateLocationPath, h.CreateLocation)
 
+		// Search
+		n.GET(constants.SearchLocationsPath, h.SearchLocations)
+		n.GET(constants.AutocompleteLocationsPath, h.AutocompleteLocations)
+
+		// Import / export
+		n.POST(constants.ImportLocationsPath,
+			middleware.RequireFeatureFlag(app.Organization.FeatureFlagEvaluator, orgEntity.FeatureFlagBulkImport),
+			h.ImportLocations)
+		n.GET(constants.LocationImportJobPath, h.GetLocationImportJob)
+		n.GET(constants.ExportLocationsPath,
+			middleware.RequireFeatureFlag(app.Organization.FeatureFlagEvaluator, orgEntity.FeatureFlagDataExport),
+			h.ExportLocations)
+
 		// Bulk actions
 		n.DELETE(constants.BulkDeleteLocationsPath, h.BulkDeleteLocations)
 		n.POST(constants.BulkRestoreLocationsPath, h.BulkRestoreLocations)
@@ -28,11 +45,34 @@ func RegisterLocationRoutes(rg *gin.RouterGroup, h *handlers.LocationHandler, ap
 		// Single item operations
 		n.GET(constants.GetLocationPath, h.GetLocation)
 		n.PUT(constants.UpdateLocationPath, h.UpdateLocation)
+		n.PATCH(constants.UpdateLocationPath, h.PatchLocation)
 		n.DELETE(constants.DeleteLocationPath, h.DeleteLocation)
 
 		// Media upload
 		n.POST(constants.UploadLocationMediaPath, h.UploadLocationMedia)
+		n.POST(constants.LocationMediaUploadsPath, uploads.CreateUploadSession(uploadEntity.TargetLocationMedia, h.LocationExists))
+		n.POST(constants.ConfirmLocationMediaUploadPath, uploads.ConfirmUploadSession(uploadEntity.TargetLocationMedia, h.AttachUploadedMedia))
+		n.PUT(constants.ReorderLocationMediaPath, h.ReorderLocationMedia)
+		n.PUT(constants.LocationMediaItemPath, h.UpdateLocationMedia)
+		n.PUT(constants.LocationCoverMediaPath, h.SetLocationCoverMedia)
+		n.DELETE(constants.LocationMediaItemPath, h.DeleteLocationMedia)
 		n.POST(constants.RestoreLocationPath, h.RestoreLocation)
 		n.DELETE(constants.HardDeleteLocationPath, h.HardDeleteLocation)
+
+		// Hierarchy
+		n.GET(constants.LocationChildrenPath, h.GetLocationChildren)
+		n.GET(constants.LocationAncestorsPath, h.GetLocationAncestors)
+		n.PUT(constants.MoveLocationPath, h.MoveLocation)
 	}
+
+	// Selecting a suggestion only needs read access, not the create access POST implies
+	selectGroup := rg.Group(constants.LocationBasePath, middleware.AutoGuard(app.RBACService,
+		middleware.WithCustomAction("read"),
+		middleware.WithOwnership("organizationId", "id"),
+	))
+	selectGroup.POST(constants.LocationSelectionPath, h.RecordLocationSelection)
+
+	// The bulk endpoint checks the permission of each operation and scopes its records itself
+	bulkGroup := rg.Group(constants.LocationBasePath, middleware.MultiLayerGuard(app.RBACService, middleware.GuardConfig{RequireAuth: true}))
+	bulkGroup.POST(constants.BulkLocationsPath, bulkOps.Bulk("locations", h.BulkOps))
 }
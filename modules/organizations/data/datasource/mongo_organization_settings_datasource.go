@@ -0,0 +1,148 @@
+// internal/modules/organizations/data/datasource/mongo_organization_settings_datasource.go
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/data/mongodb/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoOrganizationSettingsDatasource handles raw MongoDB operations for organization settings
+type MongoOrganizationSettingsDatasource struct {
+	collection *mongo.Collection
+}
+
+// NewMongoOrganizationSettingsDatasource creates a new instance of the organization settings datasource
+func NewMongoOrganizationSettingsDatasource(db *mongo.Database) *MongoOrganizationSettingsDatasource {
+	collection := db.Collection(model.OrganizationSettingsModel{}.CollectionName())
+
+	return &MongoOrganizationSettingsDatasource{
+		collection: collection,
+	}
+}
+
+// FindByOrganizationID finds the settings document for an organization
+func (ds *MongoOrganizationSettingsDatasource) FindByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) (*model.OrganizationSettingsModel, error) {
+	filter := bson.M{"organizationId": organizationID}
+
+	var settings model.OrganizationSettingsModel
+	err := ds.collection.FindOne(ctx, filter).Decode(&settings)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Return nil if not found
+		}
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// Upsert creates or replaces the settings document for an organization
+func (ds *MongoOrganizationSettingsDatasource) Upsert(ctx context.Context, settings *model.OrganizationSettingsModel) error {
+	now := time.Now()
+	settings.UpdatedAt = now
+
+	filter := bson.M{"organizationId": settings.OrganizationID}
+	update := bson.M{
+		"$set": bson.M{
+			"defaultCurrency":    settings.DefaultCurrency,
+			"timezone":           settings.Timezone,
+			"branding":           settings.Branding,
+			"allowedUploadTypes": settings.AllowedUploadTypes,
+			"featureFlags":       settings.FeatureFlags,
+			"updatedBy":          settings.UpdatedBy,
+			"updatedAt":          now,
+		},
+		"$setOnInsert": bson.M{
+			"createdAt": now,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var saved model.OrganizationSettingsModel
+	if err := ds.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saved); err != nil {
+		return err
+	}
+
+	// Capture MongoDB-assigned ObjectID and creation time
+	settings.ID = saved.ID
+	settings.CreatedAt = saved.CreatedAt
+	return nil
+}
+
+// DeleteByOrganizationID removes the settings document for an organization
+func (ds *MongoOrganizationSettingsDatasource) DeleteByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) (bool, error) {
+	result, err := ds.collection.DeleteOne(ctx, bson.M{"organizationId": organizationID})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+// MongoFeatureFlagOverrideDatasource handles raw MongoDB operations for global feature flag overrides
+type MongoFeatureFlagOverrideDatasource struct {
+	collection *mongo.Collection
+}
+
+// NewMongoFeatureFlagOverrideDatasource creates a new instance of the feature flag override datasource
+func NewMongoFeatureFlagOverrideDatasource(db *mongo.Database) *MongoFeatureFlagOverrideDatasource {
+	collection := db.Collection(model.FeatureFlagOverrideModel{}.CollectionName())
+
+	return &MongoFeatureFlagOverrideDatasource{
+		collection: collection,
+	}
+}
+
+// FindAll returns every global override sorted by key
+func (ds *MongoFeatureFlagOverrideDatasource) FindAll(ctx context.Context) ([]model.FeatureFlagOverrideModel, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "key", Value: 1}})
+
+	cursor, err := ds.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var overrides []model.FeatureFlagOverrideModel
+	if err := cursor.All(ctx, &overrides); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// Upsert creates or replaces the override for a flag
+func (ds *MongoFeatureFlagOverrideDatasource) Upsert(ctx context.Context, override *model.FeatureFlagOverrideModel) error {
+	override.UpdatedAt = time.Now()
+
+	filter := bson.M{"key": override.Key}
+	update := bson.M{
+		"$set": bson.M{
+			"enabled":   override.Enabled,
+			"updatedBy": override.UpdatedBy,
+			"updatedAt": override.UpdatedAt,
+		},
+	}
+
+	_, err := ds.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// Delete removes the override for a flag
+func (ds *MongoFeatureFlagOverrideDatasource) Delete(ctx context.Context, key string) (bool, error) {
+	result, err := ds.collection.DeleteOne(ctx, bson.M{"key": key})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+}
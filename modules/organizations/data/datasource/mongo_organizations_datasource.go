@@ -3,15 +3,14 @@ package datasource
 
 import (
 	"context"
-	"log"
 	"time"
 
-	mongodb "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/data/mongodb/indexes"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/data/mongodb/model"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // MongoOrganizationDatasource handles raw MongoDB operations for organizations
@@ -23,9 +22,6 @@ type MongoOrganizationDatasource struct {
 func NewMongoOrganizationDatasource(db *mongo.Database) *MongoOrganizationDatasource {
 	collection := db.Collection(model.OrganizationModel{}.CollectionName())
 
-	if err := mongodb.SetupOrganizationIndexes(collection); err != nil {
-		log.Printf("⚠️ Failed to setup organization indexes: %v", err)
-	}
 	return &MongoOrganizationDatasource{
 		collection: collection,
 	}
@@ -47,33 +43,30 @@ func (ds *MongoOrganizationDatasource) Insert(ctx context.Context, organization
 	return nil
 }
 
-// FindByFilters retrieves organization documents with optional filters, pagination, and sorting
-func (ds *MongoOrganizationDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, page int, limit int) ([]model.OrganizationModel, int64, error) {
-	// Step 1: Count total for pagination
-	totalCount, err := ds.collection.CountDocuments(ctx, filters)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Step 2: Prepare options
-	opts := options.Find().
-		SetSkip(int64((page - 1) * limit)).
-		SetLimit(int64(limit)).
-		SetSort(bson.D{{Key: "createdAt", Value: -1}}) // Default sort by creation date descending
-
-	// Step 3: Query
-	cursor, err := ds.collection.Find(ctx, filters, opts)
+// FindByFilters retrieves the page of organization documents matching filters selected by opts
+func (ds *MongoOrganizationDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, opts models.ListOptions) ([]model.OrganizationModel, *models.PageInfo, error) {
+	var organizations []model.OrganizationModel
+	info, err := database.FindPage(ctx, ds.collection, filters, opts, &organizations)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
-	defer cursor.Close(ctx)
+	return organizations, info, nil
+}
 
-	var organizations []model.OrganizationModel
-	if err := cursor.All(ctx, &organizations); err != nil {
-		return nil, 0, err
-	}
+// Stream decodes each organization document matching filters in sort order and calls fn with it
+func (ds *MongoOrganizationDatasource) Stream(ctx context.Context, filters map[string]interface{}, sort []models.SortField, fn func(*model.OrganizationModel) error) error {
+	return database.Stream(ctx, ds.collection, filters, sort, func(cursor *mongo.Cursor) error {
+		var m model.OrganizationModel
+		if err := cursor.Decode(&m); err != nil {
+			return err
+		}
+		return fn(&m)
+	})
+}
 
-	return organizations, totalCount, nil
+// Count counts the organization documents matching filters
+func (ds *MongoOrganizationDatasource) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	return ds.collection.CountDocuments(ctx, filters)
 }
 
 // FindByID finds an organization by its ID
@@ -109,22 +102,44 @@ func (ds *MongoOrganizationDatasource) FindBySlug(ctx context.Context, slug stri
 func (ds *MongoOrganizationDatasource) Update(ctx context.Context, organization *model.OrganizationModel) error {
 	organization.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": organization.ID}
+	// Only overwrite the version the caller read, so concurrent edits are not lost
+	expected := organization.Version
+	organization.Version++
+	filter := database.VersionedFilter(organization.ID, expected)
 	update := bson.M{"$set": organization}
 
-	_, err := ds.collection.UpdateOne(ctx, filter, update)
+	result, err := ds.collection.UpdateOne(ctx, filter, update)
+	if err == nil && result.MatchedCount == 0 {
+		err = models.ErrVersionConflict
+	}
+	if err != nil {
+		organization.Version = expected
+	}
 	return err
 }
 
+// Patch writes only the fields of updated that differ from original, while the stored
+// document is still at the version of original
+func (ds *MongoOrganizationDatasource) Patch(ctx context.Context, original, updated *model.OrganizationModel) error {
+	set, unset, err := database.ChangedFields(original, updated)
+	if err != nil || len(set)+len(unset) == 0 {
+		return err
+	}
+	updated.UpdatedAt = time.Now()
+	set["updatedAt"] = updated.UpdatedAt
+
+	filter := database.VersionedFilter(original.ID, original.Version)
+	if err := database.PatchDocument(ctx, ds.collection, filter, set, unset); err != nil {
+		return err
+	}
+	updated.Version = original.Version + 1
+	return nil
+}
+
 // SoftDelete marks an organization as deleted by setting deletedAt timestamp
 func (ds *MongoOrganizationDatasource) SoftDelete(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id, "deletedAt": nil}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": time.Now(),
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.SoftDeleteUpdate(ctx)
 
 	result, err := ds.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -137,12 +152,7 @@ func (ds *MongoOrganizationDatasource) SoftDelete(ctx context.Context, id primit
 // Restore restores a soft-deleted organization by setting deletedAt to nil
 func (ds *MongoOrganizationDatasource) Restore(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id, "deletedAt": bson.M{"$ne": nil}}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -170,12 +180,7 @@ func (ds *MongoOrganizationDatasource) BulkSoftDelete(ctx context.Context, ids [
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": nil,
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": time.Now(),
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.SoftDeleteUpdate(ctx)
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -207,17 +212,21 @@ func (ds *MongoOrganizationDatasource) BulkSoftDelete(ctx context.Context, ids [
 	return updatedIDs, nil
 }
 
-// UpdateStatus updates the status of an organization
-func (ds *MongoOrganizationDatasource) UpdateStatus(ctx context.Context, id primitive.ObjectID, status string) error {
-	filter := bson.M{"_id": id}
+// UpdateStatus updates the status of an organization still at the expected version
+func (ds *MongoOrganizationDatasource) UpdateStatus(ctx context.Context, id primitive.ObjectID, status string, version int64) error {
+	filter := database.VersionedFilter(id, version)
 	update := bson.M{
 		"$set": bson.M{
 			"status":    status,
 			"updatedAt": time.Now(),
 		},
+		"$inc": database.IncrementVersion(),
 	}
 
-	_, err := ds.collection.UpdateOne(ctx, filter, update)
+	result, err := ds.collection.UpdateOne(ctx, filter, update)
+	if err == nil && result.MatchedCount == 0 {
+		err = models.ErrVersionConflict
+	}
 	return err
 }
 
@@ -228,12 +237,7 @@ func (ds *MongoOrganizationDatasource) BulkRestore(ctx context.Context, ids []pr
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": bson.M{"$ne": nil}, // Only restore soft-deleted items
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -4,6 +4,7 @@ package model
 import (
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -28,10 +29,12 @@ type OrganizationModel struct {
 	Website       string             `bson:"website" json:"website"`
 	TaxIDs        []string           `bson:"taxIds" json:"taxIds"`
 	Logo          string             `bson:"logo" json:"logo"`
+	LogoVariants  models.ImageVariants `bson:"logoVariants" json:"logoVariants,omitempty"`
 	Address       AddressModel       `bson:"address" json:"address"`
 	Status        string             `bson:"status" json:"status"`
 	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
 	UpdatedAt     time.Time          `bson:"updatedAt" json:"updatedAt"`
+	Version       int64              `bson:"version" json:"version"`
 	DeletedAt     *time.Time         `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
 }
 
@@ -52,6 +55,7 @@ func FromEntity(entity *entity.Organization) *OrganizationModel {
 		Website:       entity.Website,
 		TaxIDs:        entity.TaxIDs,
 		Logo:          entity.Logo,
+		LogoVariants:  entity.LogoVariants,
 		Address: AddressModel{
 			Street:  entity.Address.Street,
 			City:    entity.Address.City,
@@ -62,6 +66,7 @@ func FromEntity(entity *entity.Organization) *OrganizationModel {
 		Status:    entity.Status,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
+		Version:   entity.Version,
 		DeletedAt: entity.DeletedAt,
 	}
 }
@@ -78,6 +83,7 @@ func (m *OrganizationModel) ToEntity() entity.Organization {
 		Website:       m.Website,
 		TaxIDs:        m.TaxIDs,
 		Logo:          m.Logo,
+		LogoVariants:  m.LogoVariants,
 		Address: entity.Address{
 			Street:  m.Address.Street,
 			City:    m.Address.City,
@@ -88,6 +94,7 @@ func (m *OrganizationModel) ToEntity() entity.Organization {
 		Status:    m.Status,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+		Version:   m.Version,
 		DeletedAt: m.DeletedAt,
 	}
 }
@@ -0,0 +1,109 @@
+// internal/modules/organizations/data/mongodb/model/organization_settings_model.go
+package model
+
+import (
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BrandingModel represents embedded branding in the settings model
+type BrandingModel struct {
+	PrimaryColor   string `bson:"primaryColor" json:"primaryColor"`
+	SecondaryColor string `bson:"secondaryColor" json:"secondaryColor"`
+	AccentColor    string `bson:"accentColor" json:"accentColor"`
+}
+
+// OrganizationSettingsModel represents the MongoDB organization settings schema
+type OrganizationSettingsModel struct {
+	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	OrganizationID     primitive.ObjectID `bson:"organizationId" json:"organizationId"`
+	DefaultCurrency    string             `bson:"defaultCurrency" json:"defaultCurrency"`
+	Timezone           string             `bson:"timezone" json:"timezone"`
+	Branding           BrandingModel      `bson:"branding" json:"branding"`
+	AllowedUploadTypes []string           `bson:"allowedUploadTypes" json:"allowedUploadTypes"`
+	FeatureFlags       map[string]bool    `bson:"featureFlags" json:"featureFlags"`
+	UpdatedBy          string             `bson:"updatedBy,omitempty" json:"updatedBy,omitempty"`
+	CreatedAt          time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt          time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// CollectionName returns the MongoDB collection name
+func (OrganizationSettingsModel) CollectionName() string {
+	return "organization_settings"
+}
+
+// SettingsFromEntity maps entity.OrganizationSettings to OrganizationSettingsModel
+func SettingsFromEntity(e *entity.OrganizationSettings) *OrganizationSettingsModel {
+	return &OrganizationSettingsModel{
+		ID:              e.ID,
+		OrganizationID:  e.OrganizationID,
+		DefaultCurrency: e.DefaultCurrency,
+		Timezone:        e.Timezone,
+		Branding: BrandingModel{
+			PrimaryColor:   e.Branding.PrimaryColor,
+			SecondaryColor: e.Branding.SecondaryColor,
+			AccentColor:    e.Branding.AccentColor,
+		},
+		AllowedUploadTypes: e.AllowedUploadTypes,
+		FeatureFlags:       e.FeatureFlags,
+		UpdatedBy:          e.UpdatedBy,
+		CreatedAt:          e.CreatedAt,
+		UpdatedAt:          e.UpdatedAt,
+	}
+}
+
+// ToEntity maps OrganizationSettingsModel to entity.OrganizationSettings
+func (m *OrganizationSettingsModel) ToEntity() entity.OrganizationSettings {
+	return entity.OrganizationSettings{
+		ID:              m.ID,
+		OrganizationID:  m.OrganizationID,
+		DefaultCurrency: m.DefaultCurrency,
+		Timezone:        m.Timezone,
+		Branding: entity.Branding{
+			PrimaryColor:   m.Branding.PrimaryColor,
+			SecondaryColor: m.Branding.SecondaryColor,
+			AccentColor:    m.Branding.AccentColor,
+		},
+		AllowedUploadTypes: m.AllowedUploadTypes,
+		FeatureFlags:       m.FeatureFlags,
+		UpdatedBy:          m.UpdatedBy,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
+
+// FeatureFlagOverrideModel represents the MongoDB feature flag override schema
+type FeatureFlagOverrideModel struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Key       string             `bson:"key" json:"key"`
+	Enabled   bool               `bson:"enabled" json:"enabled"`
+	UpdatedBy string             `bson:"updatedBy,omitempty" json:"updatedBy,omitempty"`
+	UpdatedAt time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// CollectionName returns the MongoDB collection name
+func (FeatureFlagOverrideModel) CollectionName() string {
+	return "feature_flag_overrides"
+}
+
+// FeatureFlagOverrideFromEntity maps entity.FeatureFlagOverride to FeatureFlagOverrideModel
+func FeatureFlagOverrideFromEntity(e *entity.FeatureFlagOverride) *FeatureFlagOverrideModel {
+	return &FeatureFlagOverrideModel{
+		Key:       e.Key,
+		Enabled:   e.Enabled,
+		UpdatedBy: e.UpdatedBy,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+// ToEntity maps FeatureFlagOverrideModel to entity.FeatureFlagOverride
+func (m *FeatureFlagOverrideModel) ToEntity() entity.FeatureFlagOverride {
+	return entity.FeatureFlagOverride{
+		Key:       m.Key,
+		Enabled:   m.Enabled,
+		UpdatedBy: m.UpdatedBy,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,110 @@
+// internal/modules/organizations/data/mongodb/repository/organization_settings_repository.go
+package repository
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Ensure interface compliance
+var (
+	_ repository.OrganizationSettingsRepository = (*OrganizationSettingsRepositoryMongo)(nil)
+	_ repository.FeatureFlagOverrideRepository  = (*FeatureFlagOverrideRepositoryMongo)(nil)
+)
+
+// OrganizationSettingsRepositoryMongo implements the domain OrganizationSettingsRepository interface
+type OrganizationSettingsRepositoryMongo struct {
+	datasource *datasource.MongoOrganizationSettingsDatasource
+}
+
+// NewOrganizationSettingsRepositoryMongo creates a new instance of OrganizationSettingsRepositoryMongo
+func NewOrganizationSettingsRepositoryMongo(ds *datasource.MongoOrganizationSettingsDatasource) *OrganizationSettingsRepositoryMongo {
+	return &OrganizationSettingsRepositoryMongo{
+		datasource: ds,
+	}
+}
+
+// FindByOrganizationID returns the stored settings for an organization
+func (r *OrganizationSettingsRepositoryMongo) FindByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) (*entity.OrganizationSettings, error) {
+	settingsModel, err := r.datasource.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settingsModel == nil {
+		return nil, nil
+	}
+
+	settingsEntity := settingsModel.ToEntity()
+	return &settingsEntity, nil
+}
+
+// Upsert creates or replaces the settings document for an organization
+func (r *OrganizationSettingsRepositoryMongo) Upsert(ctx context.Context, settings *entity.OrganizationSettings) error {
+	settingsModel := model.SettingsFromEntity(settings)
+
+	if err := r.datasource.Upsert(ctx, settingsModel); err != nil {
+		return err
+	}
+
+	// Set back the generated fields in entity for downstream use
+	settings.ID = settingsModel.ID
+	settings.CreatedAt = settingsModel.CreatedAt
+	settings.UpdatedAt = settingsModel.UpdatedAt
+	return nil
+}
+
+// DeleteByOrganizationID removes the settings document for an organization
+func (r *OrganizationSettingsRepositoryMongo) DeleteByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) (bool, error) {
+	return r.datasource.DeleteByOrganizationID(ctx, organizationID)
+}
+
+// FeatureFlagOverrideRepositoryMongo implements the domain FeatureFlagOverrideRepository interface
+type FeatureFlagOverrideRepositoryMongo struct {
+	datasource *datasource.MongoFeatureFlagOverrideDatasource
+}
+
+// NewFeatureFlagOverrideRepositoryMongo creates a new instance of FeatureFlagOverrideRepositoryMongo
+func NewFeatureFlagOverrideRepositoryMongo(ds *datasource.MongoFeatureFlagOverrideDatasource) *FeatureFlagOverrideRepositoryMongo {
+	return &FeatureFlagOverrideRepositoryMongo{
+		datasource: ds,
+	}
+}
+
+// FindAll returns every global override
+func (r *FeatureFlagOverrideRepositoryMongo) FindAll(ctx context.Context) ([]*entity.FeatureFlagOverride, error) {
+	overrideModels, err := r.datasource.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make([]*entity.FeatureFlagOverride, len(overrideModels))
+	for i, m := range overrideModels {
+		e := m.ToEntity()
+		overrides[i] = &e
+	}
+
+	return overrides, nil
+}
+
+// Upsert creates or replaces the override for a flag
+func (r *FeatureFlagOverrideRepositoryMongo) Upsert(ctx context.Context, override *entity.FeatureFlagOverride) error {
+	overrideModel := model.FeatureFlagOverrideFromEntity(override)
+
+	if err := r.datasource.Upsert(ctx, overrideModel); err != nil {
+		return err
+	}
+
+	override.UpdatedAt = overrideModel.UpdatedAt
+	return nil
+}
+
+// Delete removes the override for a flag
+func (r *FeatureFlagOverrideRepositoryMongo) Delete(ctx context.Context, key string) (bool, error) {
+	return r.datasource.Delete(ctx, key)
+}
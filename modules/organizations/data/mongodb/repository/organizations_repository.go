@@ -29,18 +29,39 @@ func NewOrganizationRepositoryMongo(ds *datasource.MongoOrganizationDatasource)
 
 // FindAll retrieves organizations with filtering and pagination
 func (r *OrganizationRepositoryMongo) FindAll(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Organization, int64, error) {
-	organizationModels, totalCount, err := r.datasource.FindByFilters(ctx, filter, page, limit)
+	organizations, info, err := r.FindPage(ctx, filter, models.ListOptions{Page: page, Limit: limit, Count: models.CountExact})
 	if err != nil {
 		return nil, 0, err
 	}
+	return organizations, *info.Total, nil
+}
+
+// FindPage retrieves the page of organizations matching filter selected by opts
+func (r *OrganizationRepositoryMongo) FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Organization, *models.PageInfo, error) {
+	organizationModels, info, err := r.datasource.FindByFilters(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	organizationEntities := make([]*entity.Organization, len(organizationModels))
 	for i, model := range organizationModels {
 		entity := model.ToEntity()
 		organizationEntities[i] = &entity
 	}
+	return organizationEntities, info, nil
+}
+
+// Stream implements repository.OrganizationRepository.
+func (r *OrganizationRepositoryMongo) Stream(ctx context.Context, filter map[string]interface{}, sort []models.SortField, fn func(*entity.Organization) error) error {
+	return r.datasource.Stream(ctx, filter, sort, func(m *model.OrganizationModel) error {
+		organization := m.ToEntity()
+		return fn(&organization)
+	})
+}
 
-	return organizationEntities, totalCount, nil
+// Count implements repository.OrganizationRepository.
+func (r *OrganizationRepositoryMongo) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return r.datasource.Count(ctx, filter)
 }
 
 // FindByID finds an organization by its ID
@@ -92,7 +113,22 @@ func (r *OrganizationRepositoryMongo) Create(ctx context.Context, organization *
 // Update updates an existing organization
 func (r *OrganizationRepositoryMongo) Update(ctx context.Context, organization *entity.Organization) error {
 	organizationModel := model.FromEntity(organization)
-	return r.datasource.Update(ctx, organizationModel)
+	if err := r.datasource.Update(ctx, organizationModel); err != nil {
+		return err
+	}
+	organization.Version = organizationModel.Version
+	return nil
+}
+
+// Patch writes only the fields of updated that differ from original.
+func (r *OrganizationRepositoryMongo) Patch(ctx context.Context, original, updated *entity.Organization) error {
+	updatedModel := model.FromEntity(updated)
+	if err := r.datasource.Patch(ctx, model.FromEntity(original), updatedModel); err != nil {
+		return err
+	}
+	updated.UpdatedAt = updatedModel.UpdatedAt
+	updated.Version = updatedModel.Version
+	return nil
 }
 
 // SoftDelete marks an organization as deleted without removing it from the database
@@ -165,8 +201,8 @@ func (r *OrganizationRepositoryMongo) BulkSoftDelete(ctx context.Context, ids []
 }
 
 // UpdateStatus updates the status of an organization
-func (r *OrganizationRepositoryMongo) UpdateStatus(ctx context.Context, id primitive.ObjectID, status string) error {
-	return r.datasource.UpdateStatus(ctx, id, status)
+func (r *OrganizationRepositoryMongo) UpdateStatus(ctx context.Context, id primitive.ObjectID, status string, version int64) error {
+	return r.datasource.UpdateStatus(ctx, id, status, version)
 }
 
 
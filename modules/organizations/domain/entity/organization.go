@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 )
 
 // Validation errors
@@ -37,6 +39,8 @@ type Organization struct {
 	TaxIDs        []string  `json:"taxIds" bson:"taxIds" example:"['GST123456', 'PAN1234567']"`
 	// URL to organization logo
 	Logo          string    `json:"logo" bson:"logo" example:"https://storage.example.com/logos/wecare.png"`
+	// Resized copies of the logo by variant name (thumb, card, full)
+	LogoVariants  models.ImageVariants `json:"logoVariants,omitempty" bson:"logoVariants,omitempty"`
 	// Physical address
 	Address       Address   `json:"address" bson:"address"`
 	// Current status (Pending, Approved, Suspended, Archived)
@@ -45,6 +49,7 @@ type Organization struct {
 	CreatedAt     time.Time `json:"createdAt" bson:"createdAt"`
 	// Last update timestamp
 	UpdatedAt     time.Time `json:"updatedAt" bson:"updatedAt"`
+	Version       int64     `json:"version" bson:"version"`
 	// Soft delete timestamp
 	DeletedAt     *time.Time `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
@@ -67,4 +72,10 @@ type Address struct {
 // IsDeleted returns true if the organization is soft-deleted
 func (o *Organization) IsDeleted() bool {
 	return o.DeletedAt != nil
+}
+
+// ResolveFileURLs replaces the stored logo keys with the URLs returned by resolve, for responses
+func (o *Organization) ResolveFileURLs(resolve func(string) string) {
+	o.Logo = resolve(o.Logo)
+	o.LogoVariants = o.LogoVariants.Map(resolve)
 }
\ No newline at end of file
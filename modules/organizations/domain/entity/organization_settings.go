@@ -0,0 +1,200 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	// Embed the IANA timezone database so timezone validation works in minimal images
+	_ "time/tzdata"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Settings validation errors
+var (
+	ErrInvalidCurrency      = errors.New("default currency must be a 3-letter ISO 4217 code")
+	ErrInvalidTimezone      = errors.New("timezone must be a valid IANA timezone name")
+	ErrInvalidBrandingColor = errors.New("branding colours must be hex values like #1A2B3C")
+	ErrInvalidUploadType    = errors.New("allowed upload types must be MIME types like image/png")
+	ErrUnknownFeatureFlag   = errors.New("unknown feature flag")
+)
+
+// Known feature flags
+const (
+	FeatureFlagBulkImport     = "bulk_import"
+	FeatureFlagCustomBranding = "custom_branding"
+	FeatureFlagVideoUploads   = "video_uploads"
+	FeatureFlagAdvancedSearch = "advanced_search"
+	FeatureFlagDataExport     = "data_export"
+)
+
+// DefaultFeatureFlags holds the value of every known flag when neither the
+// organization nor a global override sets it
+var DefaultFeatureFlags = map[string]bool{
+	FeatureFlagBulkImport:     false,
+	FeatureFlagCustomBranding: true,
+	FeatureFlagVideoUploads:   true,
+	FeatureFlagAdvancedSearch: false,
+	FeatureFlagDataExport:     false,
+}
+
+// DefaultAllowedUploadTypes mirrors the file types accepted by the media handlers
+var DefaultAllowedUploadTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"image/svg+xml",
+	"video/mp4",
+	"video/quicktime",
+}
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	colorPattern    = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	mimeTypePattern = regexp.MustCompile(`^[a-z]+/[a-z0-9.+-]+$`)
+)
+
+// OrganizationSettings holds tenant-level configuration for an organization
+// @Description Tenant-level configuration and feature flags for an organization
+type OrganizationSettings struct {
+	// Unique identifier for the settings document
+	ID primitive.ObjectID `json:"id" bson:"_id,omitempty" example:"5f8d0c1b7ea3f0d0f3c8e1c0"`
+	// Organization these settings belong to
+	OrganizationID primitive.ObjectID `json:"organizationId" bson:"organizationId" example:"5f8d0c1b7ea3f0d0f3c8e1b9"`
+	// Default currency (ISO 4217)
+	DefaultCurrency string `json:"defaultCurrency" bson:"defaultCurrency" example:"INR"`
+	// Default timezone (IANA name)
+	Timezone string `json:"timezone" bson:"timezone" example:"Asia/Kolkata"`
+	// Branding colours
+	Branding Branding `json:"branding" bson:"branding"`
+	// MIME types the organization may upload
+	AllowedUploadTypes []string `json:"allowedUploadTypes" bson:"allowedUploadTypes" example:"image/jpeg,image/png"`
+	// Per-tenant feature flags
+	FeatureFlags map[string]bool `json:"featureFlags" bson:"featureFlags"`
+	// ID of the user who last changed the settings
+	UpdatedBy string `json:"updatedBy,omitempty" bson:"updatedBy,omitempty" example:"6824886e6b180b753cea43e9"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+}
+
+// Branding represents the organization's brand colours
+// @Description Brand colours used by white-labelled clients
+type Branding struct {
+	// Primary brand colour
+	PrimaryColor string `json:"primaryColor" bson:"primaryColor" example:"#0B5FFF"`
+	// Secondary brand colour
+	SecondaryColor string `json:"secondaryColor" bson:"secondaryColor" example:"#F5A623"`
+	// Accent colour
+	AccentColor string `json:"accentColor" bson:"accentColor" example:"#22C55E"`
+}
+
+// DefaultOrganizationSettings returns the settings used when an organization has none stored
+func DefaultOrganizationSettings(organizationID primitive.ObjectID) *OrganizationSettings {
+	flags := make(map[string]bool, len(DefaultFeatureFlags))
+	for key, value := range DefaultFeatureFlags {
+		flags[key] = value
+	}
+
+	uploadTypes := make([]string, len(DefaultAllowedUploadTypes))
+	copy(uploadTypes, DefaultAllowedUploadTypes)
+
+	return &OrganizationSettings{
+		OrganizationID:  organizationID,
+		DefaultCurrency: "INR",
+		Timezone:        "Asia/Kolkata",
+		Branding: Branding{
+			PrimaryColor:   "#0B5FFF",
+			SecondaryColor: "#F5A623",
+			AccentColor:    "#22C55E",
+		},
+		AllowedUploadTypes: uploadTypes,
+		FeatureFlags:       flags,
+	}
+}
+
+// Validate checks the settings against the schema rules
+func (s *OrganizationSettings) Validate() error {
+	if !IsValidCurrency(s.DefaultCurrency) {
+		return ErrInvalidCurrency
+	}
+
+	if !IsValidTimezone(s.Timezone) {
+		return ErrInvalidTimezone
+	}
+
+	for _, color := range []string{s.Branding.PrimaryColor, s.Branding.SecondaryColor, s.Branding.AccentColor} {
+		if color != "" && !colorPattern.MatchString(color) {
+			return ErrInvalidBrandingColor
+		}
+	}
+
+	for _, uploadType := range s.AllowedUploadTypes {
+		if !mimeTypePattern.MatchString(uploadType) {
+			return ErrInvalidUploadType
+		}
+	}
+
+	for key := range s.FeatureFlags {
+		if !IsKnownFeatureFlag(key) {
+			return ErrUnknownFeatureFlag
+		}
+	}
+
+	return nil
+}
+
+// IsUploadTypeAllowed reports whether the given MIME type may be uploaded
+func (s *OrganizationSettings) IsUploadTypeAllowed(contentType string) bool {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	for _, allowed := range s.AllowedUploadTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// FlagValue returns the organization's value for a flag, falling back to the default
+func (s *OrganizationSettings) FlagValue(flag string) bool {
+	if value, ok := s.FeatureFlags[flag]; ok {
+		return value
+	}
+	return DefaultFeatureFlags[flag]
+}
+
+// IsValidCurrency reports whether code looks like an ISO 4217 currency code
+func IsValidCurrency(code string) bool {
+	return currencyPattern.MatchString(code)
+}
+
+// IsValidTimezone reports whether name is a loadable IANA timezone
+func IsValidTimezone(name string) bool {
+	if strings.TrimSpace(name) == "" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// IsKnownFeatureFlag reports whether flag is a registered feature flag
+func IsKnownFeatureFlag(flag string) bool {
+	_, ok := DefaultFeatureFlags[flag]
+	return ok
+}
+
+// FeatureFlagOverride is a platform-wide value that wins over every tenant's setting
+// @Description Global feature flag override set by platform admins
+type FeatureFlagOverride struct {
+	// Feature flag key
+	Key string `json:"key" bson:"key" example:"bulk_import"`
+	// Forced value for every organization
+	Enabled bool `json:"enabled" bson:"enabled" example:"true"`
+	// ID of the platform admin who set the override
+	UpdatedBy string `json:"updatedBy,omitempty" bson:"updatedBy,omitempty" example:"6824886e6b180b753cea43e9"`
+	// Last update timestamp
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+}
@@ -13,6 +13,16 @@ type OrganizationRepository interface {
 	// FindAll retrieves organizations with filtering and pagination
 	// The filter should exclude soft-deleted organizations by default
 	FindAll(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Organization, int64, error)
+
+	// FindPage retrieves the page of organizations matching filter selected by opts, by page
+	// number or cursor
+	FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Organization, *models.PageInfo, error)
+
+	// Stream calls fn for every organization matching filter in sort order, without loading them all
+	Stream(ctx context.Context, filter map[string]interface{}, sort []models.SortField, fn func(*entity.Organization) error) error
+
+	// Count counts the organizations matching filter
+	Count(ctx context.Context, filter map[string]interface{}) (int64, error)
 	
 	// FindByID finds an organization by its ID
 	// This should not return soft-deleted organizations unless explicitly asked
@@ -27,6 +37,10 @@ type OrganizationRepository interface {
 	
 	// Update updates an existing organization
 	Update(ctx context.Context, organization *entity.Organization) error
+
+	// Patch writes only the fields of updated that differ from original. It fails with
+	// models.ErrVersionConflict if the organization changed after original was read.
+	Patch(ctx context.Context, original, updated *entity.Organization) error
 	
 	// SoftDelete marks an organization as deleted without removing it from the database
 	SoftDelete(ctx context.Context, id primitive.ObjectID) (bool, error)
@@ -40,8 +54,9 @@ type OrganizationRepository interface {
 	// BulkSoftDelete marks multiple organizations as deleted
 	BulkSoftDelete(ctx context.Context, ids []string) (*models.BulkDeleteResponse, error)
 	
-	// UpdateStatus updates the status of an organization
-	UpdateStatus(ctx context.Context, id primitive.ObjectID, status string) error
+	// UpdateStatus updates the status of an organization still at the expected version,
+	// returning models.ErrVersionConflict when it has changed
+	UpdateStatus(ctx context.Context, id primitive.ObjectID, status string, version int64) error
 
 	BulkRestore(ctx context.Context, ids []string) (*models.BulkRestoreResponse, error) 
 	
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OrganizationSettingsRepository defines the interface for organization settings persistence
+type OrganizationSettingsRepository interface {
+	// FindByOrganizationID returns the stored settings for an organization
+	// Returns nil when the organization has no settings document yet
+	FindByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) (*entity.OrganizationSettings, error)
+
+	// Upsert creates or replaces the settings document for an organization
+	Upsert(ctx context.Context, settings *entity.OrganizationSettings) error
+
+	// DeleteByOrganizationID removes the settings document for an organization
+	DeleteByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) (bool, error)
+}
+
+// FeatureFlagOverrideRepository defines the interface for global feature flag overrides
+type FeatureFlagOverrideRepository interface {
+	// FindAll returns every global override
+	FindAll(ctx context.Context) ([]*entity.FeatureFlagOverride, error)
+
+	// Upsert creates or replaces the override for a flag
+	Upsert(ctx context.Context, override *entity.FeatureFlagOverride) error
+
+	// Delete removes the override for a flag
+	Delete(ctx context.Context, key string) (bool, error)
+}
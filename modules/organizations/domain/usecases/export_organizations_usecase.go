@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+)
+
+// ExportOrganizationsUseCase reads every organization matching a list filter, for exports
+type ExportOrganizationsUseCase struct {
+	repo repository.OrganizationRepository
+}
+
+func NewExportOrganizationsUseCase(repo repository.OrganizationRepository) *ExportOrganizationsUseCase {
+	return &ExportOrganizationsUseCase{
+		repo: repo,
+	}
+}
+
+// Count counts the organizations an export of filter will contain
+func (uc *ExportOrganizationsUseCase) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return uc.repo.Count(ctx, filter)
+}
+
+// Execute calls write for each organization matching filter, in sort order
+func (uc *ExportOrganizationsUseCase) Execute(ctx context.Context, filter map[string]interface{}, sort []models.SortField, write func(*entity.Organization) error) error {
+	return uc.repo.Stream(ctx, filter, sort, write)
+}
@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FeatureFlagEvaluator resolves the effective value of a feature flag for a tenant.
+//
+// Precedence: global override set by a platform admin, then the organization's
+// own setting, then the flag's default.
+type FeatureFlagEvaluator struct {
+	getSettings   *GetOrganizationSettingsUseCase
+	listOverrides *ListFeatureFlagOverridesUseCase
+}
+
+func NewFeatureFlagEvaluator(getSettings *GetOrganizationSettingsUseCase, listOverrides *ListFeatureFlagOverridesUseCase) *FeatureFlagEvaluator {
+	return &FeatureFlagEvaluator{
+		getSettings:   getSettings,
+		listOverrides: listOverrides,
+	}
+}
+
+// IsEnabled reports whether flag is enabled for the organization.
+// An empty or invalid organization ID evaluates against overrides and defaults only.
+func (e *FeatureFlagEvaluator) IsEnabled(ctx context.Context, organizationID string, flag string) (bool, error) {
+	if !entity.IsKnownFeatureFlag(flag) {
+		return false, entity.ErrUnknownFeatureFlag
+	}
+
+	flags, err := e.EvaluateAll(ctx, organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	return flags[flag], nil
+}
+
+// EvaluateAll returns the effective value of every known flag for the organization
+func (e *FeatureFlagEvaluator) EvaluateAll(ctx context.Context, organizationID string) (map[string]bool, error) {
+	flags := make(map[string]bool, len(entity.DefaultFeatureFlags))
+	for key, value := range entity.DefaultFeatureFlags {
+		flags[key] = value
+	}
+
+	if objectID, err := primitive.ObjectIDFromHex(organizationID); err == nil {
+		settings, err := e.getSettings.Execute(ctx, objectID)
+		if err != nil {
+			return nil, err
+		}
+		for key := range flags {
+			flags[key] = settings.FlagValue(key)
+		}
+	}
+
+	overrides, err := e.listOverrides.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range overrides {
+		if _, known := flags[override.Key]; known {
+			flags[override.Key] = override.Enabled
+		}
+	}
+
+	return flags, nil
+}
@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+)
+
+const (
+	featureFlagOverridesCacheKey = "feature_flags:overrides"
+	featureFlagOverridesCacheTTL = 5 * time.Minute
+)
+
+// ListFeatureFlagOverridesUseCase returns the global feature flag overrides
+type ListFeatureFlagOverridesUseCase struct {
+	repo  repository.FeatureFlagOverrideRepository
+	cache services.CacheService
+}
+
+func NewListFeatureFlagOverridesUseCase(repo repository.FeatureFlagOverrideRepository, cache services.CacheService) *ListFeatureFlagOverridesUseCase {
+	return &ListFeatureFlagOverridesUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute returns every global override
+func (uc *ListFeatureFlagOverridesUseCase) Execute(ctx context.Context) ([]*entity.FeatureFlagOverride, error) {
+	if uc.cache != nil {
+		var cached []*entity.FeatureFlagOverride
+		if found, err := uc.cache.Get(ctx, featureFlagOverridesCacheKey, &cached); err == nil && found {
+			return cached, nil
+		}
+	}
+
+	overrides, err := uc.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.Set(ctx, featureFlagOverridesCacheKey, overrides, featureFlagOverridesCacheTTL)
+	}
+
+	return overrides, nil
+}
+
+// SetFeatureFlagOverrideUseCase creates or replaces a global feature flag override
+type SetFeatureFlagOverrideUseCase struct {
+	repo  repository.FeatureFlagOverrideRepository
+	cache services.CacheService
+}
+
+func NewSetFeatureFlagOverrideUseCase(repo repository.FeatureFlagOverrideRepository, cache services.CacheService) *SetFeatureFlagOverrideUseCase {
+	return &SetFeatureFlagOverrideUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute stores the override and invalidates the cached override list
+func (uc *SetFeatureFlagOverrideUseCase) Execute(ctx context.Context, override *entity.FeatureFlagOverride) error {
+	if !entity.IsKnownFeatureFlag(override.Key) {
+		return entity.ErrUnknownFeatureFlag
+	}
+
+	if err := uc.repo.Upsert(ctx, override); err != nil {
+		return err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.Delete(ctx, featureFlagOverridesCacheKey)
+	}
+
+	return nil
+}
+
+// DeleteFeatureFlagOverrideUseCase removes a global feature flag override
+type DeleteFeatureFlagOverrideUseCase struct {
+	repo  repository.FeatureFlagOverrideRepository
+	cache services.CacheService
+}
+
+func NewDeleteFeatureFlagOverrideUseCase(repo repository.FeatureFlagOverrideRepository, cache services.CacheService) *DeleteFeatureFlagOverrideUseCase {
+	return &DeleteFeatureFlagOverrideUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute removes the override so tenants fall back to their own setting
+func (uc *DeleteFeatureFlagOverrideUseCase) Execute(ctx context.Context, key string) (bool, error) {
+	deleted, err := uc.repo.Delete(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	if deleted && uc.cache != nil {
+		_ = uc.cache.Delete(ctx, featureFlagOverridesCacheKey)
+	}
+
+	return deleted, nil
+}
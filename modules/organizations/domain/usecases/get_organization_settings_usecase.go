@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// organizationSettingsCacheTTL controls how long settings stay in Redis
+const organizationSettingsCacheTTL = 10 * time.Minute
+
+// organizationSettingsCacheKey returns the cache key for an organization's settings
+func organizationSettingsCacheKey(organizationID primitive.ObjectID) string {
+	return "org_settings:" + organizationID.Hex()
+}
+
+// GetOrganizationSettingsUseCase implements the organization settings lookup
+type GetOrganizationSettingsUseCase struct {
+	repo  repository.OrganizationSettingsRepository
+	cache services.CacheService
+}
+
+func NewGetOrganizationSettingsUseCase(repo repository.OrganizationSettingsRepository, cache services.CacheService) *GetOrganizationSettingsUseCase {
+	return &GetOrganizationSettingsUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute returns the organization's settings, or the defaults when none are stored
+func (uc *GetOrganizationSettingsUseCase) Execute(ctx context.Context, organizationID primitive.ObjectID) (*entity.OrganizationSettings, error) {
+	key := organizationSettingsCacheKey(organizationID)
+
+	if uc.cache != nil {
+		var cached entity.OrganizationSettings
+		if found, err := uc.cache.Get(ctx, key, &cached); err == nil && found {
+			return &cached, nil
+		}
+	}
+
+	settings, err := uc.repo.FindByOrganizationID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings == nil {
+		settings = entity.DefaultOrganizationSettings(organizationID)
+	}
+
+	if uc.cache != nil {
+		// Cache failures are not fatal, the next read will hit Mongo again
+		_ = uc.cache.Set(ctx, key, settings, organizationSettingsCacheTTL)
+	}
+
+	return settings, nil
+}
+
+// IsUploadTypeAllowed reports whether the organization's settings allow files of contentType
+func (uc *GetOrganizationSettingsUseCase) IsUploadTypeAllowed(ctx context.Context, organizationID primitive.ObjectID, contentType string) (bool, error) {
+	settings, err := uc.Execute(ctx, organizationID)
+	if err != nil {
+		return false, err
+	}
+	return settings.IsUploadTypeAllowed(contentType), nil
+}
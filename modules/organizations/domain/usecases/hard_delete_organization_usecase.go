@@ -3,22 +3,39 @@ package usecases
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // OrganizationUseCase implements the organization business logic
 type HardDeleteOrganizationUseCase struct {
-	repo repository.OrganizationRepository
+	repo         repository.OrganizationRepository
+	settingsRepo repository.OrganizationSettingsRepository
+	cache        services.CacheService
 }
 
-func NewHardDeleteOrganizationUseCase(repo repository.OrganizationRepository) *HardDeleteOrganizationUseCase {
+func NewHardDeleteOrganizationUseCase(repo repository.OrganizationRepository, settingsRepo repository.OrganizationSettingsRepository, cache services.CacheService) *HardDeleteOrganizationUseCase {
 	return &HardDeleteOrganizationUseCase{
-		repo: repo,
+		repo:         repo,
+		settingsRepo: settingsRepo,
+		cache:        cache,
 	}
 }
 
-// HardDeleteOrganization permanently removes an organization (admin/cleanup only)
+// HardDeleteOrganization permanently removes an organization (admin/cleanup only), along
+// with its settings
 func (uc *HardDeleteOrganizationUseCase) Execute(ctx context.Context, id primitive.ObjectID) (bool, error) {
-	return uc.repo.HardDelete(ctx, id)
+	deleted, err := uc.repo.HardDelete(ctx, id)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+
+	if _, err := uc.settingsRepo.DeleteByOrganizationID(ctx, id); err != nil {
+		return true, err
+	}
+	if uc.cache != nil {
+		_ = uc.cache.Delete(ctx, organizationSettingsCacheKey(id))
+	}
+	return true, nil
 }
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
 )
@@ -19,6 +20,6 @@ func NewListOrganizationUseCase(repo repository.OrganizationRepository) *ListOrg
 }
 
 // ListOrganizations retrieves a list of organizations with pagination
-func (uc *ListOrganizationUseCase) Execute(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Organization, int64, error) {
-	return uc.repo.FindAll(ctx, filter, page, limit)
+func (uc *ListOrganizationUseCase) Execute(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Organization, *models.PageInfo, error) {
+	return uc.repo.FindPage(ctx, filter, opts)
 }
@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+)
+
+// PatchOrganizationUseCase saves a partial update of an organization
+type PatchOrganizationUseCase struct {
+	repo repository.OrganizationRepository
+}
+
+func NewPatchOrganizationUseCase(repo repository.OrganizationRepository) *PatchOrganizationUseCase {
+	return &PatchOrganizationUseCase{
+		repo: repo,
+	}
+}
+
+// Execute writes the fields of updated that differ from original, the organization as it was read
+func (uc *PatchOrganizationUseCase) Execute(ctx context.Context, original, updated *entity.Organization) error {
+	// If the slug is being changed, check for duplicates
+	if updated.Slug != original.Slug && updated.Slug != "" {
+		existingOrg, err := uc.repo.FindBySlug(ctx, updated.Slug)
+		if err != nil {
+			return err
+		}
+		if existingOrg != nil && existingOrg.ID != updated.ID {
+			return errors.New("organization with this slug already exists")
+		}
+	}
+
+	return uc.repo.Patch(ctx, original, updated)
+}
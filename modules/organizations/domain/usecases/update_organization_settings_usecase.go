@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+)
+
+// UpdateOrganizationSettingsUseCase implements the organization settings update
+type UpdateOrganizationSettingsUseCase struct {
+	repo  repository.OrganizationSettingsRepository
+	cache services.CacheService
+}
+
+func NewUpdateOrganizationSettingsUseCase(repo repository.OrganizationSettingsRepository, cache services.CacheService) *UpdateOrganizationSettingsUseCase {
+	return &UpdateOrganizationSettingsUseCase{
+		repo:  repo,
+		cache: cache,
+	}
+}
+
+// Execute validates and stores the settings, then invalidates the cached copy
+func (uc *UpdateOrganizationSettingsUseCase) Execute(ctx context.Context, settings *entity.OrganizationSettings) error {
+	if settings.OrganizationID.IsZero() {
+		return errors.New("organization ID is required")
+	}
+
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Upsert(ctx, settings); err != nil {
+		return err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.Delete(ctx, organizationSettingsCacheKey(settings.OrganizationID))
+	}
+
+	return nil
+}
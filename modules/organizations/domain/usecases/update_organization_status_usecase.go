@@ -19,8 +19,9 @@ func NewUpdateOrganizationStatusUseCase(repo repository.OrganizationRepository)
 	}
 }
 
-// UpdateOrganizationStatus updates the status of an organization
-func (uc *UpdateOrganizationStatusUseCase) Execute(ctx context.Context, id primitive.ObjectID, status string) error {
+// UpdateOrganizationStatus updates the status of an organization, provided it is still at
+// the version the caller read
+func (uc *UpdateOrganizationStatusUseCase) Execute(ctx context.Context, id primitive.ObjectID, status string, version int64) error {
 	// Validate status (should be one of: "Pending", "Approved", "Suspended", "Archived")
 	validStatuses := map[string]bool{
 		"Pending":   true,
@@ -33,5 +34,5 @@ func (uc *UpdateOrganizationStatusUseCase) Execute(ctx context.Context, id primi
 		return errors.New("invalid organization status")
 	}
 
-	return uc.repo.UpdateStatus(ctx, id, status)
+	return uc.repo.UpdateStatus(ctx, id, status, version)
 }
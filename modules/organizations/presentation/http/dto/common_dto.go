@@ -6,6 +6,7 @@ var (
 	ErrNameRequired      = errors.New("organization name is required")
 	ErrEmailRequired     = errors.New("email is required")
 	ErrTypeRequired      = errors.New("organization type is required")
+	ErrStatusRequired    = errors.New("organization status is required")
 	ErrInvalidEmail      = errors.New("invalid email address")
 	ErrInvalidType       = errors.New("invalid organization type (must be SUPPLIER, TRAVEL_AGENT, PLATFORM)")
 	ErrInvalidStatus     = errors.New("invalid organization status: must be one of Pending, Approved, Suspended, or Archived")
@@ -3,9 +3,42 @@ package dto
 import (
 	"strconv"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
 	"github.com/gin-gonic/gin"
 )
 
+// OrganizationResponseFields are the members the fields parameter can select for organizations
+var OrganizationResponseFields = fieldset.Of(entity.Organization{})
+
+// OrganizationFilterFields are the fields organizations can be filtered by with the filter parameter
+var OrganizationFilterFields = listfilter.Fields{
+	"name":      {Path: "name", Type: listfilter.String},
+	"slug":      {Path: "slug", Type: listfilter.String},
+	"type":      {Path: "type", Type: listfilter.String},
+	"status":    {Path: "status", Type: listfilter.String},
+	"email":     {Path: "email", Type: listfilter.String},
+	"phone":     {Path: "phone", Type: listfilter.String},
+	"website":   {Path: "website", Type: listfilter.String},
+	"city":      {Path: "address.city", Type: listfilter.String},
+	"state":     {Path: "address.state", Type: listfilter.String},
+	"country":   {Path: "address.country", Type: listfilter.String},
+	"createdAt": {Path: "createdAt", Type: listfilter.Date},
+	"updatedAt": {Path: "updatedAt", Type: listfilter.Date},
+}
+
+// OrganizationSortFields are the fields organizations can be sorted by
+var OrganizationSortFields = pagination.SortFields{
+	"name":      "name",
+	"slug":      "slug",
+	"type":      "type",
+	"status":    "status",
+	"createdAt": "createdAt",
+	"updatedAt": "updatedAt",
+}
+
 // GetOrganizationsDto defines the query parameters for listing organizations
 type GetOrganizationsDto struct {
 	// Pagination parameters
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+)
+
+// OrganizationExportColumns are the columns an organization export can include, in default order
+var OrganizationExportColumns = export.Columns{
+	{Key: "id", Header: "ID"},
+	{Key: "name", Header: "Name"},
+	{Key: "slug", Header: "Slug"},
+	{Key: "type", Header: "Type"},
+	{Key: "status", Header: "Status"},
+	{Key: "email", Header: "Email"},
+	{Key: "phone", Header: "Phone"},
+	{Key: "website", Header: "Website"},
+	{Key: "taxIds", Header: "Tax IDs"},
+	{Key: "street", Header: "Street"},
+	{Key: "city", Header: "City"},
+	{Key: "state", Header: "State"},
+	{Key: "country", Header: "Country"},
+	{Key: "pincode", Header: "Pincode"},
+	{Key: "createdAt", Header: "Created At"},
+	{Key: "updatedAt", Header: "Updated At"},
+	{Key: "deletedAt", Header: "Deleted At"},
+}
+
+// OrganizationExportRow returns the export values of an organization keyed by column key
+func OrganizationExportRow(org *entity.Organization) map[string]string {
+	return map[string]string{
+		"id":        org.ID.Hex(),
+		"name":      org.Name,
+		"slug":      org.Slug,
+		"type":      org.Type,
+		"status":    org.Status,
+		"email":     org.Email,
+		"phone":     org.Phone,
+		"website":   org.Website,
+		"taxIds":    export.List(org.TaxIDs),
+		"street":    org.Address.Street,
+		"city":      org.Address.City,
+		"state":     org.Address.State,
+		"country":   org.Address.Country,
+		"pincode":   org.Address.Pincode,
+		"createdAt": export.Time(org.CreatedAt),
+		"updatedAt": export.Time(org.UpdatedAt),
+		"deletedAt": export.OptionalTime(org.DeletedAt),
+	}
+}
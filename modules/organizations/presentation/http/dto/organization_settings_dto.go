@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"errors"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+)
+
+var ErrEnabledRequired = errors.New("enabled is required")
+
+// BrandingDto represents brand colour updates
+type BrandingDto struct {
+	PrimaryColor   *string `json:"primaryColor,omitempty" example:"#0B5FFF"`
+	SecondaryColor *string `json:"secondaryColor,omitempty" example:"#F5A623"`
+	AccentColor    *string `json:"accentColor,omitempty" example:"#22C55E"`
+}
+
+// UpdateOrganizationSettingsDto defines the fields for updating organization settings
+// All fields are optional to support partial updates
+type UpdateOrganizationSettingsDto struct {
+	DefaultCurrency    *string         `json:"defaultCurrency,omitempty" example:"INR"`
+	Timezone           *string         `json:"timezone,omitempty" example:"Asia/Kolkata"`
+	Branding           *BrandingDto    `json:"branding,omitempty"`
+	AllowedUploadTypes []string        `json:"allowedUploadTypes,omitempty" example:"image/jpeg,image/png"`
+	FeatureFlags       map[string]bool `json:"featureFlags,omitempty"`
+}
+
+// Validate performs validation on the UpdateOrganizationSettingsDto
+func (dto *UpdateOrganizationSettingsDto) Validate() error {
+	if dto.DefaultCurrency != nil && !entity.IsValidCurrency(strings.ToUpper(*dto.DefaultCurrency)) {
+		return entity.ErrInvalidCurrency
+	}
+
+	if dto.Timezone != nil && !entity.IsValidTimezone(*dto.Timezone) {
+		return entity.ErrInvalidTimezone
+	}
+
+	for key := range dto.FeatureFlags {
+		if !entity.IsKnownFeatureFlag(key) {
+			return entity.ErrUnknownFeatureFlag
+		}
+	}
+
+	return nil
+}
+
+// ApplyUpdates applies the update DTO to existing settings
+// Only updates fields that are provided in the DTO
+func (dto *UpdateOrganizationSettingsDto) ApplyUpdates(settings *entity.OrganizationSettings) {
+	if dto.DefaultCurrency != nil {
+		settings.DefaultCurrency = strings.ToUpper(*dto.DefaultCurrency)
+	}
+	if dto.Timezone != nil {
+		settings.Timezone = *dto.Timezone
+	}
+	if dto.Branding != nil {
+		if dto.Branding.PrimaryColor != nil {
+			settings.Branding.PrimaryColor = *dto.Branding.PrimaryColor
+		}
+		if dto.Branding.SecondaryColor != nil {
+			settings.Branding.SecondaryColor = *dto.Branding.SecondaryColor
+		}
+		if dto.Branding.AccentColor != nil {
+			settings.Branding.AccentColor = *dto.Branding.AccentColor
+		}
+	}
+	if dto.AllowedUploadTypes != nil {
+		uploadTypes := make([]string, 0, len(dto.AllowedUploadTypes))
+		for _, uploadType := range dto.AllowedUploadTypes {
+			uploadTypes = append(uploadTypes, strings.ToLower(strings.TrimSpace(uploadType)))
+		}
+		settings.AllowedUploadTypes = uploadTypes
+	}
+	if dto.FeatureFlags != nil {
+		if settings.FeatureFlags == nil {
+			settings.FeatureFlags = make(map[string]bool, len(dto.FeatureFlags))
+		}
+		for key, value := range dto.FeatureFlags {
+			settings.FeatureFlags[key] = value
+		}
+	}
+}
+
+// SetFeatureFlagOverrideDto defines the body for setting a global feature flag override
+type SetFeatureFlagOverrideDto struct {
+	Enabled *bool `json:"enabled" binding:"required" example:"true"`
+}
+
+// Validate performs validation on the SetFeatureFlagOverrideDto
+func (dto *SetFeatureFlagOverrideDto) Validate() error {
+	if dto.Enabled == nil {
+		return ErrEnabledRequired
+	}
+	return nil
+}
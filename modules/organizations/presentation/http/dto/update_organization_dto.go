@@ -2,6 +2,7 @@ package dto
 
 import (
 	"net/mail"
+	"strings"
 
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,7 +18,7 @@ type UpdateOrganizationDto struct {
 	Email         *string    `json:"email,omitempty"`
 	Phone         *string    `json:"phone,omitempty"`
 	Website       *string    `json:"website,omitempty"`
-	TaxIDs        []string   `json:"taxIds,omitempty"`
+	TaxIDs        []string   `json:"taxIds"`
 	Logo          *string    `json:"logo,omitempty"`
 	Address       AddressDto `json:"address,omitempty"`
 	Status        *string    `json:"status,omitempty"`
@@ -75,6 +76,63 @@ func (dto *UpdateOrganizationDto) Validate() error {
 	return nil
 }
 
+// ValidatePatch validates the fields changed by a patch. Removing a field, or setting it
+// to null, clears it, so the required fields must keep a value.
+func (dto *UpdateOrganizationDto) ValidatePatch() error {
+	required := []struct {
+		value *string
+		err   error
+	}{
+		{dto.Name, ErrNameRequired},
+		{dto.Email, ErrEmailRequired},
+		{dto.Type, ErrTypeRequired},
+		{dto.Status, ErrStatusRequired},
+	}
+	for _, field := range required {
+		if field.value != nil && strings.TrimSpace(*field.value) == "" {
+			return field.err
+		}
+	}
+	return dto.Validate()
+}
+
+// ValidateOrganization checks a whole organization against the rules it was created
+// with, and requires its status
+func ValidateOrganization(org *entity.Organization) error {
+	whole := CreateOrganizationDto{Name: org.Name, Email: org.Email, Type: org.Type, Status: org.Status}
+	if err := whole.Validate(); err != nil {
+		return err
+	}
+	if org.Status == "" {
+		return ErrStatusRequired
+	}
+	return nil
+}
+
+// NewUpdateOrganizationDto returns every updatable field of an organization. Partial
+// updates are applied to this document, then only the changed fields are applied back.
+func NewUpdateOrganizationDto(org *entity.Organization) *UpdateOrganizationDto {
+	current := *org
+	return &UpdateOrganizationDto{
+		Name:    &current.Name,
+		Slug:    &current.Slug,
+		Type:    &current.Type,
+		Email:   &current.Email,
+		Phone:   &current.Phone,
+		Website: &current.Website,
+		TaxIDs:  append([]string{}, current.TaxIDs...),
+		Logo:    &current.Logo,
+		Address: AddressDto{
+			Street:  &current.Address.Street,
+			City:    &current.Address.City,
+			State:   &current.Address.State,
+			Country: &current.Address.Country,
+			Pincode: &current.Address.Pincode,
+		},
+		Status: &current.Status,
+	}
+}
+
 // ApplyUpdates applies the update DTO to an existing organization entity
 // Only updates fields that are provided in the DTO
 func (dto *UpdateOrganizationDto) ApplyUpdates(org *entity.Organization) {
@@ -100,8 +158,10 @@ func (dto *UpdateOrganizationDto) ApplyUpdates(org *entity.Organization) {
 	if dto.TaxIDs != nil {
 		org.TaxIDs = dto.TaxIDs
 	}
-	if dto.Logo != nil {
+	if dto.Logo != nil && *dto.Logo != org.Logo {
 		org.Logo = *dto.Logo
+		// Variants belong to the previous logo
+		org.LogoVariants = nil
 	}
 	if dto.Status != nil {
 		org.Status = *dto.Status
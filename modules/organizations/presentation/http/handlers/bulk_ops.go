@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
+)
+
+// BulkOps returns the operations of the organizations bulk endpoint. Like the single
+// organization routes, callers other than global admins may only change their own
+// organization; other IDs fail with a forbidden result.
+func (h *OrganizationHandler) BulkOps(c *gin.Context) bulk.Ops {
+	ops := bulk.Ops{
+		"update":  {Action: "update", Prepare: h.bulkUpdate},
+		"status":  {Action: "update", Prepare: h.bulkStatus},
+		"delete":  {Action: "delete", Prepare: h.bulkDelete},
+		"restore": {Action: "restore", Prepare: h.bulkRestore},
+	}
+
+	scoped, err := middleware.GetScopedRBACContext(c)
+	if err == nil && scoped.IsGlobalAdmin {
+		return ops
+	}
+	ownOrgID := ""
+	if scoped != nil {
+		ownOrgID = scoped.OrganizationID
+	}
+	return ops.Restrict(func(ctx context.Context, id primitive.ObjectID) error {
+		if ownOrgID == "" || id.Hex() != ownOrgID {
+			return middleware.NewAppError(middleware.ErrorCodeForbidden, "Access denied to this organization", nil, http.StatusForbidden)
+		}
+		return nil
+	})
+}
+
+// bulkUpdate applies data to each organization as a JSON Merge Patch, like PatchOrganization
+func (h *OrganizationHandler) bulkUpdate(data json.RawMessage) (bulk.Apply, error) {
+	var fields map[string]json.RawMessage
+	if err := bulk.DecodeData(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("data must change at least one field")
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		org, err := h.bulkOrganization(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var changes dto.UpdateOrganizationDto
+		if err := patch.ApplyTo(patch.ContentTypeMergePatch, dto.NewUpdateOrganizationDto(org), data, &changes); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest)
+		}
+		if err := changes.ValidatePatch(); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+		}
+		if changes.Logo != nil {
+			logo := services.FileKey(h.fileService, *changes.Logo)
+			changes.Logo = &logo
+		}
+
+		original := *org
+		changes.ApplyUpdates(org)
+		if err := dto.ValidateOrganization(org); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+		}
+		if err := h.PatchOrganizationUseCase.Execute(ctx, &original, org); err != nil {
+			return middleware.NewVersionConflictAppError(err, "Failed to update organization")
+		}
+		return nil
+	}, nil
+}
+
+func (h *OrganizationHandler) bulkStatus(data json.RawMessage) (bulk.Apply, error) {
+	var status dto.OrgStatusUpdateDto
+	if err := bulk.DecodeData(data, &status); err != nil {
+		return nil, err
+	}
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		organization, err := h.bulkOrganization(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := h.UpdateOrganizationStatusUseCase.Execute(ctx, id, status.Status, organization.Version); err != nil {
+			return middleware.NewVersionConflictAppError(err, "Failed to update organization status")
+		}
+		return nil
+	}, nil
+}
+
+func (h *OrganizationHandler) bulkDelete(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		deleted, err := h.SoftDeleteOrganizationUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to delete organization", err, http.StatusInternalServerError)
+		}
+		if !deleted {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Organization not found or already deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+func (h *OrganizationHandler) bulkRestore(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		restored, err := h.RestoreOrganizationUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to restore organization", err, http.StatusInternalServerError)
+		}
+		if !restored {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Organization not found or not deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+// bulkOrganization returns the organization with id, or a not found error
+func (h *OrganizationHandler) bulkOrganization(ctx context.Context, id primitive.ObjectID) (*entity.Organization, error) {
+	org, err := h.GetOrganizationUseCase.Execute(ctx, id)
+	if err != nil {
+		return nil, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch organization", err, http.StatusInternalServerError)
+	}
+	if org == nil {
+		return nil, middleware.NewAppError(middleware.ErrorCodeNotFound, "Organization not found", nil, http.StatusNotFound)
+	}
+	return org, nil
+}
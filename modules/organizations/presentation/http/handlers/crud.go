@@ -3,6 +3,8 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
 	"github.com/gin-gonic/gin"
@@ -17,7 +19,10 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"Organization ID"	example("6824886e6b180b753cea43e9")
+//	@Param			fields	query		string	false	"Comma separated members to return, the ID is always included"	example(name,status)
+//	@Param			If-None-Match	header		string	false	"ETag from an earlier read, answered with 304 if the organization is unchanged"
 //	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.Organization}
+//	@Header			200	{string}	ETag	"Version of the organization, send it as If-Match when updating"
 //	@Failure		400	{object}	models.SwaggerErrorResponse
 //	@Failure		404	{object}	models.SwaggerErrorResponse
 //	@Failure		500	{object}	models.SwaggerErrorResponse
@@ -25,6 +30,12 @@ import (
 func (h *OrganizationHandler) GetOrganization(c *gin.Context) {
 	id := c.Param("id")
 
+	fields, err := fieldset.FromQuery(c, dto.OrganizationResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		middleware.HandleError(c, middleware.NewAppError(
@@ -57,7 +68,22 @@ func (h *OrganizationHandler) GetOrganization(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, organization)
+	if middleware.NotModified(c, organization.Version) {
+		return
+	}
+
+	h.resolveFileURLs(organization)
+	response, err := fieldset.Select(organization, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch organization",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // CreateOrganization godoc
@@ -97,8 +123,9 @@ func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
 		return
 	}
 
-	// Convert to entity
+	// Convert to entity, storing a logo of this storage by key
 	organization := createDto.ToEntity()
+	organization.Logo = services.FileKey(h.fileService, organization.Logo)
 
 	// Call use case to create
 	if err := h.CreateOrganizationUseCase.Execute(c.Request.Context(), organization); err != nil {
@@ -122,6 +149,7 @@ func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
 		return
 	}
 
+	h.resolveFileURLs(organization)
 	c.JSON(http.StatusCreated, organization)
 }
 
@@ -134,9 +162,13 @@ func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
 //	@Produce		json
 //	@Param			id				path		string						true	"Organization ID"
 //	@Param			organization	body		dto.UpdateOrganizationDto	true	"Organization data to update"
+//	@Param			If-Match	header		string	true	"ETag of the organization being updated"
 //	@Success		200				{object}	models.SwaggerStandardResponse{data=entity.Organization}
+//	@Header			200	{string}	ETag	"New version of the organization"
 //	@Failure		400				{object}	models.SwaggerErrorResponse
 //	@Failure		404				{object}	models.SwaggerErrorResponse
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The organization was changed by another request"
+//	@Failure		428	{object}	models.SwaggerErrorResponse	"If-Match header missing"
 //	@Failure		500				{object}	models.SwaggerErrorResponse
 //	@Router			/organizations/{id} [put]
 func (h *OrganizationHandler) UpdateOrganization(c *gin.Context) {
@@ -175,6 +207,12 @@ func (h *OrganizationHandler) UpdateOrganization(c *gin.Context) {
 		return
 	}
 
+	// Refuse to overwrite changes the client has not seen
+	if appErr := middleware.CheckIfMatch(c, existingOrg.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
 	// Parse update DTO
 	var updateDto dto.UpdateOrganizationDto
 	if err := c.ShouldBindJSON(&updateDto); err != nil {
@@ -198,20 +236,22 @@ func (h *OrganizationHandler) UpdateOrganization(c *gin.Context) {
 		return
 	}
 
-	// Apply updates to the existing organization
+	// Apply updates to the existing organization. A logo URL of this storage, such as one
+	// returned by a previous read, is stored by key.
+	if updateDto.Logo != nil {
+		logo := services.FileKey(h.fileService, *updateDto.Logo)
+		updateDto.Logo = &logo
+	}
 	updateDto.ApplyUpdates(existingOrg)
 
 	// Call use case to update
 	if err := h.UpdateOrganizationUseCase.Execute(c.Request.Context(), existingOrg); err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to update organization",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update organization"))
 		return
 	}
 
+	middleware.SetETag(c, existingOrg.Version)
+	h.resolveFileURLs(existingOrg)
 	c.JSON(http.StatusOK, existingOrg)
 }
 
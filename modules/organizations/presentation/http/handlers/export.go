@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
+)
+
+// ExportSource selects the organizations an export request covers: the same filters and
+// sort as ListOrganizations, limited to the caller's own organization below global scope
+func (h *OrganizationHandler) ExportSource(c *gin.Context) (*export.Source, error) {
+	rbacContext, err := middleware.GetScopedRBACContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	queryDto := dto.NewGetOrganizationsDto(c)
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.OrganizationFilterFields); err != nil {
+		return nil, err
+	}
+	sort, err := pagination.ParseSort(c.Query("sort"), dto.OrganizationSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rbacContext.IsGlobalAdmin {
+		organizationID, _ := primitive.ObjectIDFromHex(rbacContext.OrganizationID)
+		filter["_id"] = organizationID
+	}
+
+	return &export.Source{
+		Count: func(ctx context.Context) (int64, error) {
+			return h.ExportOrganizationsUseCase.Count(ctx, filter)
+		},
+		Rows: func(ctx context.Context, emit func(map[string]string) error) error {
+			return h.ExportOrganizationsUseCase.Execute(ctx, filter, sort, func(org *entity.Organization) error {
+				return emit(dto.OrganizationExportRow(org))
+			})
+		},
+	}, nil
+}
@@ -3,8 +3,11 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/logger"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -20,6 +23,12 @@ import (
 //	@Produce		json
 //	@Param			page			query		int																			false	"Page number"		default(1)
 //	@Param			limit			query		int																			false	"Items per page"	default(10)	maximum(100)
+//	@Param			sort			query		string																		false	"Comma separated sort fields, prefixed with - for descending"	example(-createdAt)
+//	@Param			after			query		string																		false	"Cursor from nextCursor, returns the page after it"
+//	@Param			before			query		string																		false	"Cursor from prevCursor, returns the page before it"
+//	@Param			count			query		string																		false	"How total is computed, exact by default with page numbers and none with cursors"	Enums(exact, estimated, none)
+//	@Param			filter			query		string																		false	"Filter expression, clauses field:operator:value separated by ;"	example(createdAt:gte:2026-01-01)
+//	@Param			fields			query		string																		false	"Comma separated members to return, the ID is always included"	example(name,status)
 //	@Param			name			query		string																		false	"Filter by name (partial match, case-insensitive)"
 //	@Param			slug			query		string																		false	"Filter by slug (partial match, case-insensitive)"
 //	@Param			type			query		string																		false	"Filter by organization type (exact match)"
@@ -52,8 +61,24 @@ func (h *OrganizationHandler) ListOrganizations(c *gin.Context) {
 	// Parse query parameters using DTO
 	queryDto := dto.NewGetOrganizationsDto(c)
 
+	listOpts, err := pagination.FromQuery(c, queryDto.Page, queryDto.Limit, dto.OrganizationSortFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch organizations"))
+		return
+	}
+
 	// Get base filter from DTO
+	fields, err := fieldset.FromQuery(c, dto.OrganizationResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch organizations"))
+		return
+	}
+
 	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.OrganizationFilterFields); err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch organizations"))
+		return
+	}
 
 	// Apply organization scoping for non-global admins
 	if !rbacContext.IsGlobalAdmin {
@@ -73,36 +98,30 @@ func (h *OrganizationHandler) ListOrganizations(c *gin.Context) {
 		zap.Bool("is_global_admin", rbacContext.IsGlobalAdmin))
 
 	// Call use case with filter from DTO
-	organizations, total, err := h.ListOrganizationUseCase.Execute(
+	organizations, pageInfo, err := h.ListOrganizationUseCase.Execute(
 		c.Request.Context(),
 		filter,
-		queryDto.Page,
-		queryDto.Limit,
+		listOpts,
 	)
 
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to fetch organizations",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch organizations"))
 		return
 	}
 
-	response := gin.H{
-		"items":          organizations,
-		"page":           queryDto.Page,
-		"limit":          queryDto.Limit,
-		"total":          total,
-		"totalPages":     (total + int64(queryDto.Limit) - 1) / int64(queryDto.Limit),
-		"includeDeleted": queryDto.IncludeDeleted,
-		"isScoped":       !rbacContext.IsGlobalAdmin, // Indicate if results are scoped
+	h.resolveFileURLs(organizations...)
+	items, err := fieldset.SelectAll(organizations, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch organizations"))
+		return
 	}
+	response := pagination.Response(items, pageInfo)
+	response["includeDeleted"] = queryDto.IncludeDeleted
+	response["isScoped"] = !rbacContext.IsGlobalAdmin // Indicate if results are scoped
 
 	logger.Log.Info("Organizations listed successfully",
 		zap.String("user_id", rbacContext.UserID),
-		zap.Int64("total_found", total),
+		zap.Int("returned", len(organizations)),
 		zap.Bool("is_scoped", !rbacContext.IsGlobalAdmin))
 
 	c.JSON(http.StatusOK, response)
@@ -2,11 +2,11 @@ package handlers
 
 import (
 	"net/http"
-	"path/filepath"
-	"strings"
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -14,7 +14,7 @@ import (
 // UploadOrganizationLogo godoc
 //
 //	@Summary		Upload organization logo
-//	@Description	Upload a new logo for an organization and update its record
+//	@Description	Upload a new logo for an organization and update its record. The file is checked by the upload scanners and rejected files are quarantined. The image type is detected from its content, metadata is stripped, SVGs are sanitised and resized variants are stored in logoVariants.
 //	@Tags			organizations
 //	@Accept			multipart/form-data
 //	@Produce		json
@@ -24,6 +24,8 @@ import (
 //	@Failure		400		{object}	models.SwaggerErrorResponse									"Invalid request"
 //	@Failure		404		{object}	models.SwaggerErrorResponse									"Organization not found"
 //	@Failure		413		{object}	models.SwaggerErrorResponse									"File too large"
+//	@Failure		422		{object}	models.SwaggerErrorResponse									"File rejected by the upload scanners"
+//	@Failure		503		{object}	models.SwaggerErrorResponse									"Upload scanners unavailable"
 //	@Failure		500		{object}	models.SwaggerErrorResponse									"Server error"
 //	@Router			/organizations/{id}/logo [post]
 func (h *OrganizationHandler) UploadOrganizationLogo(c *gin.Context) {
@@ -87,66 +89,29 @@ func (h *OrganizationHandler) UploadOrganizationLogo(c *gin.Context) {
 		return
 	}
 
-	// Check file type (allow only images)
-	ext := strings.ToLower(filepath.Ext(header.Filename))
-	validExtensions := map[string]bool{
-		".jpg":  true,
-		".jpeg": true,
-		".png":  true,
-		".gif":  true,
-		".svg":  true,
-		".webp": true,
-	}
-
-	if !validExtensions[ext] {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInvalidRequest,
-			"Invalid file type, only images are allowed",
-			nil,
-			http.StatusBadRequest,
-		))
+	// Reject files whose content is not an allowed image, is too large or is infected
+	if err := h.uploadScanner.Scan(c.Request.Context(), services.NewMultipartScanInput(file, header, services.ImageUploadTypes)); err != nil {
+		middleware.HandleError(c, middleware.NewUploadScanAppError(err, "Failed to scan uploaded file"))
 		return
 	}
 
-	// Determine content type
-	contentType := ""
-	switch ext {
-	case ".jpg", ".jpeg":
-		contentType = "image/jpeg"
-	case ".png":
-		contentType = "image/png"
-	case ".gif":
-		contentType = "image/gif"
-	case ".svg":
-		contentType = "image/svg+xml"
-	case ".webp":
-		contentType = "image/webp"
-	}
-
-	// Upload file to S3
-	logoURL, err := h.fileService.UploadFile(c.Request.Context(), file, header.Filename, contentType)
+	// Validate the image by content, strip its metadata and store it with its variants
+	logo, err := h.imagePipeline.Process(c.Request.Context(), file, header.Filename, services.ImageOptions{AllowSVG: true})
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to upload logo",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewImageAppError(err, "Failed to upload logo"))
 		return
 	}
 
-	// Delete old logo if it exists
-	if organization.Logo != "" {
-		// Try to delete the old logo, but don't fail if it doesn't work
-		_ = h.fileService.DeleteFile(c.Request.Context(), organization.Logo)
-	}
+	previousLogo := &models.ProcessedImage{URL: organization.Logo, Variants: organization.LogoVariants}
 
 	// Update organization with new logo URL
-	organization.Logo = logoURL
+	organization.Logo = logo.URL
+	organization.LogoVariants = logo.Variants
 	organization.UpdatedAt = time.Now()
 
 	// Save updated organization
 	if err := h.UpdateOrganizationUseCase.Execute(c.Request.Context(), organization); err != nil {
+		h.imagePipeline.DeleteImage(c.Request.Context(), logo)
 		middleware.HandleError(c, middleware.NewAppError(
 			middleware.ErrorCodeInternalServer,
 			"Failed to update organization with new logo",
@@ -156,6 +121,12 @@ func (h *OrganizationHandler) UploadOrganizationLogo(c *gin.Context) {
 		return
 	}
 
+	// Delete the old logo and its variants only once the new one is saved
+	if previousLogo.URL != "" {
+		h.imagePipeline.DeleteImage(c.Request.Context(), previousLogo)
+	}
+
 	// Return updated organization
+	h.resolveFileURLs(organization)
 	c.JSON(http.StatusOK, organization)
 }
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/usecases"
 )
 
@@ -17,7 +18,17 @@ type OrganizationHandler struct {
 	BulkSoftDeleteOrganizationsUseCase *usecases.BulkSoftDeleteOrganizationsUseCase
 	BulkRestoreOrganizationsUseCase 	*usecases.BulkRestoreOrganizationsUseCase
 	HardDeleteOrganizationUseCase      *usecases.HardDeleteOrganizationUseCase
+	GetOrganizationSettingsUseCase     *usecases.GetOrganizationSettingsUseCase
+	UpdateOrganizationSettingsUseCase  *usecases.UpdateOrganizationSettingsUseCase
+	ListFeatureFlagOverridesUseCase    *usecases.ListFeatureFlagOverridesUseCase
+	SetFeatureFlagOverrideUseCase      *usecases.SetFeatureFlagOverrideUseCase
+	DeleteFeatureFlagOverrideUseCase   *usecases.DeleteFeatureFlagOverrideUseCase
+	FeatureFlagEvaluator               *usecases.FeatureFlagEvaluator
 	fileService                        services.FileService
+	imagePipeline                      *services.ImagePipeline
+	uploadScanner                      *services.UploadScanPipeline
+	PatchOrganizationUseCase           *usecases.PatchOrganizationUseCase
+	ExportOrganizationsUseCase         *usecases.ExportOrganizationsUseCase
 }
 
 // NewOrganizationHandler creates a new organization handler
@@ -32,6 +43,16 @@ func NewOrganizationHandler(GetOrganizationUseCase *usecases.GetOrganizationUseC
 	BulkSoftDeleteOrganizationsUseCase *usecases.BulkSoftDeleteOrganizationsUseCase,
 	HardDeleteOrganizationUseCase *usecases.HardDeleteOrganizationUseCase,
 	BulkRestoreOrganizationsUseCase 	*usecases.BulkRestoreOrganizationsUseCase,
+	GetOrganizationSettingsUseCase *usecases.GetOrganizationSettingsUseCase,
+	UpdateOrganizationSettingsUseCase *usecases.UpdateOrganizationSettingsUseCase,
+	ListFeatureFlagOverridesUseCase *usecases.ListFeatureFlagOverridesUseCase,
+	SetFeatureFlagOverrideUseCase *usecases.SetFeatureFlagOverrideUseCase,
+	DeleteFeatureFlagOverrideUseCase *usecases.DeleteFeatureFlagOverrideUseCase,
+	FeatureFlagEvaluator *usecases.FeatureFlagEvaluator,
+	imagePipeline *services.ImagePipeline,
+	uploadScanner *services.UploadScanPipeline,
+	PatchOrganizationUseCase *usecases.PatchOrganizationUseCase,
+	ExportOrganizationsUseCase *usecases.ExportOrganizationsUseCase,
 ) *OrganizationHandler {
 	return &OrganizationHandler{
 		fileService:                        fileService,
@@ -45,5 +66,29 @@ func NewOrganizationHandler(GetOrganizationUseCase *usecases.GetOrganizationUseC
 		BulkSoftDeleteOrganizationsUseCase: BulkSoftDeleteOrganizationsUseCase,
 		HardDeleteOrganizationUseCase:      HardDeleteOrganizationUseCase,
 		BulkRestoreOrganizationsUseCase: 	BulkRestoreOrganizationsUseCase,
+		GetOrganizationSettingsUseCase:     GetOrganizationSettingsUseCase,
+		UpdateOrganizationSettingsUseCase:  UpdateOrganizationSettingsUseCase,
+		ListFeatureFlagOverridesUseCase:    ListFeatureFlagOverridesUseCase,
+		SetFeatureFlagOverrideUseCase:      SetFeatureFlagOverrideUseCase,
+		DeleteFeatureFlagOverrideUseCase:   DeleteFeatureFlagOverrideUseCase,
+		FeatureFlagEvaluator:               FeatureFlagEvaluator,
+		imagePipeline:                      imagePipeline,
+		uploadScanner:                      uploadScanner,
+		PatchOrganizationUseCase:           PatchOrganizationUseCase,
+		ExportOrganizationsUseCase:         ExportOrganizationsUseCase,
 	}
 }
+
+// resolveFileURLs replaces the stored logo keys of organizations with URLs for the response
+func (h *OrganizationHandler) resolveFileURLs(organizations ...*entity.Organization) {
+	for _, organization := range organizations {
+		if organization != nil {
+			organization.ResolveFileURLs(h.resolveFileURL)
+		}
+	}
+}
+
+// resolveFileURL returns the URL to serve for a stored file reference
+func (h *OrganizationHandler) resolveFileURL(ref string) string {
+	return services.ResolveFileURL(h.fileService, ref)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
+)
+
+// PatchOrganization godoc
+//
+//	@Summary		Partially update an organization
+//	@Description	Apply a JSON Merge Patch (application/merge-patch+json) or JSON Patch (application/json-patch+json) to the updatable fields of an organization. Only the changed fields are validated and saved; removing a field clears it.
+//	@Tags			organizations
+//	@Accept			application/merge-patch+json,application/json-patch+json
+//	@Produce		json
+//	@Param			id			path		string	true	"Organization ID"
+//	@Param			patch		body		dto.UpdateOrganizationDto	true	"Merge patch of the fields to change, or an array of JSON Patch operations"
+//	@Param			If-Match	header		string	false	"ETag of the organization; when sent, the patch is refused if the organization has changed since"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Organization}
+//	@Header			200	{string}	ETag	"New version of the organization"
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		409	{object}	models.SwaggerErrorResponse	"A JSON Patch test operation failed"
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The organization was changed by another request"
+//	@Failure		415	{object}	models.SwaggerErrorResponse	"Unsupported patch format"
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/organizations/{id} [patch]
+func (h *OrganizationHandler) PatchOrganization(c *gin.Context) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid organization ID",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	existingOrg, err := h.GetOrganizationUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch organization",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	if existingOrg == nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"Organization not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return
+	}
+
+	if appErr := middleware.CheckIfMatchIfPresent(c, existingOrg.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// Patch the updatable fields and keep only the ones the patch changed
+	var changes dto.UpdateOrganizationDto
+	if err := patch.ApplyTo(c.ContentType(), dto.NewUpdateOrganizationDto(existingOrg), body, &changes); err != nil {
+		middleware.HandleError(c, middleware.NewPatchAppError(c, err, "Failed to apply patch"))
+		return
+	}
+
+	if err := changes.ValidatePatch(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// A logo URL of this storage, such as one returned by a previous read, is stored by key
+	if changes.Logo != nil {
+		logo := services.FileKey(h.fileService, *changes.Logo)
+		changes.Logo = &logo
+	}
+	original := *existingOrg
+	changes.ApplyUpdates(existingOrg)
+	if err := dto.ValidateOrganization(existingOrg); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	if err := h.PatchOrganizationUseCase.Execute(c.Request.Context(), &original, existingOrg); err != nil {
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update organization"))
+		return
+	}
+
+	middleware.SetETag(c, existingOrg.Version)
+	h.resolveFileURLs(existingOrg)
+	c.JSON(http.StatusOK, existingOrg)
+}
@@ -0,0 +1,377 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GetOrganizationSettings godoc
+//
+//	@Summary		Get organization settings
+//	@Description	Get tenant-level settings for an organization, falling back to defaults when none are stored
+//	@Tags			organizations
+//	@Produce		json
+//	@Param			id	path		string	true	"Organization ID"	example("6824886e6b180b753cea43e9")
+//	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.OrganizationSettings}
+//	@Failure		400	{object}	models.SwaggerErrorResponse
+//	@Failure		404	{object}	models.SwaggerErrorResponse
+//	@Failure		500	{object}	models.SwaggerErrorResponse
+//	@Router			/organizations/{id}/settings [get]
+func (h *OrganizationHandler) GetOrganizationSettings(c *gin.Context) {
+	objectId, ok := h.findOrganizationForSettings(c)
+	if !ok {
+		return
+	}
+
+	settings, err := h.GetOrganizationSettingsUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch organization settings",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, settings)
+}
+
+// UpdateOrganizationSettings godoc
+//
+//	@Summary		Update organization settings
+//	@Description	Partially update tenant-level settings for an organization
+//	@Tags			organizations
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string								true	"Organization ID"	example("6824886e6b180b753cea43e9")
+//	@Param			settings	body		dto.UpdateOrganizationSettingsDto	true	"Settings to update"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.OrganizationSettings}
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/organizations/{id}/settings [put]
+func (h *OrganizationHandler) UpdateOrganizationSettings(c *gin.Context) {
+	var updateDto dto.UpdateOrganizationSettingsDto
+	if err := c.ShouldBindJSON(&updateDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	if err := updateDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	objectId, ok := h.findOrganizationForSettings(c)
+	if !ok {
+		return
+	}
+
+	settings, err := h.GetOrganizationSettingsUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch organization settings",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	updateDto.ApplyUpdates(settings)
+	if rbacContext, err := middleware.GetScopedRBACContext(c); err == nil {
+		settings.UpdatedBy = rbacContext.UserID
+	}
+
+	if err := h.UpdateOrganizationSettingsUseCase.Execute(c.Request.Context(), settings); err != nil {
+		middleware.HandleError(c, settingsUpdateError(err))
+		return
+	}
+
+	updatedSettings, err := h.GetOrganizationSettingsUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Settings updated but failed to fetch updated data",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, updatedSettings)
+}
+
+// GetOrganizationFeatureFlags godoc
+//
+//	@Summary		Get effective feature flags
+//	@Description	Get the effective value of every feature flag for an organization after global overrides
+//	@Tags			organizations
+//	@Produce		json
+//	@Param			id	path		string	true	"Organization ID"	example("6824886e6b180b753cea43e9")
+//	@Success		200	{object}	models.SwaggerStandardResponse{data=map[string]bool}
+//	@Failure		400	{object}	models.SwaggerErrorResponse
+//	@Failure		404	{object}	models.SwaggerErrorResponse
+//	@Failure		500	{object}	models.SwaggerErrorResponse
+//	@Router			/organizations/{id}/feature-flags [get]
+func (h *OrganizationHandler) GetOrganizationFeatureFlags(c *gin.Context) {
+	objectId, ok := h.findOrganizationForSettings(c)
+	if !ok {
+		return
+	}
+
+	flags, err := h.FeatureFlagEvaluator.EvaluateAll(c.Request.Context(), objectId.Hex())
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to evaluate feature flags",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, flags)
+}
+
+// ListFeatureFlagOverrides godoc
+//
+//	@Summary		List global feature flag overrides
+//	@Description	List feature flag overrides applied to every organization (platform admins only)
+//	@Tags			feature-flags
+//	@Produce		json
+//	@Success		200	{object}	models.SwaggerStandardResponse{data=[]entity.FeatureFlagOverride}
+//	@Failure		403	{object}	models.SwaggerErrorResponse
+//	@Failure		500	{object}	models.SwaggerErrorResponse
+//	@Router			/feature-flags/overrides [get]
+func (h *OrganizationHandler) ListFeatureFlagOverrides(c *gin.Context) {
+	if !requirePlatformAdmin(c) {
+		return
+	}
+
+	overrides, err := h.ListFeatureFlagOverridesUseCase.Execute(c.Request.Context())
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch feature flag overrides",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, overrides)
+}
+
+// SetFeatureFlagOverride godoc
+//
+//	@Summary		Set a global feature flag override
+//	@Description	Force a feature flag on or off for every organization (platform admins only)
+//	@Tags			feature-flags
+//	@Accept			json
+//	@Produce		json
+//	@Param			flag		path		string							true	"Feature flag key"	example("bulk_import")
+//	@Param			override	body		dto.SetFeatureFlagOverrideDto	true	"Override value"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.FeatureFlagOverride}
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		403			{object}	models.SwaggerErrorResponse
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/feature-flags/overrides/{flag} [put]
+func (h *OrganizationHandler) SetFeatureFlagOverride(c *gin.Context) {
+	rbacContext, ok := requirePlatformAdminContext(c)
+	if !ok {
+		return
+	}
+
+	flag := c.Param("flag")
+	if !entity.IsKnownFeatureFlag(flag) {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			entity.ErrUnknownFeatureFlag.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	var overrideDto dto.SetFeatureFlagOverrideDto
+	if err := c.ShouldBindJSON(&overrideDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	if err := overrideDto.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	override := &entity.FeatureFlagOverride{
+		Key:       flag,
+		Enabled:   *overrideDto.Enabled,
+		UpdatedBy: rbacContext.UserID,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := h.SetFeatureFlagOverrideUseCase.Execute(c.Request.Context(), override); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to set feature flag override",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, override)
+}
+
+// DeleteFeatureFlagOverride godoc
+//
+//	@Summary		Remove a global feature flag override
+//	@Description	Remove an override so organizations fall back to their own setting (platform admins only)
+//	@Tags			feature-flags
+//	@Produce		json
+//	@Param			flag	path		string	true	"Feature flag key"	example("bulk_import")
+//	@Success		200		{object}	models.SwaggerStandardResponse
+//	@Failure		403		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/feature-flags/overrides/{flag} [delete]
+func (h *OrganizationHandler) DeleteFeatureFlagOverride(c *gin.Context) {
+	if !requirePlatformAdmin(c) {
+		return
+	}
+
+	flag := c.Param("flag")
+	deleted, err := h.DeleteFeatureFlagOverrideUseCase.Execute(c.Request.Context(), flag)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to delete feature flag override",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	if !deleted {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"Feature flag override not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Feature flag override removed successfully"})
+}
+
+// findOrganizationForSettings parses the organization ID and makes sure the organization exists
+func (h *OrganizationHandler) findOrganizationForSettings(c *gin.Context) (primitive.ObjectID, bool) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid organization ID",
+			err,
+			http.StatusBadRequest,
+		))
+		return primitive.NilObjectID, false
+	}
+
+	organization, err := h.GetOrganizationUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch organization",
+			err,
+			http.StatusInternalServerError,
+		))
+		return primitive.NilObjectID, false
+	}
+
+	if organization == nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"Organization not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return primitive.NilObjectID, false
+	}
+
+	return objectId, true
+}
+
+// requirePlatformAdmin rejects callers that are not global admins
+func requirePlatformAdmin(c *gin.Context) bool {
+	_, ok := requirePlatformAdminContext(c)
+	return ok
+}
+
+func requirePlatformAdminContext(c *gin.Context) (*middleware.ScopedRBACContext, bool) {
+	rbacContext, err := middleware.GetScopedRBACContext(c)
+	if err != nil || !rbacContext.IsGlobalAdmin {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeForbidden,
+			"Only platform admins can manage feature flag overrides",
+			err,
+			http.StatusForbidden,
+		))
+		return nil, false
+	}
+	return rbacContext, true
+}
+
+// settingsUpdateError maps settings validation errors to a 400 response
+func settingsUpdateError(err error) *middleware.AppError {
+	switch {
+	case errors.Is(err, entity.ErrInvalidCurrency),
+		errors.Is(err, entity.ErrInvalidTimezone),
+		errors.Is(err, entity.ErrInvalidBrandingColor),
+		errors.Is(err, entity.ErrInvalidUploadType),
+		errors.Is(err, entity.ErrUnknownFeatureFlag):
+		return middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		)
+	default:
+		return middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to update organization settings",
+			err,
+			http.StatusInternalServerError,
+		)
+	}
+}
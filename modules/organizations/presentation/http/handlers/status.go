@@ -17,9 +17,13 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"Organization ID"	example("6824886e6b180b753cea43e9")
+//	@Param			If-Match	header		string	true	"ETag of the organization being updated"
 //	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.Organization}
+//	@Header			200	{string}	ETag	"New version of the organization"
 //	@Failure		400	{object}	models.SwaggerErrorResponse
 //	@Failure		404	{object}	models.SwaggerErrorResponse
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The organization was changed by another request"
+//	@Failure		428	{object}	models.SwaggerErrorResponse	"If-Match header missing"
 //	@Failure		500	{object}	models.SwaggerErrorResponse
 //	@Router			/organizations/{id}/status [put]
 func (h *OrganizationHandler) UpdateOrganizationStatus(c *gin.Context) {
@@ -82,14 +86,15 @@ func (h *OrganizationHandler) UpdateOrganizationStatus(c *gin.Context) {
 		return
 	}
 
-	// Now proceed with the update, knowing the organization exists
-	if err := h.UpdateOrganizationStatusUseCase.Execute(c.Request.Context(), objectId, statusDto.Status); err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to update organization status",
-			err,
-			http.StatusInternalServerError,
-		))
+	// Refuse to overwrite changes the client has not seen
+	if appErr := middleware.CheckIfMatch(c, organization.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	// Now proceed with the update, which is refused if the organization changed since it was read
+	if err := h.UpdateOrganizationStatusUseCase.Execute(c.Request.Context(), objectId, statusDto.Status, organization.Version); err != nil {
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update organization status"))
 		return
 	}
 
@@ -105,5 +110,7 @@ func (h *OrganizationHandler) UpdateOrganizationStatus(c *gin.Context) {
 		return
 	}
 
+	middleware.SetETag(c, updatedOrganization.Version)
+	h.resolveFileURLs(updatedOrganization)
 	c.JSON(http.StatusOK, updatedOrganization)
 }
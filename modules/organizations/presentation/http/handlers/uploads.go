@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+)
+
+// OrganizationExists reports whether an organization exists, for opening direct uploads
+func (h *OrganizationHandler) OrganizationExists(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	organization, err := h.GetOrganizationUseCase.Execute(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return organization != nil, nil
+}
+
+// AttachUploadedLogo replaces the organization's logo with a confirmed direct upload
+func (h *OrganizationHandler) AttachUploadedLogo(ctx context.Context, session *uploadEntity.UploadSession) (interface{}, error) {
+	organization, err := h.GetOrganizationUseCase.Execute(ctx, session.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil {
+		return nil, errors.New("organization not found")
+	}
+
+	// Direct uploads go through the same validation and variant generation as proxied ones
+	logo, err := h.imagePipeline.ProcessStored(ctx, session.Key, session.FileName, services.ImageOptions{AllowSVG: true})
+	if err != nil {
+		return nil, err
+	}
+
+	previousLogo := &models.ProcessedImage{URL: organization.Logo, Variants: organization.LogoVariants}
+	organization.Logo = logo.URL
+	organization.LogoVariants = logo.Variants
+	organization.UpdatedAt = time.Now()
+	if err := h.UpdateOrganizationUseCase.Execute(ctx, organization); err != nil {
+		h.imagePipeline.DeleteImage(ctx, logo)
+		return nil, err
+	}
+
+	// The raw upload is replaced by its cleaned copy; old logo deletion must not fail the request
+	_ = h.fileService.DeleteFile(ctx, session.Key)
+	if previousLogo.URL != "" {
+		h.imagePipeline.DeleteImage(ctx, previousLogo)
+	}
+	h.resolveFileURLs(organization)
+	return organization, nil
+}
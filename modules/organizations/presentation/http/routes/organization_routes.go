@@ -3,13 +3,18 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	exportHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/presentation/http/handlers"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/dto"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/presentation/http/handlers"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	uploadHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/presentation/http/handlers"
 )
 
 // RegisterOrganizationRoutes registers all organization-related routes
-func RegisterOrganizationRoutes(router *gin.RouterGroup, handler *handlers.OrganizationHandler) {
+func RegisterOrganizationRoutes(router *gin.RouterGroup, handler *handlers.OrganizationHandler, uploads *uploadHandlers.UploadHandler, exports *exportHandlers.ExportHandler, bulkOps *bulk.Handler) {
 	orgGroup := router.Group(constants.OrganizationBasePath)
 	orgGroup.Use(middleware.ScopedRBACMiddleware())
 	{
@@ -31,6 +36,15 @@ func RegisterOrganizationRoutes(router *gin.RouterGroup, handler *handlers.Organ
 			middleware.RequireScopedPermission("organizations", "create"),
 			handler.BulkRestoreOrganizations)
 
+		// The bulk endpoint checks the permission of each operation itself
+		orgGroup.POST(constants.BulkOrganizationsPath, bulkOps.Bulk("organizations", handler.BulkOps))
+
+		// Export
+		orgGroup.GET(constants.ExportOrganizationsPath,
+			middleware.RequireScopedPermission("organizations", "list"),
+			exports.RequireEnabled(),
+			exports.Export("organizations", dto.OrganizationExportColumns, handler.ExportSource))
+
 		// Single item operations
 		orgGroup.GET(constants.GetOrganizationPath,
 			middleware.RequireScopedPermission("organizations", "read"),
@@ -42,6 +56,11 @@ func RegisterOrganizationRoutes(router *gin.RouterGroup, handler *handlers.Organ
 			middleware.RequireOrganizationAccess(),
 			handler.UpdateOrganization)
 
+		orgGroup.PATCH(constants.UpdateOrganizationPath,
+			middleware.RequireScopedPermission("organizations", "update"),
+			middleware.RequireOrganizationAccess(),
+			handler.PatchOrganization)
+
 		orgGroup.DELETE(constants.DeleteOrganizationPath,
 			middleware.RequireScopedPermission("organizations", "delete"),
 			middleware.RequireOrganizationAccess(),
@@ -59,6 +78,17 @@ func RegisterOrganizationRoutes(router *gin.RouterGroup, handler *handlers.Organ
 			middleware.RequireOrganizationAccess(),
 			handler.UploadOrganizationLogo)
 
+		// Direct logo upload
+		orgGroup.POST(constants.OrgLogoUploadsPath,
+			middleware.RequireScopedPermission("organizations", "update"),
+			middleware.RequireOrganizationAccess(),
+			uploads.CreateUploadSession(uploadEntity.TargetOrganizationLogo, handler.OrganizationExists))
+
+		orgGroup.POST(constants.ConfirmOrgLogoUploadPath,
+			middleware.RequireScopedPermission("organizations", "update"),
+			middleware.RequireOrganizationAccess(),
+			uploads.ConfirmUploadSession(uploadEntity.TargetOrganizationLogo, handler.AttachUploadedLogo))
+
 		// Restore operation
 		orgGroup.POST(constants.RestoreOrganizationPath,
 			middleware.RequireScopedPermission("organizations", "update"),
@@ -70,5 +100,30 @@ func RegisterOrganizationRoutes(router *gin.RouterGroup, handler *handlers.Organ
 		orgGroup.DELETE(constants.HardDeleteOrganizationPath,
 			middleware.RequireScopedPermission("organizations", "delete"),
 			handler.HardDeleteOrganization)
+
+		// Tenant settings
+		orgGroup.GET(constants.OrganizationSettingsPath,
+			middleware.RequireScopedPermission("organizations", "read"),
+			middleware.RequireOrganizationAccess(),
+			handler.GetOrganizationSettings)
+
+		orgGroup.PUT(constants.OrganizationSettingsPath,
+			middleware.RequireScopedPermission("organizations", "update"),
+			middleware.RequireOrganizationAccess(),
+			handler.UpdateOrganizationSettings)
+
+		orgGroup.GET(constants.OrganizationFeatureFlagsPath,
+			middleware.RequireScopedPermission("organizations", "read"),
+			middleware.RequireOrganizationAccess(),
+			handler.GetOrganizationFeatureFlags)
+	}
+
+	// Global feature flag overrides (platform admins only, checked in the handlers)
+	flagGroup := router.Group(constants.FeatureFlagBasePath)
+	flagGroup.Use(middleware.ScopedRBACMiddleware())
+	{
+		flagGroup.GET(constants.ListFeatureFlagOverridesPath, handler.ListFeatureFlagOverrides)
+		flagGroup.PUT(constants.FeatureFlagOverridePath, handler.SetFeatureFlagOverride)
+		flagGroup.DELETE(constants.FeatureFlagOverridePath, handler.DeleteFeatureFlagOverride)
 	}
 }
@@ -2,16 +2,15 @@ package datasource
 
 import (
 	"context"
-	"log"
 	"time"
 
-	mongodb "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/data/mongodb/indexes"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/data/mongodb/model"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // MongoPermissionDatasource handles raw MongoDB operations for Permissions
@@ -23,9 +22,6 @@ type MongoPermissionDatasource struct {
 func NewMongoPermissionDatasource(db *mongo.Database) *MongoPermissionDatasource {
 	collection := db.Collection(model.PermissionModel{}.CollectionName())
 
-	if err := mongodb.SetupPermissionIndexes(collection); err != nil {
-		log.Printf("⚠️ Failed to setup Permission indexes: %v", err)
-	}
 	return &MongoPermissionDatasource{
 		collection: collection,
 	}
@@ -47,33 +43,14 @@ func (ds *MongoPermissionDatasource) Insert(ctx context.Context, permission *mod
 	return nil
 }
 
-// FindByFilters retrieves permission documents with optional filters, pagination, and sorting
-func (ds *MongoPermissionDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, page int, limit int) ([]model.PermissionModel, int64, error) {
-	// Step 1: Count total for pagination
-	totalCount, err := ds.collection.CountDocuments(ctx, filters)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Step 2: Prepare options
-	opts := options.Find().
-		SetSkip(int64((page - 1) * limit)).
-		SetLimit(int64(limit)).
-		SetSort(bson.D{{Key: "createdAt", Value: -1}}) // Default sort by createdAt descending
-
-	// Step 3: Execute query
-	cursor, err := ds.collection.Find(ctx, filters, opts)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer cursor.Close(ctx)
-
+// FindByFilters retrieves the page of permission documents matching filters selected by opts
+func (ds *MongoPermissionDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, opts models.ListOptions) ([]model.PermissionModel, *models.PageInfo, error) {
 	var permissions []model.PermissionModel
-	if err := cursor.All(ctx, &permissions); err != nil {
-		return nil, 0, err
+	info, err := database.FindPage(ctx, ds.collection, filters, opts, &permissions)
+	if err != nil {
+		return nil, nil, err
 	}
-
-	return permissions, totalCount, nil
+	return permissions, info, nil
 }
 
 // FindByID retrieves a permission document by its ID
@@ -96,13 +73,40 @@ func (ds *MongoPermissionDatasource) FindByID(ctx context.Context, id primitive.
 func (ds *MongoPermissionDatasource) Update(ctx context.Context, permission *model.PermissionModel) error {
 	permission.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": permission.ID}
+	// Only overwrite the version the caller read, so concurrent edits are not lost
+	expected := permission.Version
+	permission.Version++
+	filter := database.VersionedFilter(permission.ID, expected)
 	update := bson.M{"$set": permission}
 
-	_, err := ds.collection.UpdateOne(ctx, filter, update)
+	result, err := ds.collection.UpdateOne(ctx, filter, update)
+	if err == nil && result.MatchedCount == 0 {
+		err = models.ErrVersionConflict
+	}
+	if err != nil {
+		permission.Version = expected
+	}
 	return err
 }
 
+// Patch writes only the fields of updated that differ from original, while the stored
+// document is still at the version of original
+func (ds *MongoPermissionDatasource) Patch(ctx context.Context, original, updated *model.PermissionModel) error {
+	set, unset, err := database.ChangedFields(original, updated)
+	if err != nil || len(set)+len(unset) == 0 {
+		return err
+	}
+	updated.UpdatedAt = time.Now()
+	set["updatedAt"] = updated.UpdatedAt
+
+	filter := database.VersionedFilter(original.ID, original.Version)
+	if err := database.PatchDocument(ctx, ds.collection, filter, set, unset); err != nil {
+		return err
+	}
+	updated.Version = original.Version + 1
+	return nil
+}
+
 // HardDelete permanently removes an permission from the database
 func (ds *MongoPermissionDatasource) HardDelete(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id}
@@ -15,6 +15,7 @@ type PermissionModel struct {
 	Description string             `json:"description" bson:"description"` // Human-readable description
 	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt"`
 	UpdatedAt   time.Time          `json:"updatedAt" bson:"updatedAt"`
+	Version     int64              `json:"version" bson:"version"`
 }
 
 // CollectionName returns the MongoDB collection name
@@ -31,6 +32,7 @@ func FromEntity(entity *entity.Permission) *PermissionModel {
 		Description: entity.Description,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
+		Version:   entity.Version,
 	}
 
 	return model
@@ -45,6 +47,7 @@ func (m *PermissionModel) ToEntity() entity.Permission {
 		Description: m.Description,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+		Version:   m.Version,
 	}
 
 	return permission
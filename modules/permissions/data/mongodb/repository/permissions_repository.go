@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/data/datasource"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/data/mongodb/model"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
@@ -23,17 +24,26 @@ func NewPermissionRepositoryMongo(ds *datasource.MongoPermissionDatasource) *Per
 }
 
 func (r *PermissionRepositoryMongo) List(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Permission, int64, error) {
-	permissionModels, totalCount, err := r.datasource.FindByFilters(ctx, filter, page, limit)
+	permissions, info, err := r.FindPage(ctx, filter, models.ListOptions{Page: page, Limit: limit, Count: models.CountExact})
 	if err != nil {
 		return nil, 0, err
 	}
+	return permissions, *info.Total, nil
+}
+
+// FindPage retrieves the page of permissions matching filter selected by opts
+func (r *PermissionRepositoryMongo) FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Permission, *models.PageInfo, error) {
+	permissionModels, info, err := r.datasource.FindByFilters(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	permissionEntities := make([]*entity.Permission, len(permissionModels))
 	for i, model := range permissionModels {
 		entity := model.ToEntity()
 		permissionEntities[i] = &entity
 	}
-	return permissionEntities, totalCount, nil
+	return permissionEntities, info, nil
 }
 
 func (r *PermissionRepositoryMongo) Create(ctx context.Context, permission *entity.Permission) error {
@@ -67,8 +77,21 @@ func (r *PermissionRepositoryMongo) GetByID(ctx context.Context, id primitive.Ob
 func (r *PermissionRepositoryMongo) Update(ctx context.Context, permission *entity.Permission) error {
 	permissionModel := model.FromEntity(permission)
 
-	return r.datasource.Update(ctx, permissionModel)
+	if err := r.datasource.Update(ctx, permissionModel); err != nil {
+		return err
+	}
+	permission.Version = permissionModel.Version
+	return nil
+}
 
+func (r *PermissionRepositoryMongo) Patch(ctx context.Context, original, updated *entity.Permission) error {
+	updatedModel := model.FromEntity(updated)
+	if err := r.datasource.Patch(ctx, model.FromEntity(original), updatedModel); err != nil {
+		return err
+	}
+	updated.UpdatedAt = updatedModel.UpdatedAt
+	updated.Version = updatedModel.Version
+	return nil
 }
 
 func (r *PermissionRepositoryMongo) HardDelete(ctx context.Context, id primitive.ObjectID) (bool, error) {
@@ -24,6 +24,7 @@ type Permission struct {
 	Description string             `json:"description" bson:"description"` // Human-readable description
 	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt"`
 	UpdatedAt   time.Time          `json:"updatedAt" bson:"updatedAt"`
+	Version     int64              `json:"version" bson:"version"`
 }
 
 func (p *Permission) String() string {
@@ -4,6 +4,7 @@ package repository
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -12,11 +13,16 @@ type PermissionRepository interface {
 	Create(ctx context.Context, permission *entity.Permission) error
 	GetByID(ctx context.Context, id primitive.ObjectID) (*entity.Permission, error)
 	Update(ctx context.Context, permission *entity.Permission) error
+	Patch(ctx context.Context, original, updated *entity.Permission) error
 
 	HardDelete(ctx context.Context, id primitive.ObjectID) (bool, error)
 
 	List(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Permission, int64, error)
 
+	// FindPage retrieves the page of permissions matching filter selected by opts, by page
+	// number or cursor
+	FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Permission, *models.PageInfo, error)
+
 	ExistsByResourceAction(ctx context.Context, resource string, action entity.PermissionAction) (bool, error)
 	ExistsByResourceActionExcluding(ctx context.Context, resource string, action entity.PermissionAction, excludeID primitive.ObjectID) (bool, error)
 	ExistsByID(ctx context.Context, id primitive.ObjectID) (bool, error)
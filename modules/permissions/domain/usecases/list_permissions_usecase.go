@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/repository"
 )
@@ -19,6 +20,6 @@ func NewListPermissionUseCase(repo repository.PermissionRepository) *ListPermiss
 }
 
 // ListPermissions retrieves a list of Permissions with pagination
-func (uc *ListPermissionsUseCase) Execute(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Permission, int64, error) {
-	return uc.repo.List(ctx, filter, page, limit)
+func (uc *ListPermissionsUseCase) Execute(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Permission, *models.PageInfo, error) {
+	return uc.repo.FindPage(ctx, filter, opts)
 }
@@ -0,0 +1,38 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/repository"
+)
+
+// PatchPermissionUseCase saves a partial update of a permission
+type PatchPermissionUseCase struct {
+	repo repository.PermissionRepository
+}
+
+func NewPatchPermissionUseCase(repo repository.PermissionRepository) *PatchPermissionUseCase {
+	return &PatchPermissionUseCase{
+		repo: repo,
+	}
+}
+
+// Execute writes the fields of updated that differ from original, the permission as it was read
+func (uc *PatchPermissionUseCase) Execute(ctx context.Context, original, updated *entity.Permission) error {
+	if updated.Resource != original.Resource || updated.Action != original.Action {
+		exists, err := uc.repo.ExistsByResourceActionExcluding(ctx,
+			updated.Resource,
+			updated.Action,
+			updated.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("permission with this resource, action already exists")
+		}
+	}
+
+	return uc.repo.Patch(ctx, original, updated)
+}
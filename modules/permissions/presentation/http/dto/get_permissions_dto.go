@@ -4,9 +4,33 @@ package dto
 import (
 	"strconv"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
 	"github.com/gin-gonic/gin"
 )
 
+// PermissionResponseFields are the members the fields parameter can select for permissions
+var PermissionResponseFields = fieldset.Of(entity.Permission{})
+
+// PermissionFilterFields are the fields permissions can be filtered by with the filter parameter
+var PermissionFilterFields = listfilter.Fields{
+	"resource":    {Path: "resource", Type: listfilter.String},
+	"action":      {Path: "action", Type: listfilter.String},
+	"description": {Path: "description", Type: listfilter.String},
+	"createdAt":   {Path: "createdAt", Type: listfilter.Date},
+	"updatedAt":   {Path: "updatedAt", Type: listfilter.Date},
+}
+
+// PermissionSortFields are the fields permissions can be sorted by
+var PermissionSortFields = pagination.SortFields{
+	"resource":  "resource",
+	"action":    "action",
+	"createdAt": "createdAt",
+	"updatedAt": "updatedAt",
+}
+
 // GetPermissionsDto defines the query parameters for listing permissions
 type GetPermissionsDto struct {
 	// Pagination parameters
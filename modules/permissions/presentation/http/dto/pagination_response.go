@@ -7,9 +7,14 @@ import "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/pe
 // PaginatedOrganizationsResponse represents the paginated response for organizations
 type PaginatedPermissionsResponse struct {
 	Items          []entity.Permission `json:"items"`
-	Page           int                   `json:"page" example:"1"`
+	Page           int                   `json:"page,omitempty" example:"1"`
 	Limit          int                   `json:"limit" example:"10"`
-	Total          int64                 `json:"total" example:"2"`
-	TotalPages     int64                 `json:"totalPages" example:"1"`
+	Total          int64                 `json:"total,omitempty" example:"2"`
+	TotalPages     int64                 `json:"totalPages,omitempty" example:"1"`
+	TotalEstimated bool                  `json:"totalEstimated,omitempty" example:"false"`
+	HasNext        bool                  `json:"hasNext" example:"true"`
+	HasPrev        bool                  `json:"hasPrev" example:"false"`
+	NextCursor     string                `json:"nextCursor,omitempty" example:"IQAAAAJzAA8AAAAtY3JlYXRlZEF0LC1faWQABHYAAAAAAAA"`
+	PrevCursor     string                `json:"prevCursor,omitempty"`
 	IncludeDeleted bool                  `json:"includeDeleted" example:"false"`
 }
\ No newline at end of file
@@ -41,6 +41,17 @@ func (dto *UpdatePermissionDto) Validate() error {
 	return nil
 }
 
+// NewUpdatePermissionDto returns every updatable field of a permission. Partial updates
+// are applied to this document, then only the changed fields are applied back.
+func NewUpdatePermissionDto(permission *entity.Permission) *UpdatePermissionDto {
+	resource := permission.Resource
+	action := string(permission.Action)
+	return &UpdatePermissionDto{
+		Resource: &resource,
+		Action:   &action,
+	}
+}
+
 // ApplyUpdates applies the update DTO to an existing permission entity
 // Only updates fields that are provided in the DTO
 func (dto *UpdatePermissionDto) ApplyUpdates(permission *entity.Permission) {
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/presentation/http/dto"
 	"github.com/gin-gonic/gin"
@@ -17,7 +18,10 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"Permission ID"	format(objectid)	example("6824886e6b180b753cea43e9")
+//	@Param			fields	query		string	false	"Comma separated members to return, the ID is always included"	example(resource,action)
+//	@Param			If-None-Match	header		string	false	"ETag from an earlier read, answered with 304 if the permission is unchanged"
 //	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.Permission}
+//	@Header			200	{string}	ETag	"Version of the permission, send it as If-Match when updating"
 //	@Failure		400	{object}	models.SwaggerErrorResponse
 //	@Failure		404	{object}	models.SwaggerErrorResponse
 //	@Failure		500	{object}	models.SwaggerErrorResponse
@@ -25,6 +29,12 @@ import (
 func (h *PermissionHandler) GetPermission(c *gin.Context) {
 	id := c.Param("id")
 
+	fields, err := fieldset.FromQuery(c, dto.PermissionResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		middleware.HandleError(c, middleware.NewAppError(
@@ -57,7 +67,21 @@ func (h *PermissionHandler) GetPermission(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, Permission)
+	if middleware.NotModified(c, Permission.Version) {
+		return
+	}
+
+	response, err := fieldset.Select(Permission, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch permission",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // CreatePermission godoc
@@ -124,9 +148,13 @@ func (h *PermissionHandler) CreatePermission(c *gin.Context) {
 //	@Produce		json
 //	@Param			id			path		string					true	"Permission ID"	format(objectid)
 //	@Param			permission	body		dto.UpdatePermissionDto	true	"Permission update data"
+//	@Param			If-Match	header		string	true	"ETag of the permission being updated"
 //	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Permission}
+//	@Header			200	{string}	ETag	"New version of the permission"
 //	@Failure		400			{object}	models.SwaggerErrorResponse
 //	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The permission was changed by another request"
+//	@Failure		428	{object}	models.SwaggerErrorResponse	"If-Match header missing"
 //	@Failure		500			{object}	models.SwaggerErrorResponse
 //	@Router			/permissions/{id} [put]
 func (h *PermissionHandler) UpdatePermission(c *gin.Context) {
@@ -165,6 +193,12 @@ func (h *PermissionHandler) UpdatePermission(c *gin.Context) {
 		return
 	}
 
+	// Refuse to overwrite changes the client has not seen
+	if appErr := middleware.CheckIfMatch(c, existingPermission.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
 	// Parse update DTO
 	var updateDto dto.UpdatePermissionDto
 	if err := c.ShouldBindJSON(&updateDto); err != nil {
@@ -193,15 +227,11 @@ func (h *PermissionHandler) UpdatePermission(c *gin.Context) {
 
 	// Call use case to update
 	if err := h.UpdatePermissionUseCase.Execute(c.Request.Context(), existingPermission); err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to update permission",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update permission"))
 		return
 	}
 
+	middleware.SetETag(c, existingPermission.Version)
 	c.JSON(http.StatusOK, existingPermission)
 }
 
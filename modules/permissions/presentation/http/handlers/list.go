@@ -3,7 +3,10 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/presentation/http/dto"
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +20,12 @@ import (
 //	@Produce		json
 //	@Param			page			query		int																			false	"Page number"		default(1)
 //	@Param			limit			query		int																			false	"Items per page"	default(10)	maximum(100)
+//	@Param			sort			query		string																		false	"Comma separated sort fields, prefixed with - for descending"	example(-createdAt)
+//	@Param			after			query		string																		false	"Cursor from nextCursor, returns the page after it"
+//	@Param			before			query		string																		false	"Cursor from prevCursor, returns the page before it"
+//	@Param			count			query		string																		false	"How total is computed, exact by default with page numbers and none with cursors"	Enums(exact, estimated, none)
+//	@Param			filter			query		string																		false	"Filter expression, clauses field:operator:value separated by ;"	example(createdAt:gte:2026-01-01)
+//	@Param			fields			query		string																		false	"Comma separated members to return, the ID is always included"	example(resource,action)
 //	@Param			name			query		string																		false	"Filter by name (partial match, case-insensitive)"
 //	@Param			description		query		string																		false	"Filter by description (partial match, case-insensitive)"
 //	@Param			resource		query		string																		false	"Filter by resource (exact match)"
@@ -30,32 +39,43 @@ func (h *PermissionHandler) ListPermissions(c *gin.Context) {
 	// Parse query parameters using DTO
 	queryDto := dto.NewGetPermissionsDto(c)
 
+	listOpts, err := pagination.FromQuery(c, queryDto.Page, queryDto.Limit, dto.PermissionSortFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch permissions"))
+		return
+	}
+
+	fields, err := fieldset.FromQuery(c, dto.PermissionResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch permissions"))
+		return
+	}
+
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.PermissionFilterFields); err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch permissions"))
+		return
+	}
+
 	// Call use case with filter from DTO
-	permissions, total, err := h.ListPermissionsUseCase.Execute(
+	permissions, pageInfo, err := h.ListPermissionsUseCase.Execute(
 		c.Request.Context(),
-		queryDto.ToFilterMap(),
-		queryDto.Page,
-		queryDto.Limit,
+		filter,
+		listOpts,
 	)
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to fetch permissions",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch permissions"))
 		return
 	}
 
 	// Prepare response
-	response := gin.H{
-		"items":          permissions,
-		"page":           queryDto.Page,
-		"limit":          queryDto.Limit,
-		"total":          total,
-		"totalPages":     (total + int64(queryDto.Limit) - 1) / int64(queryDto.Limit),
-		"includeDeleted": queryDto.IncludeDeleted,
+	items, err := fieldset.SelectAll(permissions, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch permissions"))
+		return
 	}
+	response := pagination.Response(items, pageInfo)
+	response["includeDeleted"] = queryDto.IncludeDeleted
 
 	c.JSON(http.StatusOK, response)
 }
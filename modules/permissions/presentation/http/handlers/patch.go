@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/presentation/http/dto"
+)
+
+// PatchPermission godoc
+//
+//	@Summary		Partially update a permission
+//	@Description	Apply a JSON Merge Patch (application/merge-patch+json) or JSON Patch (application/json-patch+json) to the updatable fields of a permission. Only the changed fields are validated and saved; removing a field clears it.
+//	@Tags			permissions
+//	@Accept			application/merge-patch+json,application/json-patch+json
+//	@Produce		json
+//	@Param			id			path		string	true	"Permission ID"
+//	@Param			patch		body		dto.UpdatePermissionDto	true	"Merge patch of the fields to change, or an array of JSON Patch operations"
+//	@Param			If-Match	header		string	false	"ETag of the permission; when sent, the patch is refused if the permission has changed since"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Permission}
+//	@Header			200	{string}	ETag	"New version of the permission"
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		409	{object}	models.SwaggerErrorResponse	"A JSON Patch test operation failed"
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The permission was changed by another request"
+//	@Failure		415	{object}	models.SwaggerErrorResponse	"Unsupported patch format"
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/permissions/{id} [patch]
+func (h *PermissionHandler) PatchPermission(c *gin.Context) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid permission ID",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	existingPermission, err := h.GetPermissionUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch permission",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	if existingPermission == nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"Permission not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return
+	}
+
+	if appErr := middleware.CheckIfMatchIfPresent(c, existingPermission.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// Patch the updatable fields and keep only the ones the patch changed
+	var changes dto.UpdatePermissionDto
+	if err := patch.ApplyTo(c.ContentType(), dto.NewUpdatePermissionDto(existingPermission), body, &changes); err != nil {
+		middleware.HandleError(c, middleware.NewPatchAppError(c, err, "Failed to apply patch"))
+		return
+	}
+
+	if err := changes.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	original := *existingPermission
+	changes.ApplyUpdates(existingPermission)
+
+	if err := h.PatchPermissionUseCase.Execute(c.Request.Context(), &original, existingPermission); err != nil {
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update permission"))
+		return
+	}
+
+	middleware.SetETag(c, existingPermission.Version)
+	c.JSON(http.StatusOK, existingPermission)
+}
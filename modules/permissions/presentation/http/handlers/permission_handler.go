@@ -11,6 +11,7 @@ type PermissionHandler struct {
 	ListPermissionsUseCase      *usecases.ListPermissionsUseCase
 	UpdatePermissionUseCase     *usecases.UpdatePermissionUseCase
 	HardDeletePermissionUseCase *usecases.HardDeletePermissionUseCase
+	PatchPermissionUseCase      *usecases.PatchPermissionUseCase
 }
 
 func NewPermissionHandler(GetPermissionUseCase *usecases.GetPermissionUseCase,
@@ -18,6 +19,7 @@ func NewPermissionHandler(GetPermissionUseCase *usecases.GetPermissionUseCase,
 	ListPermissionsUseCase *usecases.ListPermissionsUseCase,
 	UpdatePermissionUseCase *usecases.UpdatePermissionUseCase,
 	HardDeletePermissionUseCase *usecases.HardDeletePermissionUseCase,
+	PatchPermissionUseCase *usecases.PatchPermissionUseCase,
 ) *PermissionHandler {
 	return &PermissionHandler{
 		GetPermissionUseCase:        GetPermissionUseCase,
@@ -25,5 +27,6 @@ func NewPermissionHandler(GetPermissionUseCase *usecases.GetPermissionUseCase,
 		ListPermissionsUseCase:      ListPermissionsUseCase,
 		UpdatePermissionUseCase:     UpdatePermissionUseCase,
 		HardDeletePermissionUseCase: HardDeletePermissionUseCase,
+		PatchPermissionUseCase:      PatchPermissionUseCase,
 	}
 }
@@ -14,6 +14,7 @@ func RegisterPermissionRoutes(router *gin.RouterGroup, handler *handlers.Permiss
 
 		permissionGroup.GET(constants.GetPermissionPath, handler.GetPermission)
 		permissionGroup.PUT(constants.UpdatePermissionPath, handler.UpdatePermission)
+		permissionGroup.PATCH(constants.UpdatePermissionPath, handler.PatchPermission)
 
 		permissionGroup.DELETE(constants.HardDeletePermissionPath, handler.HardDeletePermission)
 
@@ -4,11 +4,12 @@ import (
 	"context"
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/data/mongodb/model"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 
@@ -42,33 +43,30 @@ func (ds *MongoRoleDatasource) Insert(ctx context.Context, role *model.RoleModel
 	return nil
 }
 
-// FindByFilters retrieves role documents with optional filters, pagination, and sorting
-func (ds *MongoRoleDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, page int, limit int) ([]model.RoleModel, int64, error) {
-	// Step 1: Count total for pagination
-	totalCount, err := ds.collection.CountDocuments(ctx, filters)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Step 2: Prepare options
-	opts := options.Find().
-		SetSkip(int64((page - 1) * limit)).
-		SetLimit(int64(limit)).
-		SetSort(bson.D{{Key: "createdAt", Value: -1}}) // Default sort by createdAt descending
-
-	// Step 3: Execute query
-	cursor, err := ds.collection.Find(ctx, filters, opts)
+// FindByFilters retrieves the page of role documents matching filters selected by opts
+func (ds *MongoRoleDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, opts models.ListOptions) ([]model.RoleModel, *models.PageInfo, error) {
+	var roles []model.RoleModel
+	info, err := database.FindPage(ctx, ds.collection, filters, opts, &roles)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
-	defer cursor.Close(ctx)
+	return roles, info, nil
+}
 
-	var roles []model.RoleModel
-	if err := cursor.All(ctx, &roles); err != nil {
-		return nil, 0, err
-	}
+// Stream decodes each role document matching filters in sort order and calls fn with it
+func (ds *MongoRoleDatasource) Stream(ctx context.Context, filters map[string]interface{}, sort []models.SortField, fn func(*model.RoleModel) error) error {
+	return database.Stream(ctx, ds.collection, filters, sort, func(cursor *mongo.Cursor) error {
+		var m model.RoleModel
+		if err := cursor.Decode(&m); err != nil {
+			return err
+		}
+		return fn(&m)
+	})
+}
 
-	return roles, totalCount, nil
+// Count counts the role documents matching filters
+func (ds *MongoRoleDatasource) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	return ds.collection.CountDocuments(ctx, filters)
 }
 
 // FindByID retrieves a role document by its ID
@@ -112,22 +110,44 @@ func (ds *MongoRoleDatasource) FindByName(ctx context.Context, name string) (*mo
 func (ds *MongoRoleDatasource) Update(ctx context.Context, role *model.RoleModel) error {
 	role.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": role.ID}
+	// Only overwrite the version the caller read, so concurrent edits are not lost
+	expected := role.Version
+	role.Version++
+	filter := database.VersionedFilter(role.ID, expected)
 	update := bson.M{"$set": role}
 
-	_, err := ds.collection.UpdateOne(ctx, filter, update)
+	result, err := ds.collection.UpdateOne(ctx, filter, update)
+	if err == nil && result.MatchedCount == 0 {
+		err = models.ErrVersionConflict
+	}
+	if err != nil {
+		role.Version = expected
+	}
 	return err
 }
 
+// Patch writes only the fields of updated that differ from original, while the stored
+// document is still at the version of original
+func (ds *MongoRoleDatasource) Patch(ctx context.Context, original, updated *model.RoleModel) error {
+	set, unset, err := database.ChangedFields(original, updated)
+	if err != nil || len(set)+len(unset) == 0 {
+		return err
+	}
+	updated.UpdatedAt = time.Now()
+	set["updatedAt"] = updated.UpdatedAt
+
+	filter := database.VersionedFilter(original.ID, original.Version)
+	if err := database.PatchDocument(ctx, ds.collection, filter, set, unset); err != nil {
+		return err
+	}
+	updated.Version = original.Version + 1
+	return nil
+}
+
 // SoftDelete marks an role as deleted by setting deletedAt timestamp
 func (ds *MongoRoleDatasource) SoftDelete(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id, "deletedAt": nil}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": time.Now(),
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.SoftDeleteUpdate(ctx)
 
 	result, err := ds.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -140,12 +160,7 @@ func (ds *MongoRoleDatasource) SoftDelete(ctx context.Context, id primitive.Obje
 // Restore restores a soft-deleted role by setting deletedAt to nil
 func (ds *MongoRoleDatasource) Restore(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id, "deletedAt": bson.M{"$ne": nil}}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -167,18 +182,13 @@ func (ds *MongoRoleDatasource) HardDelete(ctx context.Context, id primitive.Obje
 	return result.DeletedCount > 0, nil
 }
 
-// BulkSoftDelete marks multiple organizations as deleted
+// BulkSoftDelete marks multiple roles as deleted
 func (ds *MongoRoleDatasource) BulkSoftDelete(ctx context.Context, ids []primitive.ObjectID) ([]primitive.ObjectID, error) {
 	filter := bson.M{
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": nil,
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": time.Now(),
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.SoftDeleteUpdate(ctx)
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -216,12 +226,7 @@ func (ds *MongoRoleDatasource) BulkRestore(ctx context.Context, ids []primitive.
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": bson.M{"$ne": nil}, // Only restore soft-deleted items
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -1 +0,0 @@
-package indexes
\ No newline at end of file
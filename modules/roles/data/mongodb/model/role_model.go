@@ -18,6 +18,7 @@ type RoleModel struct {
 	CreatedBy   string             `json:"createdBy" bson:"createdBy"`
 	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
 	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+	Version     int64              `bson:"version" json:"version"`
 	DeletedAt   *time.Time         `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"`
 }
 
@@ -38,6 +39,7 @@ func FromEntity(entity *entity.Role) *RoleModel {
 		CreatedBy:   entity.CreatedBy,
 		CreatedAt:   entity.CreatedAt,
 		UpdatedAt:   entity.UpdatedAt,
+		Version:     entity.Version,
 		DeletedAt:   entity.DeletedAt,
 	}
 
@@ -56,6 +58,7 @@ func (m *RoleModel) ToEntity() entity.Role {
 		CreatedBy:   m.CreatedBy,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		Version:     m.Version,
 		DeletedAt:   m.DeletedAt,
 	}
 
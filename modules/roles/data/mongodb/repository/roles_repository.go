@@ -27,17 +27,39 @@ func NewRoleRepositoryMongo(ds *datasource.MongoRoleDatasource) *RoleRepositoryM
 
 // List implements repository.RoleRepository.
 func (r *RoleRepositoryMongo) List(ctx context.Context, filter map[string]interface{}, page int, limit int) ([]*entity.Role, int64, error) {
-	roleModels, totalCount, err := r.datasource.FindByFilters(ctx, filter, page, limit)
+	roles, info, err := r.FindPage(ctx, filter, models.ListOptions{Page: page, Limit: limit, Count: models.CountExact})
 	if err != nil {
 		return nil, 0, err
 	}
+	return roles, *info.Total, nil
+}
+
+// FindPage implements repository.RoleRepository.
+func (r *RoleRepositoryMongo) FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Role, *models.PageInfo, error) {
+	roleModels, info, err := r.datasource.FindByFilters(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	roleEntities := make([]*entity.Role, len(roleModels))
 	for i, model := range roleModels {
 		entity := model.ToEntity()
 		roleEntities[i] = &entity
 	}
-	return roleEntities, totalCount, nil
+	return roleEntities, info, nil
+}
+
+// Stream implements repository.RoleRepository.
+func (r *RoleRepositoryMongo) Stream(ctx context.Context, filter map[string]interface{}, sort []models.SortField, fn func(*entity.Role) error) error {
+	return r.datasource.Stream(ctx, filter, sort, func(m *model.RoleModel) error {
+		role := m.ToEntity()
+		return fn(&role)
+	})
+}
+
+// Count implements repository.RoleRepository.
+func (r *RoleRepositoryMongo) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return r.datasource.Count(ctx, filter)
 }
 
 // Create implements repository.RoleRepository.
@@ -87,7 +109,22 @@ func (r *RoleRepositoryMongo) GetByName(ctx context.Context, name string) (*enti
 // Update implements repository.RoleRepository.
 func (r *RoleRepositoryMongo) Update(ctx context.Context, role *entity.Role) error {
 	roleModel := model.FromEntity(role)
-	return r.datasource.Update(ctx, roleModel)
+	if err := r.datasource.Update(ctx, roleModel); err != nil {
+		return err
+	}
+	role.Version = roleModel.Version
+	return nil
+}
+
+// Patch implements repository.RoleRepository.
+func (r *RoleRepositoryMongo) Patch(ctx context.Context, original, updated *entity.Role) error {
+	updatedModel := model.FromEntity(updated)
+	if err := r.datasource.Patch(ctx, model.FromEntity(original), updatedModel); err != nil {
+		return err
+	}
+	updated.UpdatedAt = updatedModel.UpdatedAt
+	updated.Version = updatedModel.Version
+	return nil
 }
 
 
@@ -26,6 +26,7 @@ type Role struct {
 	CreatedBy      string              `json:"createdBy" bson:"createdBy"` // User ID who created this role
 	CreatedAt      time.Time           `json:"createdAt" bson:"createdAt"`
 	UpdatedAt      time.Time           `json:"updatedAt" bson:"updatedAt"`
+	Version        int64               `json:"version" bson:"version"`
 	DeletedAt      *time.Time          `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
@@ -15,9 +15,20 @@ type RoleRepository interface {
 	GetByID(ctx context.Context, id primitive.ObjectID) (*entity.Role, error)
 	GetByName(ctx context.Context, name string) (*entity.Role, error)
 	Update(ctx context.Context, role *entity.Role) error
+	Patch(ctx context.Context, original, updated *entity.Role) error
 	
 	// Listing and filtering
 	List(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Role, int64, error)
+
+	// FindPage retrieves the page of roles matching filter selected by opts, by page
+	// number or cursor
+	FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Role, *models.PageInfo, error)
+
+	// Stream calls fn for every role matching filter in sort order, without loading them all
+	Stream(ctx context.Context, filter map[string]interface{}, sort []models.SortField, fn func(*entity.Role) error) error
+
+	// Count counts the roles matching filter
+	Count(ctx context.Context, filter map[string]interface{}) (int64, error)
 	
 	// Bulk operations
 	BulkSoftDelete(ctx context.Context, ids []string) (*models.BulkDeleteResponse, error) 
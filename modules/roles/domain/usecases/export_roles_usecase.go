@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
+)
+
+// ExportRolesUseCase reads every role matching a list filter, for exports
+type ExportRolesUseCase struct {
+	repo repository.RoleRepository
+}
+
+func NewExportRolesUseCase(repo repository.RoleRepository) *ExportRolesUseCase {
+	return &ExportRolesUseCase{
+		repo: repo,
+	}
+}
+
+// Count counts the roles an export of filter will contain
+func (uc *ExportRolesUseCase) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return uc.repo.Count(ctx, filter)
+}
+
+// Execute calls write for each role matching filter, in sort order
+func (uc *ExportRolesUseCase) Execute(ctx context.Context, filter map[string]interface{}, sort []models.SortField, write func(*entity.Role) error) error {
+	return uc.repo.Stream(ctx, filter, sort, write)
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
 )
@@ -20,6 +21,6 @@ func NewListRolesUseCase(repo repository.RoleRepository) *ListRolesUseCase {
 
 
 
-func (uc *ListRolesUseCase) Execute(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.Role, int64, error) {
-	return uc.repo.List(ctx, filter, page, limit)
+func (uc *ListRolesUseCase) Execute(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.Role, *models.PageInfo, error) {
+	return uc.repo.FindPage(ctx, filter, opts)
 }
\ No newline at end of file
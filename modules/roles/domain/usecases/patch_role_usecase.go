@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	permissionRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
+)
+
+// PatchRoleUseCase saves a partial update of a role
+type PatchRoleUseCase struct {
+	roleRepo       repository.RoleRepository
+	permissionRepo permissionRepo.PermissionRepository
+}
+
+func NewPatchRoleUseCase(roleRepo repository.RoleRepository, permissionRepo permissionRepo.PermissionRepository) *PatchRoleUseCase {
+	return &PatchRoleUseCase{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+// Execute writes the fields of updated that differ from original, the role as it was read
+func (uc *PatchRoleUseCase) Execute(ctx context.Context, original, updated *entity.Role) error {
+	// Check name uniqueness only if name is being changed
+	if updated.Name != original.Name {
+		exists, err := uc.roleRepo.ExistsByName(ctx, updated.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("role with this name already exists")
+		}
+	}
+
+	if !slices.Equal(updated.Permissions, original.Permissions) {
+		if err := validatePermissions(ctx, uc.permissionRepo, updated.Permissions); err != nil {
+			return err
+		}
+	}
+
+	return uc.roleRepo.Patch(ctx, original, updated)
+}
@@ -4,13 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
-	permissionEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
 	permissionRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/repository"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // RoleUseCase implements the Role business logic
@@ -53,7 +52,7 @@ func (uc *UpdateRoleUseCase) Execute(ctx context.Context, role *entity.Role) err
 
 	// Validate permission IDs if any are provided
 	if len(role.Permissions) > 0 {
-		if err := uc.validatePermissions(ctx, role.Permissions); err != nil {
+		if err := validatePermissions(ctx, uc.permissionRepo, role.Permissions); err != nil {
 			return err
 		}
 	}
@@ -64,17 +63,20 @@ func (uc *UpdateRoleUseCase) Execute(ctx context.Context, role *entity.Role) err
 	return uc.roleRepo.Update(ctx, role)
 }
 
-func (uc *UpdateRoleUseCase) validatePermissions(ctx context.Context, permissions []string) error {
-	for _, perm := range permissions {
-		resource := strings.Split(perm, ":")[0]
-		action := strings.Split(perm, ":")[1]
+// validatePermissions checks that every permission ID exists
+func validatePermissions(ctx context.Context, permRepo permissionRepo.PermissionRepository, permissionIDs []string) error {
+	for _, permID := range permissionIDs {
+		id, err := primitive.ObjectIDFromHex(permID)
+		if err != nil {
+			return fmt.Errorf("invalid permission ID %s", permID)
+		}
 
-		exists, err := uc.permissionRepo.ExistsByResourceAction(ctx, resource, permissionEntity.PermissionAction(action))
+		exists, err := permRepo.ExistsByID(ctx, id)
 		if err != nil {
-			return fmt.Errorf("failed to validate permission %s: %w", perm, err)
+			return fmt.Errorf("failed to validate permission %s: %w", permID, err)
 		}
 		if !exists {
-			return fmt.Errorf("permission  %s does not exist", perm)
+			return fmt.Errorf("permission with ID %s does not exist", permID)
 		}
 	}
 	return nil
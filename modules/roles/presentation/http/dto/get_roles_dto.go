@@ -4,10 +4,37 @@ package dto
 import (
 	"strconv"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// RoleResponseFields are the members the fields parameter can select for roles
+var RoleResponseFields = fieldset.Of(entity.Role{})
+
+// RoleFilterFields are the fields roles can be filtered by with the filter parameter
+var RoleFilterFields = listfilter.Fields{
+	"name":        {Path: "name", Type: listfilter.String},
+	"description": {Path: "description", Type: listfilter.String},
+	"scope":       {Path: "scope", Type: listfilter.String},
+	"isSystem":    {Path: "isSystem", Type: listfilter.Bool},
+	"permissions": {Path: "permissions", Type: listfilter.String},
+	"createdBy":   {Path: "createdBy", Type: listfilter.String},
+	"createdAt":   {Path: "createdAt", Type: listfilter.Date},
+	"updatedAt":   {Path: "updatedAt", Type: listfilter.Date},
+}
+
+// RoleSortFields are the fields roles can be sorted by
+var RoleSortFields = pagination.SortFields{
+	"name":      "name",
+	"scope":     "scope",
+	"createdAt": "createdAt",
+	"updatedAt": "updatedAt",
+}
+
 // GetRolesDto defines the query parameters for listing roles
 type GetRolesDto struct {
 	// Pagination parameters
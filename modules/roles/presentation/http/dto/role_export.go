@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"strconv"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+)
+
+// RoleExportColumns are the columns a role export can include, in default order
+var RoleExportColumns = export.Columns{
+	{Key: "id", Header: "ID"},
+	{Key: "name", Header: "Name"},
+	{Key: "description", Header: "Description"},
+	{Key: "scope", Header: "Scope"},
+	{Key: "organizationId", Header: "Organization ID"},
+	{Key: "isSystem", Header: "System"},
+	{Key: "permissions", Header: "Permissions"},
+	{Key: "permissionCount", Header: "Permission Count"},
+	{Key: "createdAt", Header: "Created At"},
+	{Key: "updatedAt", Header: "Updated At"},
+	{Key: "deletedAt", Header: "Deleted At"},
+}
+
+// RoleExportRow returns the export values of a role keyed by column key
+func RoleExportRow(role *entity.Role) map[string]string {
+	organizationID := ""
+	if role.OrganizationID != nil {
+		organizationID = role.OrganizationID.Hex()
+	}
+
+	return map[string]string{
+		"id":              role.ID.Hex(),
+		"name":            role.Name,
+		"description":     role.Description,
+		"scope":           string(role.Scope),
+		"organizationId":  organizationID,
+		"isSystem":        export.Bool(role.IsSystem),
+		"permissions":     export.List(role.Permissions),
+		"permissionCount": strconv.Itoa(len(role.Permissions)),
+		"createdAt":       export.Time(role.CreatedAt),
+		"updatedAt":       export.Time(role.UpdatedAt),
+		"deletedAt":       export.OptionalTime(role.DeletedAt),
+	}
+}
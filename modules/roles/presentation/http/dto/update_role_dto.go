@@ -14,7 +14,7 @@ type UpdateRoleDto struct {
 	// All fields are pointers to distinguish between nil (not provided) and empty values
 	Name        *string  `json:"name,omitempty"`
 	Description *string  `json:"description,omitempty"`
-	Permissions []string `json:"permissions,omitempty"`
+	Permissions []string `json:"permissions"`
 }
 
 // Validate performs validation on the UpdateRoleDto
@@ -49,6 +49,18 @@ func (dto *UpdateRoleDto) Validate() error {
 	return nil
 }
 
+// NewUpdateRoleDto returns every updatable field of a role. Partial updates are applied
+// to this document, then only the changed fields are applied back.
+func NewUpdateRoleDto(role *entity.Role) *UpdateRoleDto {
+	current := *role
+	permissions := append([]string{}, current.Permissions...)
+	return &UpdateRoleDto{
+		Name:        &current.Name,
+		Description: &current.Description,
+		Permissions: permissions,
+	}
+}
+
 // ApplyUpdates applies the update DTO to an existing role entity
 // Only updates fields that are provided in the DTO
 func (dto *UpdateRoleDto) ApplyUpdates(role *entity.Role) {
@@ -59,16 +71,9 @@ func (dto *UpdateRoleDto) ApplyUpdates(role *entity.Role) {
 	if dto.Description != nil {
 		role.Description = strings.TrimSpace(*dto.Description)
 	}
-
-	// // Update permissions if provided
-	// if dto.Permissions != nil {
-	// 	role.Permissions = make([]primitive.ObjectID, len(dto.Permissions))
-	// 	for i, permID := range dto.Permissions {
-	// 		// We already validated the format in Validate(), so this should not error
-	// 		objID, _ := primitive.ObjectIDFromHex(permID)
-	// 		role.Permissions[i] = objID
-	// 	}
-	// }
+	if dto.Permissions != nil {
+		role.Permissions = append([]string{}, dto.Permissions...)
+	}
 }
 
 // ToUpdateEntity creates a partial entity for update operations
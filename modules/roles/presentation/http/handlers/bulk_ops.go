@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/dto"
+)
+
+// BulkOps returns the operations of the roles bulk endpoint, the same for every caller
+func (h *RoleHandler) BulkOps(c *gin.Context) bulk.Ops {
+	return bulk.Ops{
+		"update":  {Action: "update", Prepare: h.bulkUpdate},
+		"delete":  {Action: "delete", Prepare: h.bulkDelete},
+		"restore": {Action: "restore", Prepare: h.bulkRestore},
+	}
+}
+
+// bulkUpdate applies data to each role as a JSON Merge Patch, like PatchRole
+func (h *RoleHandler) bulkUpdate(data json.RawMessage) (bulk.Apply, error) {
+	var fields map[string]json.RawMessage
+	if err := bulk.DecodeData(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("data must change at least one field")
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		role, err := h.GetRoleUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch role", err, http.StatusInternalServerError)
+		}
+		if role == nil {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Role not found", nil, http.StatusNotFound)
+		}
+
+		var changes dto.UpdateRoleDto
+		if err := patch.ApplyTo(patch.ContentTypeMergePatch, dto.NewUpdateRoleDto(role), data, &changes); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest)
+		}
+		if err := changes.Validate(); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+		}
+
+		original := *role
+		changes.ApplyUpdates(role)
+		if appErr := h.checkAssignablePermissions(ctx, &original, role); appErr != nil {
+			return appErr
+		}
+		if err := h.PatchRoleUseCase.Execute(ctx, &original, role); err != nil {
+			return middleware.NewVersionConflictAppError(err, "Failed to update role")
+		}
+		return nil
+	}, nil
+}
+
+func (h *RoleHandler) bulkDelete(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		deleted, err := h.SoftDeleteRoleUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to delete role", err, http.StatusInternalServerError)
+		}
+		if !deleted {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Role not found or already deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+func (h *RoleHandler) bulkRestore(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		restored, err := h.RestoreRoleUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to restore role", err, http.StatusInternalServerError)
+		}
+		if !restored {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "Role not found or not deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
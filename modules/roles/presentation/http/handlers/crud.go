@@ -3,7 +3,9 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/dto"
 	"github.com/gin-gonic/gin"
@@ -18,7 +20,10 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"role ID"	example("6824886e6b180b753cea43e9")
+//	@Param			fields	query		string	false	"Comma separated members to return, the ID is always included"	example(name,scope)
+//	@Param			If-None-Match	header		string	false	"ETag from an earlier read, answered with 304 if the role is unchanged"
 //	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.Role}
+//	@Header			200	{string}	ETag	"Version of the role, send it as If-Match when updating"
 //	@Failure		400	{object}	models.SwaggerErrorResponse
 //	@Failure		404	{object}	models.SwaggerErrorResponse
 //	@Failure		500	{object}	models.SwaggerErrorResponse
@@ -26,6 +31,12 @@ import (
 func (h *RoleHandler) GetRole(c *gin.Context) {
 	id := c.Param("id")
 
+	fields, err := fieldset.FromQuery(c, dto.RoleResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		middleware.HandleError(c, middleware.NewAppError(
@@ -58,7 +69,21 @@ func (h *RoleHandler) GetRole(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, role)
+	if middleware.NotModified(c, role.Version) {
+		return
+	}
+
+	response, err := fieldset.Select(role, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch role",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // CreateRole godoc
@@ -107,7 +132,7 @@ func (h *RoleHandler) CreateRole(c *gin.Context) {
 		existingPerms, _, err := h.ListPermissionsUseCase.Execute(ctx, map[string]interface{}{
 			"resource": perm.Resource,
 			"action":   string(perm.Action),
-		}, 1, 1)
+		}, models.ListOptions{Page: 1, Limit: 1, Count: models.CountNone})
 		if err != nil || len(existingPerms) == 0 {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error":      "Permission not found: " + permStr,
@@ -142,7 +167,7 @@ func (h *RoleHandler) CreateRole(c *gin.Context) {
 	// Check if role name already exists
 	existing, _, err := h.ListRolesUseCase.Execute(ctx, map[string]interface{}{
 		"name": createDto.Name,
-	}, 1, 1)
+	}, models.ListOptions{Page: 1, Limit: 1, Count: models.CountNone})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check existing roles"})
 		return
@@ -190,9 +215,13 @@ func (h *RoleHandler) CreateRole(c *gin.Context) {
 //	@Produce		json
 //	@Param			id			path		string					true	"Role ID"
 //	@Param			role	body		dto.UpdateRoleDto	true	"Role data to update"
+//	@Param			If-Match	header		string	true	"ETag of the role being updated"
 //	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Role}
+//	@Header			200	{string}	ETag	"New version of the role"
 //	@Failure		400			{object}	models.SwaggerErrorResponse
 //	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The role was changed by another request"
+//	@Failure		428	{object}	models.SwaggerErrorResponse	"If-Match header missing"
 //	@Failure		500			{object}	models.SwaggerErrorResponse
 //	@Router			/roles/{id} [put]
 func (h *RoleHandler) UpdateRole(c *gin.Context) {
@@ -231,6 +260,12 @@ func (h *RoleHandler) UpdateRole(c *gin.Context) {
 		return
 	}
 
+	// Refuse to overwrite changes the client has not seen
+	if appErr := middleware.CheckIfMatch(c, existingRole.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
 	// Parse update DTO
 	var updateDto dto.UpdateRoleDto
 	if err := c.ShouldBindJSON(&updateDto); err != nil {
@@ -255,19 +290,20 @@ func (h *RoleHandler) UpdateRole(c *gin.Context) {
 	}
 
 	// Apply updates to the existing role
+	original := *existingRole
 	updateDto.ApplyUpdates(existingRole)
+	if appErr := h.checkAssignablePermissions(c.Request.Context(), &original, existingRole); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
 
 	// Call use case to update
 	if err := h.UpdateRoleUseCase.Execute(c.Request.Context(), existingRole); err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to update role",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update role"))
 		return
 	}
 
+	middleware.SetETag(c, existingRole.Version)
 	c.JSON(http.StatusOK, existingRole)
 }
 
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/dto"
+)
+
+// ExportSource selects the roles an export request covers: the same filters and sort as
+// ListRoles, limited to system roles and the caller's organization's roles below global scope
+func (h *RoleHandler) ExportSource(c *gin.Context) (*export.Source, error) {
+	queryDto := dto.NewGetRolesDto(c)
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.RoleFilterFields); err != nil {
+		return nil, err
+	}
+	sort, err := pagination.ParseSort(c.Query("sort"), dto.RoleSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if authCtx := middleware.GetAuthContext(c.Request.Context()); authCtx != nil && authCtx.RoleScope != entity.RoleScopeGlobal {
+		visible := []interface{}{nil}
+		if authCtx.OrganizationID != nil {
+			visible = append(visible, *authCtx.OrganizationID)
+		}
+		filter["organizationId"] = map[string]interface{}{"$in": visible}
+	}
+
+	return &export.Source{
+		Count: func(ctx context.Context) (int64, error) {
+			return h.ExportRolesUseCase.Count(ctx, filter)
+		},
+		Rows: func(ctx context.Context, emit func(map[string]string) error) error {
+			return h.ExportRolesUseCase.Execute(ctx, filter, sort, func(role *entity.Role) error {
+				return emit(dto.RoleExportRow(role))
+			})
+		},
+	}, nil
+}
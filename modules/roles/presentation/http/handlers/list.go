@@ -3,7 +3,10 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/dto"
 	"github.com/gin-gonic/gin"
 )
@@ -17,6 +20,12 @@ import (
 //	@Produce		json
 //	@Param			page				query		int		false	"Page number"													default(1)
 //	@Param			limit				query		int		false	"Items per page"											default(20)	maximum(100)
+//	@Param			sort				query		string	false	"Comma separated sort fields, prefixed with - for descending"	example(-createdAt)
+//	@Param			after				query		string	false	"Cursor from nextCursor, returns the page after it"
+//	@Param			before				query		string	false	"Cursor from prevCursor, returns the page before it"
+//	@Param			count				query		string	false	"How total is computed, exact by default with page numbers and none with cursors"	Enums(exact, estimated, none)
+//	@Param			filter				query		string	false	"Filter expression, clauses field:operator:value separated by ;"	example(createdAt:gte:2026-01-01)
+//	@Param			fields				query		string	false	"Comma separated members to return, the ID is always included"	example(name,scope)
 //	@Param			name				query		string	false	"Filter by name (partial match, case-insensitive)"
 //	@Param			hasPermissions		query		bool	false	"Filter roles that have permissions (true) or no permissions (false)"
 //	@Param			hasAllPermissions	query		bool	false	"If true, role must have ALL specified permissions; if false, role must have ANY"
@@ -33,32 +42,43 @@ func (h *RoleHandler) ListRoles(c *gin.Context) {
 	// Parse query parameters using DTO
 	queryDto := dto.NewGetRolesDto(c)
 
+	listOpts, err := pagination.FromQuery(c, queryDto.Page, queryDto.Limit, dto.RoleSortFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch roles"))
+		return
+	}
+
+	fields, err := fieldset.FromQuery(c, dto.RoleResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch roles"))
+		return
+	}
+
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.RoleFilterFields); err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch roles"))
+		return
+	}
+
 	// Call use case with filter from DTO
-	roles, total, err := h.ListRolesUseCase.Execute(
+	roles, pageInfo, err := h.ListRolesUseCase.Execute(
 		c.Request.Context(),
-		queryDto.ToFilterMap(),
-		queryDto.Page,
-		queryDto.Limit,
+		filter,
+		listOpts,
 	)
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to fetch roles",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch roles"))
 		return
 	}
 
 	// Prepare response
-	response := gin.H{
-		"items":          roles,
-		"page":           queryDto.Page,
-		"limit":          queryDto.Limit,
-		"total":          total,
-		"totalPages":     (total + int64(queryDto.Limit) - 1) / int64(queryDto.Limit),
-		"includeDeleted": queryDto.IncludeDeleted,
+	items, err := fieldset.SelectAll(roles, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch roles"))
+		return
 	}
+	response := pagination.Response(items, pageInfo)
+	response["includeDeleted"] = queryDto.IncludeDeleted
 
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/dto"
+)
+
+// PatchRole godoc
+//
+//	@Summary		Partially update a role
+//	@Description	Apply a JSON Merge Patch (application/merge-patch+json) or JSON Patch (application/json-patch+json) to the updatable fields of a role. Only the changed fields are validated and saved; removing a field clears it.
+//	@Tags			roles
+//	@Accept			application/merge-patch+json,application/json-patch+json
+//	@Produce		json
+//	@Param			id			path		string	true	"Role ID"
+//	@Param			patch		body		dto.UpdateRoleDto	true	"Merge patch of the fields to change, or an array of JSON Patch operations"
+//	@Param			If-Match	header		string	false	"ETag of the role; when sent, the patch is refused if the role has changed since"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.Role}
+//	@Header			200	{string}	ETag	"New version of the role"
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		403	{object}	models.SwaggerErrorResponse	"The caller cannot assign one of the permissions"
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		409	{object}	models.SwaggerErrorResponse	"A JSON Patch test operation failed"
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The role was changed by another request"
+//	@Failure		415	{object}	models.SwaggerErrorResponse	"Unsupported patch format"
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/roles/{id} [patch]
+func (h *RoleHandler) PatchRole(c *gin.Context) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid role ID",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	existingRole, err := h.GetRoleUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch role",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	if existingRole == nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"Role not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return
+	}
+
+	if appErr := middleware.CheckIfMatchIfPresent(c, existingRole.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// Patch the updatable fields and keep only the ones the patch changed
+	var changes dto.UpdateRoleDto
+	if err := patch.ApplyTo(c.ContentType(), dto.NewUpdateRoleDto(existingRole), body, &changes); err != nil {
+		middleware.HandleError(c, middleware.NewPatchAppError(c, err, "Failed to apply patch"))
+		return
+	}
+
+	if err := changes.Validate(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	original := *existingRole
+	changes.ApplyUpdates(existingRole)
+	if appErr := h.checkAssignablePermissions(c.Request.Context(), &original, existingRole); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	if err := h.PatchRoleUseCase.Execute(c.Request.Context(), &original, existingRole); err != nil {
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update role"))
+		return
+	}
+
+	middleware.SetETag(c, existingRole.Version)
+	c.JSON(http.StatusOK, existingRole)
+}
+
+// checkAssignablePermissions returns a forbidden error unless the caller may assign every
+// permission of updated, as when creating a role. Nothing is checked when the
+// permissions did not change.
+func (h *RoleHandler) checkAssignablePermissions(ctx context.Context, original, updated *entity.Role) *middleware.AppError {
+	if slices.Equal(original.Permissions, updated.Permissions) {
+		return nil
+	}
+	if err := h.rbacService.ValidateRolePermissions(ctx, middleware.GetAuthContext(ctx), updated.Permissions); err != nil {
+		return middleware.NewAppError(middleware.ErrorCodeForbidden, err.Error(), nil, http.StatusForbidden)
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ type RoleHandler struct {
 	rbacService                middleware.RBACService
 	permissionValidator        *middleware.PermissionValidator
 	ListPermissionsUseCase     *permUsecases.ListPermissionsUseCase
+	PatchRoleUseCase           *usecases.PatchRoleUseCase
+	ExportRolesUseCase         *usecases.ExportRolesUseCase
 }
 
 func NewRoleHandler(
@@ -32,7 +34,9 @@ func NewRoleHandler(
 	HardDeleteRoleUseCase *usecases.HardDeleteRoleUseCase,
 	BulkRestoreRolesUseCase *usecases.BulkRestoreRolesUseCase,
 	ListPermissionsUseCase *permUsecases.ListPermissionsUseCase,
-	// rbacService middleware.RBACService,
+	PatchRoleUseCase *usecases.PatchRoleUseCase,
+	ExportRolesUseCase *usecases.ExportRolesUseCase,
+	rbacService middleware.RBACService,
 	// permissionValidator *middleware.PermissionValidator,
 
 ) *RoleHandler {
@@ -47,7 +51,9 @@ func NewRoleHandler(
 		HardDeleteRoleUseCase:      HardDeleteRoleUseCase,
 		BulkRestoreRolesUseCase:    BulkRestoreRolesUseCase,
 		ListPermissionsUseCase:     ListPermissionsUseCase,
-		// rbacService:                rbacService,
+		PatchRoleUseCase:           PatchRoleUseCase,
+		ExportRolesUseCase:         ExportRolesUseCase,
+		rbacService:                rbacService,
 		// permissionValidator:        permissionValidator,
 	}
 }
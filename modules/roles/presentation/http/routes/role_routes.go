@@ -2,13 +2,16 @@ package routes
 
 import (
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	exportHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/presentation/http/handlers"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/dto"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/presentation/http/handlers"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterRoleRoutes(router *gin.RouterGroup, handler *handlers.RoleHandler, app *container.AppContainer) {
+func RegisterRoleRoutes(router *gin.RouterGroup, handler *handlers.RoleHandler, app *container.AppContainer, exports *exportHandlers.ExportHandler, bulkOps *bulk.Handler) {
 	roleGroup := router.Group(constants.RoleBasePath)
 
 	roleGroup.Use(middleware.AutoGuard(app.RBACService)) // middleware.WithOwnership("organizationId", "id"),
@@ -19,12 +22,18 @@ func RegisterRoleRoutes(router *gin.RouterGroup, handler *handlers.RoleHandler,
 
 		roleGroup.DELETE(constants.BulkDeleteRolesPath, handler.BulkSoftDeleteRoles)
 		roleGroup.POST(constants.BulkRestoreRolesPath, handler.BulkRestoreRoles)
+		roleGroup.GET(constants.ExportRolesPath, exports.RequireEnabled(), exports.Export("roles", dto.RoleExportColumns, handler.ExportSource))
 
 		roleGroup.GET(constants.GetRolePath, handler.GetRole)
 		roleGroup.PUT(constants.UpdateRolePath, handler.UpdateRole)
+		roleGroup.PATCH(constants.UpdateRolePath, handler.PatchRole)
 		roleGroup.DELETE(constants.DeleteRolePath, handler.SoftDeleteRole)
 
 		roleGroup.POST(constants.RestoreRolePath, handler.RestoreRole)
 		roleGroup.DELETE(constants.HardDeleteRolePath, handler.HardDeleteRole)
 	}
+
+	// The bulk endpoint checks the permission of each operation itself
+	bulkGroup := router.Group(constants.RoleBasePath, middleware.MultiLayerGuard(app.RBACService, middleware.GuardConfig{RequireAuth: true}))
+	bulkGroup.POST(constants.BulkRolesPath, bulkOps.Bulk("roles", handler.BulkOps))
 }
@@ -0,0 +1,175 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// nameFields is the field each resource's documents are named by
+var nameFields = map[string]string{
+	entity.ResourceUsers:         "fullName",
+	entity.ResourceOrganizations: "name",
+	entity.ResourceRoles:         "name",
+	entity.ResourceLocations:     "name",
+}
+
+// MongoTrashDatasource reads soft-deleted documents from the collection of each resource,
+// which is named after it.
+type MongoTrashDatasource struct {
+	db *mongo.Database
+}
+
+// NewMongoTrashDatasource creates a new datasource. The deletedAt indexes of the collections are created by migrations.
+func NewMongoTrashDatasource(db *mongo.Database) *MongoTrashDatasource {
+	return &MongoTrashDatasource{db: db}
+}
+
+// List returns one page of deleted documents across the filter's resources, most recently
+// deleted first, and the total number of matches.
+func (ds *MongoTrashDatasource) List(ctx context.Context, filter entity.TrashFilter, opts models.ListOptions) ([]model.TrashItemModel, *models.PageInfo, error) {
+	info := &models.PageInfo{Page: opts.Page, Limit: opts.Limit, HasPrev: opts.Page > 1}
+
+	resources := filter.Resources
+	if len(resources) == 0 {
+		resources = entity.Resources
+	}
+	var pipeline mongo.Pipeline
+	var base string
+	for _, resource := range resources {
+		stages := ds.resourceStages(resource, filter)
+		if stages == nil {
+			continue
+		}
+		if base == "" {
+			base = resource
+			pipeline = append(pipeline, stages...)
+			continue
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$unionWith", Value: bson.M{"coll": resource, "pipeline": stages}}})
+	}
+	if base == "" {
+		var total int64
+		info.Total = &total
+		return []model.TrashItemModel{}, info, nil
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: database.DeletedAtField, Value: -1}, {Key: "_id", Value: -1}}}},
+		bson.D{{Key: "$facet", Value: bson.M{
+			"items": bson.A{
+				bson.M{"$skip": (opts.Page - 1) * opts.Limit},
+				bson.M{"$limit": opts.Limit + 1},
+			},
+			"total": bson.A{bson.M{"$count": "count"}},
+		}}},
+	)
+
+	cursor, err := ds.db.Collection(base).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Items []model.TrashItemModel `bson:"items"`
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, nil, err
+	}
+
+	items := []model.TrashItemModel{}
+	var total int64
+	if len(results) > 0 {
+		items = results[0].Items
+		if len(results[0].Total) > 0 {
+			total = results[0].Total[0].Count
+		}
+	}
+	if len(items) > opts.Limit {
+		items = items[:opts.Limit]
+		info.HasNext = true
+	}
+	info.Total = &total
+	return items, info, nil
+}
+
+// Get returns the deleted document of resource with id.
+// Returns (nil, nil) if not found or not deleted.
+func (ds *MongoTrashDatasource) Get(ctx context.Context, resource string, id primitive.ObjectID) (*model.TrashItemModel, error) {
+	items, err := ds.find(ctx, resource, bson.M{"_id": id}, 1)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// Expired returns up to limit documents of resource deleted before the given time, oldest first.
+func (ds *MongoTrashDatasource) Expired(ctx context.Context, resource string, before time.Time, limit int) ([]model.TrashItemModel, error) {
+	return ds.find(ctx, resource, bson.M{database.DeletedAtField: bson.M{"$lt": before}}, limit)
+}
+
+func (ds *MongoTrashDatasource) find(ctx context.Context, resource string, filter bson.M, limit int) ([]model.TrashItemModel, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$and": bson.A{deletedMatch(), filter}}}},
+		{{Key: "$sort", Value: bson.D{{Key: database.DeletedAtField, Value: 1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: projection(resource)}},
+	}
+	cursor, err := ds.db.Collection(resource).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	items := []model.TrashItemModel{}
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// resourceStages returns the stages selecting the deleted documents of resource that
+// match filter, or nil when none can match
+func (ds *MongoTrashDatasource) resourceStages(resource string, filter entity.TrashFilter) mongo.Pipeline {
+	match := bson.M{}
+	if filter.DeletedBy != "" {
+		match[database.DeletedByField] = filter.DeletedBy
+	}
+	if filter.OrganizationID != nil {
+		// Organizations and locations belong to no organization's trash
+		if resource != entity.ResourceUsers && resource != entity.ResourceRoles {
+			return nil
+		}
+		match["organizationId"] = *filter.OrganizationID
+	}
+	return mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$and": bson.A{deletedMatch(), match}}}},
+		{{Key: "$project", Value: projection(resource)}},
+	}
+}
+
+func deletedMatch() bson.M {
+	return bson.M{database.DeletedAtField: bson.M{"$ne": nil}}
+}
+
+// projection maps a document of resource onto the fields of TrashItemModel
+func projection(resource string) bson.M {
+	return bson.M{
+		"resource":              bson.M{"$literal": resource},
+		"name":                  "$" + nameFields[resource],
+		"organizationId":        bson.M{"$toString": "$organizationId"},
+		database.DeletedAtField: 1,
+		database.DeletedByField: 1,
+	}
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TrashItemModel is the projection of a soft-deleted document shared by every resource
+type TrashItemModel struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	Resource       string             `bson:"resource"`
+	Name           string             `bson:"name"`
+	OrganizationID string             `bson:"organizationId,omitempty"`
+	DeletedAt      time.Time          `bson:"deletedAt"`
+	DeletedBy      string             `bson:"deletedBy,omitempty"`
+}
+
+// ToEntity maps model→domain
+func (m *TrashItemModel) ToEntity() entity.TrashItem {
+	return entity.TrashItem{
+		Resource:       m.Resource,
+		ID:             m.ID,
+		Name:           m.Name,
+		OrganizationID: m.OrganizationID,
+		DeletedAt:      m.DeletedAt,
+		DeletedBy:      m.DeletedBy,
+	}
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Ensure interface compliance
+var _ repository.TrashRepository = (*TrashRepositoryMongo)(nil)
+
+// TrashRepositoryMongo implements TrashRepository using MongoDB.
+type TrashRepositoryMongo struct {
+	datasource *datasource.MongoTrashDatasource
+}
+
+// NewTrashRepositoryMongo creates a new TrashRepositoryMongo.
+func NewTrashRepositoryMongo(ds *datasource.MongoTrashDatasource) *TrashRepositoryMongo {
+	return &TrashRepositoryMongo{datasource: ds}
+}
+
+// List returns one page of deleted records.
+func (r *TrashRepositoryMongo) List(ctx context.Context, filter entity.TrashFilter, opts models.ListOptions) ([]entity.TrashItem, *models.PageInfo, error) {
+	ms, info, err := r.datasource.List(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toEntities(ms), info, nil
+}
+
+// Get returns the deleted record, or nil if it is not in the trash.
+func (r *TrashRepositoryMongo) Get(ctx context.Context, resource string, id primitive.ObjectID) (*entity.TrashItem, error) {
+	m, err := r.datasource.Get(ctx, resource, id)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	e := m.ToEntity()
+	return &e, nil
+}
+
+// Expired returns records of resource deleted before the given time.
+func (r *TrashRepositoryMongo) Expired(ctx context.Context, resource string, before time.Time, limit int) ([]entity.TrashItem, error) {
+	ms, err := r.datasource.Expired(ctx, resource, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(ms), nil
+}
+
+func toEntities(ms []model.TrashItemModel) []entity.TrashItem {
+	items := make([]entity.TrashItem, len(ms))
+	for i := range ms {
+		items[i] = ms[i].ToEntity()
+	}
+	return items
+}
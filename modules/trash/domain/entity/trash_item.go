@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Resources whose soft-deleted records are kept in the trash
+const (
+	ResourceUsers         = "users"
+	ResourceOrganizations = "organizations"
+	ResourceRoles         = "roles"
+	ResourceLocations     = "locations"
+)
+
+// Resources lists every resource in the trash, in listing order
+var Resources = []string{ResourceUsers, ResourceOrganizations, ResourceRoles, ResourceLocations}
+
+var (
+	ErrUnknownResource   = errors.New("unknown trash resource, use users, organizations, roles or locations")
+	ErrTrashItemNotFound = errors.New("item not found in the trash")
+)
+
+// IsResource reports whether resource is kept in the trash
+func IsResource(resource string) bool {
+	for _, r := range Resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// TrashItem is a soft-deleted record of any resource
+type TrashItem struct {
+	Resource       string             `json:"resource" example:"users"`
+	ID             primitive.ObjectID `json:"id"`
+	Name           string             `json:"name" example:"Priya Sharma"`
+	OrganizationID string             `json:"organizationId,omitempty"`
+	DeletedAt      time.Time          `json:"deletedAt"`
+	DeletedBy      string             `json:"deletedBy,omitempty"` // empty for system deletes and records deleted before deleters were recorded
+	PurgeAt        *time.Time         `json:"purgeAt,omitempty"`   // when the retention policy purges it, nil when it is kept until purged by hand
+}
+
+// TrashFilter selects the items of a trash listing
+type TrashFilter struct {
+	Resources []string // empty for every resource
+	DeletedBy string
+
+	// OrganizationID limits the trash to the users and roles of one organization. Callers
+	// below global scope without an organization get the nil ID, which matches nothing.
+	OrganizationID *primitive.ObjectID
+}
+
+// Includes reports whether item is visible under the filter's organization scope
+func (f TrashFilter) Includes(item *TrashItem) bool {
+	if f.OrganizationID == nil {
+		return true
+	}
+	if item.Resource != ResourceUsers && item.Resource != ResourceRoles {
+		return false
+	}
+	return !f.OrganizationID.IsZero() && item.OrganizationID == f.OrganizationID.Hex()
+}
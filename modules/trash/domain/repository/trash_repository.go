@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TrashRepository reads soft-deleted records across the collections of every resource
+type TrashRepository interface {
+	// List returns one page of deleted records matching filter, most recently deleted first.
+	List(ctx context.Context, filter entity.TrashFilter, opts models.ListOptions) ([]entity.TrashItem, *models.PageInfo, error)
+
+	// Get returns the deleted record of resource with id, or nil if it does not exist or
+	// is not deleted.
+	Get(ctx context.Context, resource string, id primitive.ObjectID) (*entity.TrashItem, error)
+
+	// Expired returns up to limit records of resource deleted before the given time,
+	// oldest first.
+	Expired(ctx context.Context, resource string, before time.Time, limit int) ([]entity.TrashItem, error)
+}
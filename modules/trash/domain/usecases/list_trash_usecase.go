@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/repository"
+)
+
+// ListTrashUseCase lists deleted records across resources
+type ListTrashUseCase struct {
+	repo      repository.TrashRepository
+	retention map[string]time.Duration
+}
+
+// NewListTrashUseCase creates the use case. retention is how long each resource is kept
+// in the trash, to report when items are purged.
+func NewListTrashUseCase(repo repository.TrashRepository, retention map[string]time.Duration) *ListTrashUseCase {
+	return &ListTrashUseCase{repo: repo, retention: retention}
+}
+
+// Execute returns one page of deleted records matching filter, most recently deleted first
+func (uc *ListTrashUseCase) Execute(ctx context.Context, filter entity.TrashFilter, opts models.ListOptions) ([]entity.TrashItem, *models.PageInfo, error) {
+	for _, resource := range filter.Resources {
+		if !entity.IsResource(resource) {
+			return nil, nil, entity.ErrUnknownResource
+		}
+	}
+
+	items, info, err := uc.repo.List(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range items {
+		if retention := uc.retention[items[i].Resource]; retention > 0 {
+			purgeAt := items[i].DeletedAt.Add(retention)
+			items[i].PurgeAt = &purgeAt
+		}
+	}
+	return items, info, nil
+}
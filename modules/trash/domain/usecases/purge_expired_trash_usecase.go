@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/repository"
+)
+
+// purgeBatchSize is how many expired records are read at a time
+const purgeBatchSize = 100
+
+// PurgeExpiredTrashUseCase applies the retention policy, purging records that have been
+// in the trash longer than their resource's retention
+type PurgeExpiredTrashUseCase struct {
+	repo      repository.TrashRepository
+	purger    *PurgeTrashItemUseCase
+	retention map[string]time.Duration
+}
+
+// NewPurgeExpiredTrashUseCase creates the use case. Resources without a positive
+// retention are kept until purged by hand.
+func NewPurgeExpiredTrashUseCase(repo repository.TrashRepository, purger *PurgeTrashItemUseCase, retention map[string]time.Duration) *PurgeExpiredTrashUseCase {
+	return &PurgeExpiredTrashUseCase{repo: repo, purger: purger, retention: retention}
+}
+
+// Execute purges every expired record and returns how many were purged. A record that
+// fails to purge is logged and retried on the next run.
+func (uc *PurgeExpiredTrashUseCase) Execute(ctx context.Context) (int, error) {
+	purged := 0
+	now := time.Now()
+	for _, resource := range entity.Resources {
+		retention := uc.retention[resource]
+		if retention <= 0 {
+			continue
+		}
+
+		for {
+			items, err := uc.repo.Expired(ctx, resource, now.Add(-retention), purgeBatchSize)
+			if err != nil {
+				return purged, err
+			}
+
+			failed := 0
+			for i := range items {
+				done, err := uc.purger.purge(ctx, &items[i])
+				if err != nil {
+					log.Printf("Failed to purge expired %s %s: %v", resource, items[i].ID.Hex(), err)
+					failed++
+					continue
+				}
+				if done {
+					purged++
+				}
+			}
+			// Stop at the last batch, or when a batch made no progress so failures are not
+			// read again forever
+			if len(items) < purgeBatchSize || failed == len(items) {
+				break
+			}
+		}
+	}
+	return purged, nil
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/repository"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	uploadRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PurgeTrashItemUseCase permanently deletes a record in the trash along with its files
+type PurgeTrashItemUseCase struct {
+	repo     repository.TrashRepository
+	deleters map[string]ItemAction
+	files    uploadRepo.FileReferenceRepository
+	storage  services.FileService
+}
+
+// NewPurgeTrashItemUseCase creates the use case. deleters permanently deletes a record of
+// each resource; files finds the files a record references so storage can delete them.
+func NewPurgeTrashItemUseCase(repo repository.TrashRepository, deleters map[string]ItemAction, files uploadRepo.FileReferenceRepository, storage services.FileService) *PurgeTrashItemUseCase {
+	return &PurgeTrashItemUseCase{repo: repo, deleters: deleters, files: files, storage: storage}
+}
+
+// Execute purges the deleted record of resource with id. Records outside the scope of
+// filter are reported as not found.
+func (uc *PurgeTrashItemUseCase) Execute(ctx context.Context, filter entity.TrashFilter, resource string, id primitive.ObjectID) (*entity.TrashItem, error) {
+	item, err := findItem(ctx, uc.repo, filter, resource, id)
+	if err != nil {
+		return nil, err
+	}
+	purged, err := uc.purge(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	if !purged {
+		return nil, entity.ErrTrashItemNotFound
+	}
+	return item, nil
+}
+
+// purge deletes the record of item, then the uploaded files it referenced. Files are
+// read first since the record is their only reference; failing to delete one is logged
+// and left to storage GC.
+func (uc *PurgeTrashItemUseCase) purge(ctx context.Context, item *entity.TrashItem) (bool, error) {
+	deleteRecord, ok := uc.deleters[item.Resource]
+	if !ok {
+		return false, entity.ErrUnknownResource
+	}
+
+	var keys []string
+	err := uc.files.ForEachDocumentReference(ctx, item.Resource, item.ID, func(ref uploadEntity.FileReference) error {
+		// External URLs and keys outside the upload prefix are not ours to delete
+		if key := services.FileKey(uc.storage, ref.Ref); strings.HasPrefix(key, uploadEntity.StorageKeyPrefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := deleteRecord(ctx, item.ID)
+	if err != nil || !deleted {
+		return false, err
+	}
+	for _, key := range keys {
+		if err := uc.storage.DeleteFile(ctx, key); err != nil {
+			log.Printf("Failed to delete file %s of purged %s %s: %v", key, item.Resource, item.ID.Hex(), err)
+		}
+	}
+	return true, nil
+}
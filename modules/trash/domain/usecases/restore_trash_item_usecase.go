@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ItemAction changes one record of a resource through that resource's own use case,
+// reporting false when the record was not there to change
+type ItemAction func(ctx context.Context, id primitive.ObjectID) (bool, error)
+
+// RestoreTrashItemUseCase takes a record out of the trash
+type RestoreTrashItemUseCase struct {
+	repo      repository.TrashRepository
+	restorers map[string]ItemAction
+}
+
+// NewRestoreTrashItemUseCase creates the use case. restorers restores a record of each resource.
+func NewRestoreTrashItemUseCase(repo repository.TrashRepository, restorers map[string]ItemAction) *RestoreTrashItemUseCase {
+	return &RestoreTrashItemUseCase{repo: repo, restorers: restorers}
+}
+
+// Execute restores the deleted record of resource with id. Records outside the scope
+// of filter are reported as not found.
+func (uc *RestoreTrashItemUseCase) Execute(ctx context.Context, filter entity.TrashFilter, resource string, id primitive.ObjectID) (*entity.TrashItem, error) {
+	item, err := findItem(ctx, uc.repo, filter, resource, id)
+	if err != nil {
+		return nil, err
+	}
+	restore, ok := uc.restorers[resource]
+	if !ok {
+		return nil, entity.ErrUnknownResource
+	}
+
+	restored, err := restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		// Restored or purged since it was read
+		return nil, entity.ErrTrashItemNotFound
+	}
+	return item, nil
+}
+
+// findItem returns the deleted record of resource with id when filter includes it
+func findItem(ctx context.Context, repo repository.TrashRepository, filter entity.TrashFilter, resource string, id primitive.ObjectID) (*entity.TrashItem, error) {
+	if !entity.IsResource(resource) {
+		return nil, entity.ErrUnknownResource
+	}
+	item, err := repo.Get(ctx, resource, id)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil || !filter.Includes(item) {
+		return nil, entity.ErrTrashItemNotFound
+	}
+	return item, nil
+}
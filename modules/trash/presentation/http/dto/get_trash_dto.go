@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"github.com/gin-gonic/gin"
+)
+
+// GetTrashDto defines the query parameters for listing the trash
+type GetTrashDto struct {
+	Page      int      `form:"page" json:"page"`
+	Limit     int      `form:"limit" json:"limit"`
+	Resources []string `form:"resource" json:"resource"`
+	DeletedBy string   `form:"deletedBy" json:"deletedBy"`
+}
+
+// NewGetTrashDto creates a new DTO from query parameters
+func NewGetTrashDto(c *gin.Context) GetTrashDto {
+	dto := GetTrashDto{DeletedBy: c.Query("deletedBy")}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	dto.Page = page
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	dto.Limit = limit
+
+	// Resources may be repeated or comma separated
+	for _, param := range c.QueryArray("resource") {
+		for _, resource := range strings.Split(param, ",") {
+			if resource = strings.TrimSpace(resource); resource != "" {
+				dto.Resources = append(dto.Resources, resource)
+			}
+		}
+	}
+	return dto
+}
+
+// ToFilter converts the DTO to a trash filter, without the caller's scope
+func (dto *GetTrashDto) ToFilter() entity.TrashFilter {
+	return entity.TrashFilter{Resources: dto.Resources, DeletedBy: dto.DeletedBy}
+}
+
+// ListOptions returns the page the DTO selects
+func (dto *GetTrashDto) ListOptions() models.ListOptions {
+	return models.ListOptions{Page: dto.Page, Limit: dto.Limit, Count: models.CountExact}
+}
+
+// PaginatedTrashResponse represents the paginated response for the trash
+type PaginatedTrashResponse struct {
+	Items      []entity.TrashItem `json:"items"`
+	Page       int                `json:"page" example:"1"`
+	Limit      int                `json:"limit" example:"20"`
+	Total      int64              `json:"total" example:"2"`
+	TotalPages int64              `json:"totalPages" example:"1"`
+	HasNext    bool               `json:"hasNext" example:"false"`
+	HasPrev    bool               `json:"hasPrev" example:"false"`
+}
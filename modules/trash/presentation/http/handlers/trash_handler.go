@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/domain/usecases"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/presentation/http/dto"
+)
+
+// TrashHandler serves the trash endpoints
+type TrashHandler struct {
+	ListTrashUseCase        *usecases.ListTrashUseCase
+	RestoreTrashItemUseCase *usecases.RestoreTrashItemUseCase
+	PurgeTrashItemUseCase   *usecases.PurgeTrashItemUseCase
+}
+
+// NewTrashHandler creates a new TrashHandler
+func NewTrashHandler(
+	listUC *usecases.ListTrashUseCase,
+	restoreUC *usecases.RestoreTrashItemUseCase,
+	purgeUC *usecases.PurgeTrashItemUseCase,
+) *TrashHandler {
+	return &TrashHandler{
+		ListTrashUseCase:        listUC,
+		RestoreTrashItemUseCase: restoreUC,
+		PurgeTrashItemUseCase:   purgeUC,
+	}
+}
+
+// ListTrash godoc
+//
+//	@Summary		List the trash
+//	@Description	List soft-deleted users, organizations, roles and locations, most recently deleted first, with who deleted them and when the retention policy purges them. Callers below global scope only see the users and roles of their organization.
+//	@Tags			trash
+//	@Produce		json
+//	@Param			page		query		int		false	"Page number"												default(1)
+//	@Param			limit		query		int		false	"Items per page"											default(20)	maximum(100)
+//	@Param			resource	query		string	false	"Comma separated resources to list, all when omitted"		example(users,roles)
+//	@Param			deletedBy	query		string	false	"Only items deleted by this user ID"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=dto.PaginatedTrashResponse}
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/trash [get]
+func (h *TrashHandler) ListTrash(c *gin.Context) {
+	queryDto := dto.NewGetTrashDto(c)
+
+	items, pageInfo, err := h.ListTrashUseCase.Execute(c.Request.Context(), scopedFilter(c, queryDto.ToFilter()), queryDto.ListOptions())
+	if err != nil {
+		if errors.Is(err, entity.ErrUnknownResource) {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+			return
+		}
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch trash", err, http.StatusInternalServerError))
+		return
+	}
+
+	c.JSON(http.StatusOK, pagination.Response(items, pageInfo))
+}
+
+// RestoreTrashItem godoc
+//
+//	@Summary		Restore an item from the trash
+//	@Description	Restore a soft-deleted record, as its resource's restore endpoint does
+//	@Tags			trash
+//	@Produce		json
+//	@Param			resource	path		string	true	"Resource of the item"	Enums(users, organizations, roles, locations)
+//	@Param			id			path		string	true	"Item ID"				example("6824886e6b180b753cea43e9")
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.TrashItem}
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/trash/{resource}/{id}/restore [post]
+func (h *TrashHandler) RestoreTrashItem(c *gin.Context) {
+	id, ok := itemID(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.RestoreTrashItemUseCase.Execute(c.Request.Context(), scopedFilter(c, entity.TrashFilter{}), c.Param("resource"), id)
+	if err != nil {
+		handleItemError(c, err, "Failed to restore item")
+		return
+	}
+	c.JSON(http.StatusOK, item)
+}
+
+// PurgeTrashItem godoc
+//
+//	@Summary		Purge an item from the trash
+//	@Description	Permanently delete a soft-deleted record and the uploaded files it references. This cannot be undone.
+//	@Tags			trash
+//	@Produce		json
+//	@Param			resource	path		string	true	"Resource of the item"	Enums(users, organizations, roles, locations)
+//	@Param			id			path		string	true	"Item ID"				example("6824886e6b180b753cea43e9")
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=entity.TrashItem}
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/trash/{resource}/{id} [delete]
+func (h *TrashHandler) PurgeTrashItem(c *gin.Context) {
+	id, ok := itemID(c)
+	if !ok {
+		return
+	}
+
+	item, err := h.PurgeTrashItemUseCase.Execute(c.Request.Context(), scopedFilter(c, entity.TrashFilter{}), c.Param("resource"), id)
+	if err != nil {
+		handleItemError(c, err, "Failed to purge item")
+		return
+	}
+	c.JSON(http.StatusOK, item)
+}
+
+// scopedFilter limits filter to the caller's organization below global scope
+func scopedFilter(c *gin.Context, filter entity.TrashFilter) entity.TrashFilter {
+	authCtx := middleware.GetAuthContext(c.Request.Context())
+	if authCtx != nil && authCtx.RoleScope == roleEntity.RoleScopeGlobal {
+		return filter
+	}
+	orgID := primitive.NilObjectID
+	if authCtx != nil && authCtx.OrganizationID != nil {
+		orgID = *authCtx.OrganizationID
+	}
+	filter.OrganizationID = &orgID
+	return filter
+}
+
+func itemID(c *gin.Context) (primitive.ObjectID, bool) {
+	id, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid item ID", err, http.StatusBadRequest))
+		return primitive.NilObjectID, false
+	}
+	return id, true
+}
+
+func handleItemError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, entity.ErrUnknownResource):
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+	case errors.Is(err, entity.ErrTrashItemNotFound):
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "Item not found in the trash", nil, http.StatusNotFound))
+	default:
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, message, err, http.StatusInternalServerError))
+	}
+}
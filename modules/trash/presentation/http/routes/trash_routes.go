@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/trash/presentation/http/handlers"
+)
+
+// RegisterTrashRoutes registers the trash routes, guarded by the trash:list, trash:restore
+// and trash:delete permissions
+func RegisterTrashRoutes(router *gin.RouterGroup, handler *handlers.TrashHandler, app *container.AppContainer) {
+	trashGroup := router.Group(constants.TrashBasePath)
+
+	trashGroup.Use(middleware.AutoGuard(app.RBACService, middleware.WithCustomResource("trash")))
+
+	{
+		trashGroup.GET(constants.ListTrashPath, handler.ListTrash)
+		trashGroup.POST(constants.RestoreTrashItemPath, handler.RestoreTrashItem)
+		trashGroup.DELETE(constants.PurgeTrashItemPath, handler.PurgeTrashItem)
+	}
+}
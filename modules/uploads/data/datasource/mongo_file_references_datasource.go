@@ -0,0 +1,179 @@
+package datasource
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// imageRefs are the file fields of an image with variants
+type imageRefs struct {
+	URL      string            `bson:"url"`
+	Variants map[string]string `bson:"variants"`
+}
+
+// MongoFileReferenceDatasource reads the file fields of every collection that stores uploads.
+// Soft-deleted documents are included, since restoring them needs their files.
+type MongoFileReferenceDatasource struct {
+	db *mongo.Database
+}
+
+// NewMongoFileReferenceDatasource creates a new datasource.
+func NewMongoFileReferenceDatasource(db *mongo.Database) *MongoFileReferenceDatasource {
+	return &MongoFileReferenceDatasource{db: db}
+}
+
+// ForEachReference calls fn for every referenced file.
+func (ds *MongoFileReferenceDatasource) ForEachReference(ctx context.Context, fn func(entity.FileReference) error) error {
+	scans := []func(context.Context, bson.M, func(entity.FileReference) error) error{
+		ds.organizationRefs,
+		ds.userRefs,
+		ds.locationRefs,
+	}
+	for _, scan := range scans {
+		if err := scan(ctx, bson.M{}, fn); err != nil {
+			return err
+		}
+	}
+	if err := ds.uploadSessionRefs(ctx, fn); err != nil {
+		return err
+	}
+	return ds.exportJobRefs(ctx, fn)
+}
+
+// ForEachDocumentReference calls fn for every file referenced by one document of
+// collection. Collections without files report none.
+func (ds *MongoFileReferenceDatasource) ForEachDocumentReference(ctx context.Context, collection string, id primitive.ObjectID, fn func(entity.FileReference) error) error {
+	scans := map[string]func(context.Context, bson.M, func(entity.FileReference) error) error{
+		"organizations": ds.organizationRefs,
+		"users":         ds.userRefs,
+		"locations":     ds.locationRefs,
+	}
+	scan, ok := scans[collection]
+	if !ok {
+		return nil
+	}
+	return scan(ctx, bson.M{"_id": id}, fn)
+}
+
+type organizationFileDoc struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	Logo         string             `bson:"logo"`
+	LogoVariants map[string]string  `bson:"logoVariants"`
+}
+
+type userFileDoc struct {
+	ID                   primitive.ObjectID `bson:"_id"`
+	ProfilePhotoURL      string             `bson:"profilePhotoUrl"`
+	ProfilePhotoVariants map[string]string  `bson:"profilePhotoVariants"`
+}
+
+type locationFileDoc struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	Media     []imageRefs        `bson:"media"`
+	MediaURLs struct {
+		Photos        []string    `bson:"photos"`
+		Videos        []string    `bson:"videos"`
+		PhotoVariants []imageRefs `bson:"photoVariants"`
+	} `bson:"mediaUrls"`
+}
+
+type uploadSessionFileDoc struct {
+	ID  primitive.ObjectID `bson:"_id"`
+	Key string             `bson:"key"`
+}
+
+type exportJobFileDoc struct {
+	ID      primitive.ObjectID `bson:"_id"`
+	FileKey string             `bson:"fileKey"`
+}
+
+func (ds *MongoFileReferenceDatasource) organizationRefs(ctx context.Context, filter bson.M, fn func(entity.FileReference) error) error {
+	projection := bson.M{"logo": 1, "logoVariants": 1}
+	return scanFiles(ctx, ds.db.Collection("organizations"), filter, projection, func(doc *organizationFileDoc) error {
+		return emitter("organizations", doc.ID, fn)(imageRefs{URL: doc.Logo, Variants: doc.LogoVariants})
+	})
+}
+
+func (ds *MongoFileReferenceDatasource) userRefs(ctx context.Context, filter bson.M, fn func(entity.FileReference) error) error {
+	projection := bson.M{"profilePhotoUrl": 1, "profilePhotoVariants": 1}
+	return scanFiles(ctx, ds.db.Collection("users"), filter, projection, func(doc *userFileDoc) error {
+		return emitter("users", doc.ID, fn)(imageRefs{URL: doc.ProfilePhotoURL, Variants: doc.ProfilePhotoVariants})
+	})
+}
+
+// locationRefs reports media items, and the flat URL lists of documents written before media items existed
+func (ds *MongoFileReferenceDatasource) locationRefs(ctx context.Context, filter bson.M, fn func(entity.FileReference) error) error {
+	projection := bson.M{"media.url": 1, "media.variants": 1, "mediaUrls": 1}
+	return scanFiles(ctx, ds.db.Collection("locations"), filter, projection, func(doc *locationFileDoc) error {
+		emit := emitter("locations", doc.ID, fn)
+		images := append(doc.Media, doc.MediaURLs.PhotoVariants...)
+		for _, url := range append(doc.MediaURLs.Photos, doc.MediaURLs.Videos...) {
+			images = append(images, imageRefs{URL: url})
+		}
+		for _, image := range images {
+			if err := emit(image); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// uploadSessionRefs reports the objects of pending sessions, which clients may still be uploading
+func (ds *MongoFileReferenceDatasource) uploadSessionRefs(ctx context.Context, fn func(entity.FileReference) error) error {
+	filter := bson.M{"status": entity.UploadStatusPending}
+	return scanFiles(ctx, ds.db.Collection("upload_sessions"), filter, bson.M{"key": 1}, func(doc *uploadSessionFileDoc) error {
+		return emitter("upload_sessions", doc.ID, fn)(imageRefs{URL: doc.Key})
+	})
+}
+
+// exportJobRefs reports the files of export jobs, which are kept until the job expires
+func (ds *MongoFileReferenceDatasource) exportJobRefs(ctx context.Context, fn func(entity.FileReference) error) error {
+	filter := bson.M{"fileKey": bson.M{"$gt": ""}}
+	return scanFiles(ctx, ds.db.Collection("export_jobs"), filter, bson.M{"fileKey": 1}, func(doc *exportJobFileDoc) error {
+		return emitter("export_jobs", doc.ID, fn)(imageRefs{URL: doc.FileKey})
+	})
+}
+
+// scanFiles decodes each matching document into a fresh T and calls visit with it
+func scanFiles[T any](ctx context.Context, coll *mongo.Collection, filter, projection bson.M, visit func(*T) error) error {
+	cursor, err := coll.Find(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var doc T
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if err := visit(&doc); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// emitter returns a function reporting the URL and variants of an image on a document
+func emitter(collection string, id primitive.ObjectID, fn func(entity.FileReference) error) func(imageRefs) error {
+	return func(image imageRefs) error {
+		refs := []string{image.URL}
+		for _, ref := range image.Variants {
+			refs = append(refs, ref)
+		}
+		for _, ref := range refs {
+			if ref == "" {
+				continue
+			}
+			if err := fn(entity.FileReference{Ref: ref, Collection: collection, DocumentID: id.Hex()}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
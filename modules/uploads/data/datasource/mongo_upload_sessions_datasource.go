@@ -0,0 +1,86 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoUploadSessionDatasource handles raw MongoDB operations for upload sessions.
+type MongoUploadSessionDatasource struct {
+	collection *mongo.Collection
+}
+
+// NewMongoUploadSessionDatasource creates a new datasource. Indexes are created by migrations.
+func NewMongoUploadSessionDatasource(db *mongo.Database) *MongoUploadSessionDatasource {
+	coll := db.Collection((&model.UploadSessionModel{}).CollectionName())
+	return &MongoUploadSessionDatasource{collection: coll}
+}
+
+// Insert inserts a new session.
+func (ds *MongoUploadSessionDatasource) Insert(ctx context.Context, m *model.UploadSessionModel) error {
+	res, err := ds.collection.InsertOne(ctx, m)
+	if err != nil {
+		return err
+	}
+	m.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// FindByID finds a session by its ObjectID.
+// Returns (nil, nil) if not found.
+func (ds *MongoUploadSessionDatasource) FindByID(ctx context.Context, id primitive.ObjectID) (*model.UploadSessionModel, error) {
+	var m model.UploadSessionModel
+	err := ds.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&m)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Transition sets fields on a session only while it is in the given status.
+// Returns false when no session in that status matched.
+func (ds *MongoUploadSessionDatasource) Transition(ctx context.Context, id primitive.ObjectID, fromStatus string, set bson.M, unset ...string) (bool, error) {
+	set["updatedAt"] = time.Now()
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		fields := bson.M{}
+		for _, field := range unset {
+			fields[field] = ""
+		}
+		update["$unset"] = fields
+	}
+	res, err := ds.collection.UpdateOne(ctx, bson.M{"_id": id, "status": fromStatus}, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// FindExpiredPending finds pending sessions that expired before the given time, oldest first.
+func (ds *MongoUploadSessionDatasource) FindExpiredPending(ctx context.Context, before time.Time, limit int64) ([]model.UploadSessionModel, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "expiresAt", Value: 1}}).SetLimit(limit)
+	cursor, err := ds.collection.Find(ctx, bson.M{
+		"status":    entity.UploadStatusPending,
+		"expiresAt": bson.M{"$lt": before},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []model.UploadSessionModel
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
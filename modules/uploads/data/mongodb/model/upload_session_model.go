@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UploadSessionModel is the Mongo schema for direct upload sessions
+type UploadSessionModel struct {
+	ID          primitive.ObjectID  `bson:"_id,omitempty"`
+	Target      string              `bson:"target"`
+	TargetID    primitive.ObjectID  `bson:"targetId"`
+	Key         string              `bson:"key"`
+	FileName    string              `bson:"fileName"`
+	ContentType string              `bson:"contentType"`
+	Size        int64               `bson:"size"`
+	Status      string              `bson:"status"`
+	Multipart   bool                `bson:"multipart"`
+	UploadID    string              `bson:"uploadId,omitempty"`
+	PartSize    int64               `bson:"partSize,omitempty"`
+	CreatedBy   *primitive.ObjectID `bson:"createdBy,omitempty"`
+	ExpiresAt   time.Time           `bson:"expiresAt"`
+	ConfirmedAt *time.Time          `bson:"confirmedAt,omitempty"`
+	CreatedAt   time.Time           `bson:"createdAt"`
+	UpdatedAt   time.Time           `bson:"updatedAt"`
+}
+
+func (m *UploadSessionModel) CollectionName() string {
+	return "upload_sessions"
+}
+
+// FromEntity maps domain→model
+func FromEntity(e *entity.UploadSession) *UploadSessionModel {
+	return &UploadSessionModel{
+		ID:          e.ID,
+		Target:      e.Target,
+		TargetID:    e.TargetID,
+		Key:         e.Key,
+		FileName:    e.FileName,
+		ContentType: e.ContentType,
+		Size:        e.Size,
+		Status:      e.Status,
+		Multipart:   e.Multipart,
+		UploadID:    e.UploadID,
+		PartSize:    e.PartSize,
+		CreatedBy:   e.CreatedBy,
+		ExpiresAt:   e.ExpiresAt,
+		ConfirmedAt: e.ConfirmedAt,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+// ToEntity maps model→domain
+func (m *UploadSessionModel) ToEntity() entity.UploadSession {
+	return entity.UploadSession{
+		ID:          m.ID,
+		Target:      m.Target,
+		TargetID:    m.TargetID,
+		Key:         m.Key,
+		FileName:    m.FileName,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Status:      m.Status,
+		Multipart:   m.Multipart,
+		UploadID:    m.UploadID,
+		PartSize:    m.PartSize,
+		CreatedBy:   m.CreatedBy,
+		ExpiresAt:   m.ExpiresAt,
+		ConfirmedAt: m.ConfirmedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+)
+
+// Ensure interface compliance
+var _ repository.FileReferenceRepository = (*FileReferenceRepositoryMongo)(nil)
+
+// FileReferenceRepositoryMongo implements FileReferenceRepository using MongoDB.
+type FileReferenceRepositoryMongo struct {
+	datasource *datasource.MongoFileReferenceDatasource
+}
+
+// NewFileReferenceRepositoryMongo creates a new FileReferenceRepositoryMongo.
+func NewFileReferenceRepositoryMongo(ds *datasource.MongoFileReferenceDatasource) *FileReferenceRepositoryMongo {
+	return &FileReferenceRepositoryMongo{datasource: ds}
+}
+
+// ForEachReference calls fn for every referenced file.
+func (r *FileReferenceRepositoryMongo) ForEachReference(ctx context.Context, fn func(entity.FileReference) error) error {
+	return r.datasource.ForEachReference(ctx, fn)
+}
+
+// ForEachDocumentReference calls fn for every file referenced by one document.
+func (r *FileReferenceRepositoryMongo) ForEachDocumentReference(ctx context.Context, collection string, id primitive.ObjectID, fn func(entity.FileReference) error) error {
+	return r.datasource.ForEachDocumentReference(ctx, collection, id, fn)
+}
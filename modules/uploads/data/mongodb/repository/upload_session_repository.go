@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/data/datasource"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/data/mongodb/model"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Ensure interface compliance
+var _ repository.UploadSessionRepository = (*UploadSessionRepositoryMongo)(nil)
+
+// UploadSessionRepositoryMongo implements UploadSessionRepository using MongoDB.
+type UploadSessionRepositoryMongo struct {
+	datasource *datasource.MongoUploadSessionDatasource
+}
+
+// NewUploadSessionRepositoryMongo creates a new UploadSessionRepositoryMongo.
+func NewUploadSessionRepositoryMongo(ds *datasource.MongoUploadSessionDatasource) *UploadSessionRepositoryMongo {
+	return &UploadSessionRepositoryMongo{datasource: ds}
+}
+
+// Create inserts a new session and back-fills its ID.
+func (r *UploadSessionRepositoryMongo) Create(ctx context.Context, session *entity.UploadSession) error {
+	m := model.FromEntity(session)
+	if err := r.datasource.Insert(ctx, m); err != nil {
+		return err
+	}
+	session.ID = m.ID
+	return nil
+}
+
+// FindByID returns the session, or nil if it does not exist.
+func (r *UploadSessionRepositoryMongo) FindByID(ctx context.Context, id primitive.ObjectID) (*entity.UploadSession, error) {
+	m, err := r.datasource.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, nil
+	}
+	e := m.ToEntity()
+	return &e, nil
+}
+
+// MarkConfirmed moves a pending session to confirmed.
+func (r *UploadSessionRepositoryMongo) MarkConfirmed(ctx context.Context, id primitive.ObjectID, confirmedAt time.Time) (bool, error) {
+	return r.datasource.Transition(ctx, id, entity.UploadStatusPending, bson.M{
+		"status":      entity.UploadStatusConfirmed,
+		"confirmedAt": confirmedAt,
+	})
+}
+
+// Reopen moves a confirmed session back to pending.
+func (r *UploadSessionRepositoryMongo) Reopen(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.datasource.Transition(ctx, id, entity.UploadStatusConfirmed,
+		bson.M{"status": entity.UploadStatusPending}, "confirmedAt")
+	return err
+}
+
+// MarkExpired moves a pending session to expired.
+func (r *UploadSessionRepositoryMongo) MarkExpired(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	return r.datasource.Transition(ctx, id, entity.UploadStatusPending, bson.M{"status": entity.UploadStatusExpired})
+}
+
+// FindExpiredPending returns pending sessions whose upload window has closed.
+func (r *UploadSessionRepositoryMongo) FindExpiredPending(ctx context.Context, before time.Time, limit int64) ([]*entity.UploadSession, error) {
+	models, err := r.datasource.FindExpiredPending(ctx, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*entity.UploadSession, 0, len(models))
+	for i := range models {
+		e := models[i].ToEntity()
+		sessions = append(sessions, &e)
+	}
+	return sessions, nil
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// StorageKeyPrefix is the prefix every uploaded object is stored under
+const StorageKeyPrefix = "uploads/"
+
+// ErrStorageListingUnsupported is returned when the configured storage cannot enumerate its objects
+var ErrStorageListingUnsupported = errors.New("the configured storage cannot list its objects")
+
+// FileReference is a stored file referenced by a document
+type FileReference struct {
+	Ref        string // Storage key, or a URL on records not yet migrated to keys
+	Collection string
+	DocumentID string
+}
+
+// OrphanedObject is a stored object that no document references
+type OrphanedObject struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+	Deleted      bool      `json:"deleted"`
+}
+
+// MissingFile is a reference to a key that is not in storage
+type MissingFile struct {
+	Key        string `json:"key"`
+	Collection string `json:"collection"`
+	DocumentID string `json:"documentId"`
+}
+
+// StorageReport is the result of reconciling storage against the database
+type StorageReport struct {
+	DryRun         bool             `json:"dryRun"`
+	GracePeriod    time.Duration    `json:"gracePeriod"`
+	ScannedObjects int              `json:"scannedObjects"`
+	ReferencedKeys int              `json:"referencedKeys"`
+	Orphans        []OrphanedObject `json:"orphans"`
+	Missing        []MissingFile    `json:"missing"`
+	DeletedCount   int              `json:"deletedCount"`
+	OrphanedBytes  int64            `json:"orphanedBytes"`
+	StartedAt      time.Time        `json:"startedAt"`
+	FinishedAt     time.Time        `json:"finishedAt"`
+}
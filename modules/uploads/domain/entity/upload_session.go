@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Upload session statuses
+const (
+	UploadStatusPending   = "pending"
+	UploadStatusConfirmed = "confirmed"
+	UploadStatusExpired   = "expired"
+)
+
+// Upload targets, the entity a confirmed upload is attached to
+const (
+	TargetLocationMedia    = "location_media"
+	TargetOrganizationLogo = "organization_logo"
+	TargetUserProfilePhoto = "user_profile_photo"
+)
+
+var (
+	ErrUploadNotFound          = errors.New("upload session not found")
+	ErrUploadExpired           = errors.New("upload session has expired")
+	ErrUploadNotPending        = errors.New("upload session is already confirmed or expired")
+	ErrUploadTargetMismatch    = errors.New("upload session belongs to a different resource")
+	ErrUploadObjectMissing     = errors.New("uploaded file was not found in storage")
+	ErrUploadSizeMismatch      = errors.New("uploaded file size does not match the declared size")
+	ErrUploadTypeMismatch      = errors.New("uploaded file content type does not match the declared type")
+	ErrUploadTooLarge          = errors.New("file is larger than allowed")
+	ErrUploadEmpty             = errors.New("file size must be greater than zero")
+	ErrUploadTypeNotAllowed    = errors.New("file type is not allowed")
+	ErrUploadPartsRequired     = errors.New("parts are required to confirm a multipart upload")
+	ErrDirectUploadUnsupported = errors.New("the configured storage does not support direct uploads")
+)
+
+// UploadPolicy limits what may be uploaded to a target
+type UploadPolicy struct {
+	MaxSize      int64
+	AllowedTypes map[string]int64 // content type → max size, 0 means MaxSize
+}
+
+// UploadPolicies mirror the limits of the proxied media handlers, with room for large videos
+var UploadPolicies = map[string]UploadPolicy{
+	TargetLocationMedia: {
+		MaxSize: 10 << 20,
+		AllowedTypes: map[string]int64{
+			"image/jpeg": 0, "image/png": 0, "image/webp": 0, "image/gif": 0, "image/svg+xml": 0,
+			"video/mp4": 2 << 30, "video/quicktime": 2 << 30,
+		},
+	},
+	TargetOrganizationLogo: {
+		MaxSize: 5 << 20,
+		AllowedTypes: map[string]int64{
+			"image/jpeg": 0, "image/png": 0, "image/webp": 0, "image/gif": 0, "image/svg+xml": 0,
+		},
+	},
+	TargetUserProfilePhoto: {
+		MaxSize: 5 << 20,
+		AllowedTypes: map[string]int64{
+			"image/jpeg": 0, "image/png": 0, "image/webp": 0, "image/gif": 0,
+		},
+	},
+}
+
+// Check validates a declared content type and size against the policy
+func (p UploadPolicy) Check(contentType string, size int64) error {
+	if size <= 0 {
+		return ErrUploadEmpty
+	}
+	limit, ok := p.AllowedTypes[contentType]
+	if !ok {
+		return ErrUploadTypeNotAllowed
+	}
+	if limit == 0 {
+		limit = p.MaxSize
+	}
+	if size > limit {
+		return ErrUploadTooLarge
+	}
+	return nil
+}
+
+// UploadSession tracks a file the client uploads straight to storage
+type UploadSession struct {
+	ID          primitive.ObjectID  `json:"id" bson:"_id,omitempty"`
+	Target      string              `json:"target" bson:"target"`
+	TargetID    primitive.ObjectID  `json:"targetId" bson:"targetId"`
+	Key         string              `json:"key" bson:"key"`
+	FileName    string              `json:"fileName" bson:"fileName"`
+	ContentType string              `json:"contentType" bson:"contentType"`
+	Size        int64               `json:"size" bson:"size"`
+	Status      string              `json:"status" bson:"status"`
+	Multipart   bool                `json:"multipart" bson:"multipart"`
+	UploadID    string              `json:"-" bson:"uploadId,omitempty"`
+	PartSize    int64               `json:"partSize,omitempty" bson:"partSize,omitempty"`
+	FileURL     string              `json:"fileUrl,omitempty" bson:"-"` // Resolved from Key when confirmed, never stored
+	CreatedBy   *primitive.ObjectID `json:"createdBy,omitempty" bson:"createdBy,omitempty"`
+	ExpiresAt   time.Time           `json:"expiresAt" bson:"expiresAt"`
+	ConfirmedAt *time.Time          `json:"confirmedAt,omitempty" bson:"confirmedAt,omitempty"`
+	CreatedAt   time.Time           `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   time.Time           `json:"updatedAt" bson:"updatedAt"`
+}
+
+// IsExpired reports whether the upload window has closed
+func (s *UploadSession) IsExpired(now time.Time) bool {
+	return now.After(s.ExpiresAt)
+}
+
+// IsImage reports whether the session uploads an image
+func (s *UploadSession) IsImage() bool {
+	return strings.HasPrefix(s.ContentType, "image/")
+}
+
+// IsVideo reports whether the session uploads a video
+func (s *UploadSession) IsVideo() bool {
+	return strings.HasPrefix(s.ContentType, "video/")
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+)
+
+// FileReferenceRepository enumerates the stored files documents point at
+type FileReferenceRepository interface {
+	// ForEachReference calls fn for every file referenced by organizations, users,
+	// locations and pending upload sessions. An error from fn stops the scan.
+	ForEachReference(ctx context.Context, fn func(entity.FileReference) error) error
+	// ForEachDocumentReference calls fn for every file referenced by the document with id
+	// in collection, deleted or not
+	ForEachDocumentReference(ctx context.Context, collection string, id primitive.ObjectID, fn func(entity.FileReference) error) error
+}
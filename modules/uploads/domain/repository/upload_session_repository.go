@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UploadSessionRepository persists direct upload sessions
+type UploadSessionRepository interface {
+	// Create inserts a new session and sets its ID on the entity.
+	Create(ctx context.Context, session *entity.UploadSession) error
+
+	// FindByID returns the session, or nil if it does not exist.
+	FindByID(ctx context.Context, id primitive.ObjectID) (*entity.UploadSession, error)
+
+	// MarkConfirmed moves a pending session to confirmed. It returns false when the
+	// session was no longer pending, so two concurrent confirms cannot both attach the file.
+	MarkConfirmed(ctx context.Context, id primitive.ObjectID, confirmedAt time.Time) (bool, error)
+
+	// Reopen moves a confirmed session back to pending, used when attaching the file fails.
+	Reopen(ctx context.Context, id primitive.ObjectID) error
+
+	// MarkExpired moves a pending session to expired. It returns false when the session was no longer pending.
+	MarkExpired(ctx context.Context, id primitive.ObjectID) (bool, error)
+
+	// FindExpiredPending returns up to limit pending sessions whose upload window closed before the given time.
+	FindExpiredPending(ctx context.Context, before time.Time, limit int64) ([]*entity.UploadSession, error)
+}
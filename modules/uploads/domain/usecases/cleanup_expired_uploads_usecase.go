@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+)
+
+const (
+	// cleanupGracePeriod leaves time for a confirm that started just before expiry to finish
+	cleanupGracePeriod = 5 * time.Minute
+	cleanupBatchSize   = 100
+)
+
+// CleanupExpiredUploadsUseCase deletes the objects of upload sessions that were never confirmed
+type CleanupExpiredUploadsUseCase struct {
+	Repo    repo.UploadSessionRepository
+	Storage services.DirectUploadService
+}
+
+func NewCleanupExpiredUploadsUseCase(r repo.UploadSessionRepository, storage services.DirectUploadService) *CleanupExpiredUploadsUseCase {
+	return &CleanupExpiredUploadsUseCase{Repo: r, Storage: storage}
+}
+
+// Execute expires stale pending sessions and removes whatever the client uploaded for them.
+// Returns the number of sessions cleaned up.
+func (uc *CleanupExpiredUploadsUseCase) Execute(ctx context.Context) (int, error) {
+	if uc.Storage == nil {
+		return 0, nil
+	}
+	multipart, supportsMultipart := uc.Storage.(services.MultipartUploadService)
+
+	cleaned := 0
+	before := time.Now().Add(-cleanupGracePeriod)
+	for {
+		sessions, err := uc.Repo.FindExpiredPending(ctx, before, cleanupBatchSize)
+		if err != nil {
+			return cleaned, err
+		}
+		if len(sessions) == 0 {
+			return cleaned, nil
+		}
+
+		for _, session := range sessions {
+			// Claim first; a session confirmed in the meantime keeps its file
+			claimed, err := uc.Repo.MarkExpired(ctx, session.ID)
+			if err != nil {
+				return cleaned, err
+			}
+			if !claimed {
+				continue
+			}
+
+			if session.Multipart && supportsMultipart {
+				if err := multipart.AbortMultipartUpload(ctx, session.Key, session.UploadID); err != nil {
+					log.Printf("Failed to abort multipart upload %s: %v", session.Key, err)
+				}
+			}
+			if err := uc.Storage.DeleteObject(ctx, session.Key); err != nil {
+				log.Printf("Failed to delete unconfirmed upload %s: %v", session.Key, err)
+			}
+			cleaned++
+		}
+
+		if len(sessions) < cleanupBatchSize {
+			return cleaned, nil
+		}
+	}
+}
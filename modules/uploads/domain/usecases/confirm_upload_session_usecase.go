@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AttachUploadFunc attaches a confirmed file to its target entity
+type AttachUploadFunc func(ctx context.Context, session *en.UploadSession) error
+
+// ConfirmUploadSessionUseCase verifies that a direct upload landed in storage as declared,
+// passes the upload scanners and attaches it to the target entity
+type ConfirmUploadSessionUseCase struct {
+	Repo    repo.UploadSessionRepository
+	Storage services.DirectUploadService
+	Scanner *services.UploadScanPipeline
+}
+
+func NewConfirmUploadSessionUseCase(r repo.UploadSessionRepository, storage services.DirectUploadService, scanner *services.UploadScanPipeline) *ConfirmUploadSessionUseCase {
+	return &ConfirmUploadSessionUseCase{Repo: r, Storage: storage, Scanner: scanner}
+}
+
+// Execute confirms the session if it belongs to target/targetID, then calls attach.
+// Parts are required for multipart sessions and ignored otherwise.
+func (uc *ConfirmUploadSessionUseCase) Execute(
+	ctx context.Context,
+	sessionID primitive.ObjectID,
+	target string,
+	targetID primitive.ObjectID,
+	parts []services.CompletedPart,
+	attach AttachUploadFunc,
+) (*en.UploadSession, error) {
+	if uc.Storage == nil {
+		return nil, en.ErrDirectUploadUnsupported
+	}
+
+	session, err := uc.Repo.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, en.ErrUploadNotFound
+	}
+	if session.Target != target || session.TargetID != targetID {
+		return nil, en.ErrUploadTargetMismatch
+	}
+	if session.Status != en.UploadStatusPending {
+		return nil, en.ErrUploadNotPending
+	}
+	if session.IsExpired(time.Now()) {
+		return nil, en.ErrUploadExpired
+	}
+
+	if session.Multipart {
+		if len(parts) == 0 {
+			return nil, en.ErrUploadPartsRequired
+		}
+		multipart, ok := uc.Storage.(services.MultipartUploadService)
+		if !ok {
+			return nil, en.ErrDirectUploadUnsupported
+		}
+		if err := multipart.CompleteMultipartUpload(ctx, session.Key, session.UploadID, parts); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.verifyObject(ctx, session); err != nil {
+		return nil, err
+	}
+	if err := uc.scanObject(ctx, session); err != nil {
+		return nil, err
+	}
+
+	// Claim the session before attaching, so concurrent confirms attach the file once
+	confirmedAt := time.Now()
+	claimed, err := uc.Repo.MarkConfirmed(ctx, session.ID, confirmedAt)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, en.ErrUploadNotPending
+	}
+
+	session.Status = en.UploadStatusConfirmed
+	session.FileURL = uc.Storage.GetFileURL(session.Key)
+	session.ConfirmedAt = &confirmedAt
+
+	if attach != nil {
+		if err := attach(ctx, session); err != nil {
+			if reopenErr := uc.Repo.Reopen(ctx, session.ID); reopenErr != nil {
+				log.Printf("Failed to reopen upload session %s: %v", session.ID.Hex(), reopenErr)
+			}
+			return nil, err
+		}
+	}
+
+	return session, nil
+}
+
+// verifyObject checks the stored object against the declared size and type. A mismatching
+// object is deleted and the session expired, since the client cannot fix it in place.
+func (uc *ConfirmUploadSessionUseCase) verifyObject(ctx context.Context, session *en.UploadSession) error {
+	info, err := uc.Storage.StatObject(ctx, session.Key)
+	if errors.Is(err, services.ErrObjectNotFound) {
+		return en.ErrUploadObjectMissing
+	}
+	if err != nil {
+		return err
+	}
+
+	var mismatch error
+	if info.Size != session.Size {
+		mismatch = en.ErrUploadSizeMismatch
+	} else if info.ContentType != "" && baseMediaType(info.ContentType) != baseMediaType(session.ContentType) {
+		mismatch = en.ErrUploadTypeMismatch
+	}
+	if mismatch == nil {
+		return nil
+	}
+
+	uc.discard(ctx, session)
+	return mismatch
+}
+
+// scanObject copies the object to a temporary file and runs the upload scanners on it.
+// Its content must be of the declared type. Rejected objects have been quarantined by
+// the scanner and are removed from storage.
+func (uc *ConfirmUploadSessionUseCase) scanObject(ctx context.Context, session *en.UploadSession) error {
+	if uc.Scanner == nil {
+		return nil
+	}
+
+	object, err := uc.Storage.OpenObject(ctx, session.Key)
+	if errors.Is(err, services.ErrObjectNotFound) {
+		return en.ErrUploadObjectMissing
+	}
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	tmp, err := os.CreateTemp("", "upload-scan-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, object)
+	if err != nil {
+		log.Printf("Failed to download upload %s for scanning: %v", session.Key, err)
+		return err
+	}
+
+	err = uc.Scanner.Scan(ctx, &services.ScanInput{
+		FileName:     session.FileName,
+		DeclaredType: session.ContentType,
+		AllowedTypes: []string{session.ContentType},
+		Size:         size,
+		Content:      tmp,
+	})
+	if errors.Is(err, services.ErrUploadRejected) {
+		uc.discard(ctx, session)
+	}
+	return err
+}
+
+// discard deletes a rejected object and expires its session, since the client cannot fix it in place
+func (uc *ConfirmUploadSessionUseCase) discard(ctx context.Context, session *en.UploadSession) {
+	if err := uc.Storage.DeleteObject(ctx, session.Key); err != nil {
+		log.Printf("Failed to delete rejected upload %s: %v", session.Key, err)
+	}
+	if _, err := uc.Repo.MarkExpired(ctx, session.ID); err != nil {
+		log.Printf("Failed to expire upload session %s: %v", session.ID.Hex(), err)
+	}
+}
+
+// baseMediaType strips parameters such as charset from a content type
+func baseMediaType(contentType string) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		return mediaType
+	}
+	return contentType
+}
@@ -0,0 +1,128 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// DefaultUploadExpiry is how long a presigned upload URL stays valid
+	DefaultUploadExpiry = 15 * time.Minute
+	// multipartThreshold is the size above which uploads are split into parts
+	multipartThreshold = 100 << 20
+	// minPartSize is the smallest part S3 accepts (except the last one)
+	minPartSize = 16 << 20
+	// maxParts is the most parts S3 accepts for one upload
+	maxParts = 10000
+)
+
+// CreateUploadSessionInput describes the file the client wants to upload
+type CreateUploadSessionInput struct {
+	Target      string
+	TargetID    primitive.ObjectID
+	FileName    string
+	ContentType string
+	Size        int64
+	CreatedBy   *primitive.ObjectID
+	// OrganizationID is the uploader's organization, whose settings may narrow the policy
+	OrganizationID *primitive.ObjectID
+}
+
+// UploadTypeChecker reports whether an organization allows files of a content type
+type UploadTypeChecker interface {
+	IsUploadTypeAllowed(ctx context.Context, organizationID primitive.ObjectID, contentType string) (bool, error)
+}
+
+// CreateUploadSessionUseCase validates an upload request against the target's policy and
+// the uploader's organization settings, presigns a direct upload and records a pending session
+type CreateUploadSessionUseCase struct {
+	Repo        repo.UploadSessionRepository
+	Storage     services.DirectUploadService
+	UploadTypes UploadTypeChecker
+	Expiry      time.Duration
+}
+
+func NewCreateUploadSessionUseCase(r repo.UploadSessionRepository, storage services.DirectUploadService, uploadTypes UploadTypeChecker) *CreateUploadSessionUseCase {
+	return &CreateUploadSessionUseCase{Repo: r, Storage: storage, UploadTypes: uploadTypes, Expiry: DefaultUploadExpiry}
+}
+
+func (uc *CreateUploadSessionUseCase) Execute(ctx context.Context, input CreateUploadSessionInput) (*en.UploadSession, *services.PresignedUpload, error) {
+	if uc.Storage == nil {
+		return nil, nil, en.ErrDirectUploadUnsupported
+	}
+
+	policy, ok := en.UploadPolicies[input.Target]
+	if !ok {
+		return nil, nil, en.ErrUploadTypeNotAllowed
+	}
+	if err := policy.Check(input.ContentType, input.Size); err != nil {
+		return nil, nil, err
+	}
+	if input.OrganizationID != nil && uc.UploadTypes != nil {
+		allowed, err := uc.UploadTypes.IsUploadTypeAllowed(ctx, *input.OrganizationID, input.ContentType)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !allowed {
+			return nil, nil, en.ErrUploadTypeNotAllowed
+		}
+	}
+
+	now := time.Now()
+	session := &en.UploadSession{
+		Target:      input.Target,
+		TargetID:    input.TargetID,
+		Key:         services.NewUploadKey(input.FileName),
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Status:      en.UploadStatusPending,
+		CreatedBy:   input.CreatedBy,
+		ExpiresAt:   now.Add(uc.Expiry),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	var (
+		upload *services.PresignedUpload
+		err    error
+	)
+	multipart, supportsMultipart := uc.Storage.(services.MultipartUploadService)
+	if input.Size > multipartThreshold && supportsMultipart {
+		session.Multipart = true
+		session.PartSize = partSizeFor(input.Size)
+		partCount := int((input.Size + session.PartSize - 1) / session.PartSize)
+		upload, err = multipart.CreateMultipartUpload(ctx, session.Key, input.ContentType, partCount, uc.Expiry)
+		if err == nil {
+			session.UploadID = upload.UploadID
+		}
+	} else {
+		upload, err = uc.Storage.PresignUpload(ctx, session.Key, input.ContentType, input.Size, uc.Expiry)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := uc.Repo.Create(ctx, session); err != nil {
+		if session.Multipart {
+			_ = multipart.AbortMultipartUpload(ctx, session.Key, session.UploadID)
+		}
+		return nil, nil, err
+	}
+
+	return session, upload, nil
+}
+
+// partSizeFor picks the smallest part size that keeps the upload within maxParts
+func partSizeFor(size int64) int64 {
+	partSize := int64(minPartSize)
+	for (size+partSize-1)/partSize > maxParts {
+		partSize *= 2
+	}
+	return partSize
+}
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	en "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	repo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/repository"
+)
+
+// ReconcileStorageUseCase finds stored objects no document references, and references
+// whose object is gone, then garbage collects the orphans
+type ReconcileStorageUseCase struct {
+	Repo    repo.FileReferenceRepository
+	Storage services.FileService
+
+	// GracePeriod protects recent objects, e.g. an upload whose document is still being saved
+	GracePeriod time.Duration
+}
+
+func NewReconcileStorageUseCase(r repo.FileReferenceRepository, storage services.FileService, gracePeriod time.Duration) *ReconcileStorageUseCase {
+	return &ReconcileStorageUseCase{Repo: r, Storage: storage, GracePeriod: gracePeriod}
+}
+
+// Execute compares the objects under uploads/ with the keys referenced in the database.
+// Orphans last modified before the grace period are deleted unless dryRun is set.
+func (uc *ReconcileStorageUseCase) Execute(ctx context.Context, dryRun bool) (*en.StorageReport, error) {
+	lister, ok := uc.Storage.(services.ObjectLister)
+	if !ok {
+		return nil, en.ErrStorageListingUnsupported
+	}
+
+	report := &en.StorageReport{
+		DryRun:      dryRun,
+		GracePeriod: uc.GracePeriod,
+		Orphans:     []en.OrphanedObject{},
+		Missing:     []en.MissingFile{},
+		StartedAt:   time.Now(),
+	}
+
+	// List storage before reading references, so a file attached while the scan runs is
+	// seen as referenced rather than orphaned
+	objects := make(map[string]services.ObjectInfo)
+	err := lister.ListObjects(ctx, en.StorageKeyPrefix, func(object services.ObjectInfo) error {
+		objects[object.Key] = object
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.ScannedObjects = len(objects)
+
+	referenced := make(map[string]en.FileReference)
+	err = uc.Repo.ForEachReference(ctx, func(ref en.FileReference) error {
+		key := services.FileKey(uc.Storage, ref.Ref)
+		// External URLs and keys outside the upload prefix are not ours to manage
+		if !strings.HasPrefix(key, en.StorageKeyPrefix) {
+			return nil
+		}
+		if _, seen := referenced[key]; !seen {
+			referenced[key] = ref
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.ReferencedKeys = len(referenced)
+
+	for key, ref := range referenced {
+		if _, ok := objects[key]; ok || uc.objectExists(ctx, key) {
+			continue
+		}
+		report.Missing = append(report.Missing, en.MissingFile{Key: key, Collection: ref.Collection, DocumentID: ref.DocumentID})
+	}
+
+	cutoff := report.StartedAt.Add(-uc.GracePeriod)
+	for key, object := range objects {
+		if _, ok := referenced[key]; ok {
+			continue
+		}
+		orphan := en.OrphanedObject{Key: key, Size: object.Size, LastModified: object.LastModified}
+		report.OrphanedBytes += object.Size
+
+		if !dryRun && object.LastModified.Before(cutoff) {
+			if err := uc.Storage.DeleteFile(ctx, key); err != nil {
+				log.Printf("Failed to delete orphaned file %s: %v", key, err)
+			} else {
+				orphan.Deleted = true
+				report.DeletedCount++
+			}
+		}
+		report.Orphans = append(report.Orphans, orphan)
+	}
+
+	sort.Slice(report.Orphans, func(i, j int) bool { return report.Orphans[i].Key < report.Orphans[j].Key })
+	sort.Slice(report.Missing, func(i, j int) bool { return report.Missing[i].Key < report.Missing[j].Key })
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// objectExists re-checks a key missing from the listing, which may have been uploaded since
+func (uc *ReconcileStorageUseCase) objectExists(ctx context.Context, key string) bool {
+	store, ok := uc.Storage.(services.DirectUploadService)
+	if !ok {
+		return false
+	}
+	_, err := store.StatObject(ctx, key)
+	if err != nil && !errors.Is(err, services.ErrObjectNotFound) {
+		log.Printf("Failed to check file %s: %v", key, err)
+		return true
+	}
+	return err == nil
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"errors"
+	"strings"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+)
+
+// CreateUploadSessionDto describes the file the client is about to upload
+type CreateUploadSessionDto struct {
+	FileName    string `json:"fileName" binding:"required" example:"sunset.mp4"`
+	ContentType string `json:"contentType" binding:"required" example:"video/mp4"`
+	Size        int64  `json:"size" binding:"required" example:"52428800"`
+}
+
+// Validate validates the upload session request
+func (dto *CreateUploadSessionDto) Validate() error {
+	dto.FileName = strings.TrimSpace(dto.FileName)
+	dto.ContentType = strings.ToLower(strings.TrimSpace(dto.ContentType))
+	if dto.FileName == "" {
+		return errors.New("file name is required")
+	}
+	if dto.ContentType == "" {
+		return errors.New("content type is required")
+	}
+	if dto.Size <= 0 {
+		return entity.ErrUploadEmpty
+	}
+	return nil
+}
+
+// ConfirmUploadSessionDto lists the uploaded parts of a multipart upload
+type ConfirmUploadSessionDto struct {
+	Parts []services.CompletedPart `json:"parts,omitempty"`
+}
+
+// Validate validates the confirm request
+func (dto *ConfirmUploadSessionDto) Validate() error {
+	seen := make(map[int]bool, len(dto.Parts))
+	for _, part := range dto.Parts {
+		if part.PartNumber < 1 || part.ETag == "" {
+			return errors.New("each part needs a partNumber and etag")
+		}
+		if seen[part.PartNumber] {
+			return errors.New("duplicate part number")
+		}
+		seen[part.PartNumber] = true
+	}
+	return nil
+}
+
+// UploadSessionResponse is returned when a session is opened
+type UploadSessionResponse struct {
+	Session *entity.UploadSession     `json:"session"`
+	Upload  *services.PresignedUpload `json:"upload"`
+}
+
+// ConfirmUploadSessionResponse is returned when a session is confirmed
+type ConfirmUploadSessionResponse struct {
+	Session *entity.UploadSession `json:"session"`
+	Target  interface{}           `json:"target"`
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/usecases"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/presentation/http/dto"
+)
+
+// TargetLookup reports whether the entity an upload is for exists
+type TargetLookup func(ctx context.Context, id primitive.ObjectID) (bool, error)
+
+// TargetAttacher attaches a confirmed upload and returns the updated entity
+type TargetAttacher func(ctx context.Context, session *entity.UploadSession) (interface{}, error)
+
+// UploadHandler serves the direct upload endpoints mounted under each resource
+type UploadHandler struct {
+	CreateUploadSessionUseCase  *usecases.CreateUploadSessionUseCase
+	ConfirmUploadSessionUseCase *usecases.ConfirmUploadSessionUseCase
+}
+
+// NewUploadHandler creates a new UploadHandler
+func NewUploadHandler(
+	createUC *usecases.CreateUploadSessionUseCase,
+	confirmUC *usecases.ConfirmUploadSessionUseCase,
+) *UploadHandler {
+	return &UploadHandler{
+		CreateUploadSessionUseCase:  createUC,
+		ConfirmUploadSessionUseCase: confirmUC,
+	}
+}
+
+// CreateUploadSession godoc
+//
+//	@Summary		Start a direct upload
+//	@Description	Validate the file against the target's size and type limits and the types the uploader's organization allows, and return a presigned PUT URL, or one URL per part for large files. Upload the bytes there with the returned headers, then call the confirm endpoint.
+//	@Tags			uploads
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"ID of the entity the file is for"
+//	@Param			request	body		dto.CreateUploadSessionDto		true	"File to upload"
+//	@Success		201		{object}	models.SwaggerStandardResponse{data=dto.UploadSessionResponse}
+//	@Failure		400		{object}	models.SwaggerErrorResponse
+//	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		413		{object}	models.SwaggerErrorResponse
+//	@Failure		500		{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/media/uploads [post]
+//	@Router			/organizations/{id}/logo/uploads [post]
+//	@Router			/users/{id}/profile-photo/uploads [post]
+func (h *UploadHandler) CreateUploadSession(target string, lookup TargetLookup) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID, ok := parseObjectID(c, "id", "Invalid ID")
+		if !ok {
+			return
+		}
+
+		var req dto.CreateUploadSessionDto
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid request body", err, http.StatusBadRequest))
+			return
+		}
+		if err := req.Validate(); err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+			return
+		}
+
+		exists, err := lookup(c.Request.Context(), targetID)
+		if err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch upload target", err, http.StatusInternalServerError))
+			return
+		}
+		if !exists {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "Upload target not found", nil, http.StatusNotFound))
+			return
+		}
+
+		input := usecases.CreateUploadSessionInput{
+			Target:      target,
+			TargetID:    targetID,
+			FileName:    req.FileName,
+			ContentType: req.ContentType,
+			Size:        req.Size,
+		}
+		if authCtx := middleware.GetAuthContext(c.Request.Context()); authCtx != nil {
+			userID := authCtx.UserID
+			input.CreatedBy = &userID
+			input.OrganizationID = authCtx.OrganizationID
+		}
+
+		session, upload, err := h.CreateUploadSessionUseCase.Execute(c.Request.Context(), input)
+		if err != nil {
+			middleware.HandleError(c, uploadError(err, "Failed to start upload"))
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.UploadSessionResponse{Session: session, Upload: upload})
+	}
+}
+
+// ConfirmUploadSession godoc
+//
+//	@Summary		Confirm a direct upload
+//	@Description	Check that the uploaded file exists with the declared size and type and passes the upload scanners, then attach it. Rejected files are quarantined and their session expires. Multipart uploads must list every part with the ETag storage returned for it.
+//	@Tags			uploads
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"ID of the entity the file is for"
+//	@Param			uploadId	path		string							true	"Upload session ID"
+//	@Param			request		body		dto.ConfirmUploadSessionDto		false	"Uploaded parts (multipart only)"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=dto.ConfirmUploadSessionResponse}
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		409			{object}	models.SwaggerErrorResponse
+//	@Failure		410			{object}	models.SwaggerErrorResponse
+//	@Failure		422			{object}	models.SwaggerErrorResponse	"Rejected by the upload scanners"
+//	@Failure		503			{object}	models.SwaggerErrorResponse	"Upload scanners unavailable"
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/locations/{id}/media/uploads/{uploadId}/confirm [post]
+//	@Router			/organizations/{id}/logo/uploads/{uploadId}/confirm [post]
+//	@Router			/users/{id}/profile-photo/uploads/{uploadId}/confirm [post]
+func (h *UploadHandler) ConfirmUploadSession(target string, attach TargetAttacher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		targetID, ok := parseObjectID(c, "id", "Invalid ID")
+		if !ok {
+			return
+		}
+		sessionID, ok := parseObjectID(c, "uploadId", "Invalid upload ID")
+		if !ok {
+			return
+		}
+
+		var req dto.ConfirmUploadSessionDto
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindJSON(&req); err != nil {
+				middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid request body", err, http.StatusBadRequest))
+				return
+			}
+		}
+		if err := req.Validate(); err != nil {
+			middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+			return
+		}
+
+		var updated interface{}
+		session, err := h.ConfirmUploadSessionUseCase.Execute(c.Request.Context(), sessionID, target, targetID, req.Parts,
+			func(ctx context.Context, session *entity.UploadSession) error {
+				var err error
+				updated, err = attach(ctx, session)
+				return err
+			})
+		if err != nil {
+			middleware.HandleError(c, uploadError(err, "Failed to confirm upload"))
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.ConfirmUploadSessionResponse{Session: session, Target: updated})
+	}
+}
+
+func parseObjectID(c *gin.Context, param, message string) (primitive.ObjectID, bool) {
+	id, err := primitive.ObjectIDFromHex(c.Param(param))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, message, err, http.StatusBadRequest))
+		return primitive.NilObjectID, false
+	}
+	return id, true
+}
+
+// uploadError maps upload errors to HTTP errors
+func uploadError(err error, fallback string) *middleware.AppError {
+	switch {
+	case errors.Is(err, entity.ErrUploadNotFound), errors.Is(err, entity.ErrUploadTargetMismatch):
+		return middleware.NewAppError(middleware.ErrorCodeNotFound, entity.ErrUploadNotFound.Error(), nil, http.StatusNotFound)
+	case errors.Is(err, entity.ErrUploadTooLarge):
+		return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusRequestEntityTooLarge)
+	case errors.Is(err, entity.ErrUploadEmpty),
+		errors.Is(err, entity.ErrUploadTypeNotAllowed),
+		errors.Is(err, entity.ErrUploadPartsRequired),
+		errors.Is(err, entity.ErrUploadObjectMissing),
+		errors.Is(err, entity.ErrUploadSizeMismatch),
+		errors.Is(err, entity.ErrUploadTypeMismatch):
+		return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+	case errors.Is(err, services.ErrUnsupportedImage),
+		errors.Is(err, services.ErrSVGNotAllowed),
+		errors.Is(err, services.ErrUnsafeSVG),
+		errors.Is(err, services.ErrImageDimensionsTooLarge):
+		return middleware.NewImageAppError(err, fallback)
+	case errors.Is(err, services.ErrUploadRejected), errors.Is(err, services.ErrScannerUnavailable):
+		return middleware.NewUploadScanAppError(err, fallback)
+	case errors.Is(err, entity.ErrUploadNotPending):
+		return middleware.NewAppError(middleware.ErrorCodeConflict, err.Error(), nil, http.StatusConflict)
+	case errors.Is(err, entity.ErrUploadExpired):
+		return middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusGone)
+	case errors.Is(err, entity.ErrDirectUploadUnsupported):
+		return middleware.NewAppError(middleware.ErrorCodeServiceUnavailable, err.Error(), nil, http.StatusNotImplemented)
+	default:
+		return middleware.NewAppError(middleware.ErrorCodeInternalServer, fallback, err, http.StatusInternalServerError)
+	}
+}
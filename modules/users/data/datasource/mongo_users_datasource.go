@@ -4,11 +4,12 @@ import (
 	"context"
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/database"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/data/mongodb/model"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type MongoUserDatasource struct {
@@ -43,33 +44,30 @@ func (ds *MongoUserDatasource) Insert(ctx context.Context, user *model.UserModel
 	return nil
 }
 
-// FindByFilters retrieves user documents with optional filters, pagination, and sorting
-func (ds *MongoUserDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, page int, limit int) ([]model.UserModel, int64, error) {
-	// Step 1: Count total for pagination
-	totalCount, err := ds.collection.CountDocuments(ctx, filters)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Step 2: Prepare options
-	opts := options.Find().
-		SetSkip(int64((page - 1) * limit)).
-		SetLimit(int64(limit)).
-		SetSort(bson.D{{Key: "createdAt", Value: -1}}) // Default sort by creation date descending
-
-	// Step 3: Query
-	cursor, err := ds.collection.Find(ctx, filters, opts)
+// FindByFilters retrieves the page of user documents matching filters selected by opts
+func (ds *MongoUserDatasource) FindByFilters(ctx context.Context, filters map[string]interface{}, opts models.ListOptions) ([]model.UserModel, *models.PageInfo, error) {
+	var users []model.UserModel
+	info, err := database.FindPage(ctx, ds.collection, filters, opts, &users)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
-	defer cursor.Close(ctx)
+	return users, info, nil
+}
 
-	var users []model.UserModel
-	if err := cursor.All(ctx, &users); err != nil {
-		return nil, 0, err
-	}
+// Stream decodes each user document matching filters in sort order and calls fn with it
+func (ds *MongoUserDatasource) Stream(ctx context.Context, filters map[string]interface{}, sort []models.SortField, fn func(*model.UserModel) error) error {
+	return database.Stream(ctx, ds.collection, filters, sort, func(cursor *mongo.Cursor) error {
+		var m model.UserModel
+		if err := cursor.Decode(&m); err != nil {
+			return err
+		}
+		return fn(&m)
+	})
+}
 
-	return users, totalCount, nil
+// Count counts the user documents matching filters
+func (ds *MongoUserDatasource) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
+	return ds.collection.CountDocuments(ctx, filters)
 }
 
 // FindByID finds an user by its ID
@@ -126,22 +124,44 @@ func (ds *MongoUserDatasource) FindByPhone(ctx context.Context, phone string) (*
 func (ds *MongoUserDatasource) Update(ctx context.Context, user *model.UserModel) error {
 	user.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": user.ID}
+	// Only overwrite the version the caller read, so concurrent edits are not lost
+	expected := user.Version
+	user.Version++
+	filter := database.VersionedFilter(user.ID, expected)
 	update := bson.M{"$set": user}
 
-	_, err := ds.collection.UpdateOne(ctx, filter, update)
+	result, err := ds.collection.UpdateOne(ctx, filter, update)
+	if err == nil && result.MatchedCount == 0 {
+		err = models.ErrVersionConflict
+	}
+	if err != nil {
+		user.Version = expected
+	}
 	return err
 }
 
+// Patch writes only the fields of updated that differ from original, while the stored
+// document is still at the version of original
+func (ds *MongoUserDatasource) Patch(ctx context.Context, original, updated *model.UserModel) error {
+	set, unset, err := database.ChangedFields(original, updated)
+	if err != nil || len(set)+len(unset) == 0 {
+		return err
+	}
+	updated.UpdatedAt = time.Now()
+	set["updatedAt"] = updated.UpdatedAt
+
+	filter := database.VersionedFilter(original.ID, original.Version)
+	if err := database.PatchDocument(ctx, ds.collection, filter, set, unset); err != nil {
+		return err
+	}
+	updated.Version = original.Version + 1
+	return nil
+}
+
 // SoftDelete marks an user as deleted by setting deletedAt timestamp
 func (ds *MongoUserDatasource) SoftDelete(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id, "deletedAt": nil}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": time.Now(),
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.SoftDeleteUpdate(ctx)
 
 	result, err := ds.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -154,12 +174,7 @@ func (ds *MongoUserDatasource) SoftDelete(ctx context.Context, id primitive.Obje
 // Restore restores a soft-deleted user by setting deletedAt to nil
 func (ds *MongoUserDatasource) Restore(ctx context.Context, id primitive.ObjectID) (bool, error) {
 	filter := bson.M{"_id": id, "deletedAt": bson.M{"$ne": nil}}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -187,12 +202,7 @@ func (ds *MongoUserDatasource) BulkSoftDelete(ctx context.Context, ids []primiti
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": nil,
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": time.Now(),
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.SoftDeleteUpdate(ctx)
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -232,6 +242,7 @@ func (ds *MongoUserDatasource) UpdateStatus(ctx context.Context, id primitive.Ob
 			"status":    status,
 			"updatedAt": time.Now(),
 		},
+		"$inc": database.IncrementVersion(),
 	}
 
 	_, err := ds.collection.UpdateOne(ctx, filter, update)
@@ -243,12 +254,7 @@ func (ds *MongoUserDatasource) BulkRestore(ctx context.Context, ids []primitive.
 		"_id":       bson.M{"$in": ids},
 		"deletedAt": bson.M{"$ne": nil}, // Only restore soft-deleted items
 	}
-	update := bson.M{
-		"$set": bson.M{
-			"deletedAt": nil,
-			"updatedAt": time.Now(),
-		},
-	}
+	update := database.RestoreUpdate()
 
 	result, err := ds.collection.UpdateMany(ctx, filter, update)
 	if err != nil {
@@ -4,6 +4,7 @@ package model
 import (
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -15,20 +16,22 @@ func (UserModel) CollectionName() string {
 
 // UserModel represents the MongoDB document structure for users
 type UserModel struct {
-	ID              primitive.ObjectID `bson:"_id,omitempty"`
-	FullName        string             `bson:"fullName"`
-	Emails          []EmailInfo        `bson:"emails"`
-	Phones          []PhoneInfo        `bson:"phones"`
-	Password        string             `bson:"password"`
-	Status          string             `bson:"status"`
-	ProfilePhotoURL string             `bson:"profilePhotoUrl"`
-	Role            string             `bson:"role"`
-	RoleID          primitive.ObjectID `bson:"roleId"`
-	OrganizationID  primitive.ObjectID `bson:"organizationId,omitempty"`
-	AuditTrail      AuditTrailModel    `bson:"auditTrail"`
-	CreatedAt       time.Time          `bson:"createdAt"`
-	UpdatedAt       time.Time          `bson:"updatedAt"`
-	DeletedAt       *time.Time         `bson:"deletedAt,omitempty"`
+	ID                   primitive.ObjectID   `bson:"_id,omitempty"`
+	FullName             string               `bson:"fullName"`
+	Emails               []EmailInfo          `bson:"emails"`
+	Phones               []PhoneInfo          `bson:"phones"`
+	Password             string               `bson:"password"`
+	Status               string               `bson:"status"`
+	ProfilePhotoURL      string               `bson:"profilePhotoUrl"`
+	ProfilePhotoVariants models.ImageVariants `bson:"profilePhotoVariants"`
+	Role                 string               `bson:"role"`
+	RoleID               primitive.ObjectID   `bson:"roleId"`
+	OrganizationID       primitive.ObjectID   `bson:"organizationId,omitempty"`
+	AuditTrail           AuditTrailModel      `bson:"auditTrail"`
+	CreatedAt            time.Time            `bson:"createdAt"`
+	UpdatedAt            time.Time            `bson:"updatedAt"`
+	Version              int64                `bson:"version"`
+	DeletedAt            *time.Time           `bson:"deletedAt,omitempty"`
 }
 
 // PhoneInfo stores a phone number and its verification/OTP info
@@ -68,20 +71,22 @@ func (m *UserModel) ToEntity() entity.User {
 	}
 
 	return entity.User{
-		ID:              m.ID,
-		FullName:        m.FullName,
-		Emails:          emails,
-		Phones:          phones,
-		Status:          entity.UserStatus(m.Status),
-		Password:        m.Password,
-		ProfilePhotoURL: m.ProfilePhotoURL,
-		Role:            m.Role,
-		RoleID:          m.RoleID.Hex(),
-		OrganizationID:  m.OrganizationID.Hex(),
-		AuditTrail:      m.toEntityAuditTrail(),
-		CreatedAt:       m.CreatedAt,
-		UpdatedAt:       m.UpdatedAt,
-		DeletedAt:       m.DeletedAt,
+		ID:                   m.ID,
+		FullName:             m.FullName,
+		Emails:               emails,
+		Phones:               phones,
+		Status:               entity.UserStatus(m.Status),
+		Password:             m.Password,
+		ProfilePhotoURL:      m.ProfilePhotoURL,
+		ProfilePhotoVariants: m.ProfilePhotoVariants,
+		Role:                 m.Role,
+		RoleID:               m.RoleID.Hex(),
+		OrganizationID:       m.OrganizationID.Hex(),
+		AuditTrail:           m.toEntityAuditTrail(),
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+		Version:              m.Version,
+		DeletedAt:            m.DeletedAt,
 	}
 }
 
@@ -107,20 +112,22 @@ func FromEntity(user *entity.User) *UserModel {
 	}
 
 	return &UserModel{
-		ID:              user.ID,
-		FullName:        user.FullName,
-		Emails:          emails,
-		Phones:          phones,
-		Password:        user.Password,
-		Status:          string(user.Status),
-		ProfilePhotoURL: user.ProfilePhotoURL,
-		Role:            user.Role,
-		RoleID:          roleID,
-		OrganizationID:  organizationId,
-		AuditTrail:      fromEntityAuditTrail(user.AuditTrail),
-		CreatedAt:       user.CreatedAt,
-		UpdatedAt:       user.UpdatedAt,
-		DeletedAt:       user.DeletedAt,
+		ID:                   user.ID,
+		FullName:             user.FullName,
+		Emails:               emails,
+		Phones:               phones,
+		Password:             user.Password,
+		Status:               string(user.Status),
+		ProfilePhotoURL:      user.ProfilePhotoURL,
+		ProfilePhotoVariants: user.ProfilePhotoVariants,
+		Role:                 user.Role,
+		RoleID:               roleID,
+		OrganizationID:       organizationId,
+		AuditTrail:           fromEntityAuditTrail(user.AuditTrail),
+		CreatedAt:            user.CreatedAt,
+		UpdatedAt:            user.UpdatedAt,
+		Version:              user.Version,
+		DeletedAt:            user.DeletedAt,
 	}
 }
 
@@ -27,16 +27,39 @@ func NewUserRepositoryMongo(ds *datasource.MongoUserDatasource) *UserRepositoryM
 
 // List implements repository.UserRepository.
 func (u *UserRepositoryMongo) List(ctx context.Context, filter map[string]interface{}, page int, limit int) ([]*entity.User, int64, error) {
-	userModels, totalCount, err := u.datasource.FindByFilters(ctx, filter, page, limit)
+	users, info, err := u.FindPage(ctx, filter, models.ListOptions{Page: page, Limit: limit, Count: models.CountExact})
 	if err != nil {
 		return nil, 0, err
 	}
+	return users, *info.Total, nil
+}
+
+// FindPage implements repository.UserRepository.
+func (u *UserRepositoryMongo) FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.User, *models.PageInfo, error) {
+	userModels, info, err := u.datasource.FindByFilters(ctx, filter, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	userEntities := make([]*entity.User, len(userModels))
 	for i, model := range userModels {
 		entity := model.ToEntity()
 		userEntities[i] = &entity
 	}
-	return userEntities, totalCount, nil
+	return userEntities, info, nil
+}
+
+// Stream implements repository.UserRepository.
+func (u *UserRepositoryMongo) Stream(ctx context.Context, filter map[string]interface{}, sort []models.SortField, fn func(*entity.User) error) error {
+	return u.datasource.Stream(ctx, filter, sort, func(m *model.UserModel) error {
+		user := m.ToEntity()
+		return fn(&user)
+	})
+}
+
+// Count implements repository.UserRepository.
+func (u *UserRepositoryMongo) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return u.datasource.Count(ctx, filter)
 }
 
 // Create implements repository.UserRepository.
@@ -127,7 +150,22 @@ func (u *UserRepositoryMongo) SoftDelete(ctx context.Context, id primitive.Objec
 // Update implements repository.UserRepository.
 func (u *UserRepositoryMongo) Update(ctx context.Context, user *entity.User) error {
 	userModel := model.FromEntity(user)
-	return u.datasource.Update(ctx, userModel)
+	if err := u.datasource.Update(ctx, userModel); err != nil {
+		return err
+	}
+	user.Version = userModel.Version
+	return nil
+}
+
+// Patch implements repository.UserRepository.
+func (u *UserRepositoryMongo) Patch(ctx context.Context, original, updated *entity.User) error {
+	updatedModel := model.FromEntity(updated)
+	if err := u.datasource.Patch(ctx, model.FromEntity(original), updatedModel); err != nil {
+		return err
+	}
+	updated.UpdatedAt = updatedModel.UpdatedAt
+	updated.Version = updatedModel.Version
+	return nil
 }
 
 // BulkSoftDelete implements repository.UserRepository.
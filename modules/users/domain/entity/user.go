@@ -6,6 +6,8 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/crypto/bcrypt"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 )
 
 type UserStatus string
@@ -36,20 +38,22 @@ type AuditTrail struct {
 }
 
 type User struct {
-	ID              primitive.ObjectID `json:"_id" bson:"_id"`
-	FullName        string             `json:"fullName" bson:"fullName"`
-	Emails          []Email            `json:"emails" bson:"emails"`
-	Phones          []Phone            `json:"phones" bson:"phones"`
-	Password        string             `json:"password" bson:"password"`
-	Status          UserStatus         `json:"status" bson:"status"`
-	ProfilePhotoURL string             `json:"profilePhotoUrl" bson:"profilePhotoUrl"`
-	RoleID          string             `json:"roleId" bson:"roleId"`
-	Role            string             `json:"role" bson:"role"` // For convenience
-	OrganizationID  string             `json:"organizationId" bson:"organizationId, omitempty"`
-	AuditTrail      AuditTrail         `json:"auditTrail" bson:"auditTrail"`
-	CreatedAt       time.Time          `json:"createdAt" bson:"createdAt"`
-	UpdatedAt       time.Time          `json:"updatedAt" bson:"updatedAt"`
-	DeletedAt       *time.Time         `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	ID                   primitive.ObjectID   `json:"_id" bson:"_id"`
+	FullName             string               `json:"fullName" bson:"fullName"`
+	Emails               []Email              `json:"emails" bson:"emails"`
+	Phones               []Phone              `json:"phones" bson:"phones"`
+	Password             string               `json:"-" bson:"password"` // bcrypt hash, never serialised
+	Status               UserStatus           `json:"status" bson:"status"`
+	ProfilePhotoURL      string               `json:"profilePhotoUrl" bson:"profilePhotoUrl"`
+	ProfilePhotoVariants models.ImageVariants `json:"profilePhotoVariants,omitempty" bson:"profilePhotoVariants,omitempty"`
+	RoleID               string               `json:"roleId" bson:"roleId"`
+	Role                 string               `json:"role" bson:"role"` // For convenience
+	OrganizationID       string               `json:"organizationId" bson:"organizationId, omitempty"`
+	AuditTrail           AuditTrail           `json:"auditTrail" bson:"auditTrail"`
+	CreatedAt            time.Time            `json:"createdAt" bson:"createdAt"`
+	UpdatedAt            time.Time            `json:"updatedAt" bson:"updatedAt"`
+	Version              int64                `json:"version" bson:"version"`
+	DeletedAt            *time.Time           `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
 }
 
 // GetPrimaryEmail returns the first email (primary email)
@@ -73,6 +77,12 @@ func (u *User) IsDeleted() bool {
 	return u.DeletedAt != nil
 }
 
+// ResolveFileURLs replaces the stored profile photo keys with the URLs returned by resolve, for responses
+func (u *User) ResolveFileURLs(resolve func(string) string) {
+	u.ProfilePhotoURL = resolve(u.ProfilePhotoURL)
+	u.ProfilePhotoVariants = u.ProfilePhotoVariants.Map(resolve)
+}
+
 // UpdateAuditTrail updates the audit trail with new login information
 func (u *User) UpdateAuditTrail(ip, device string) {
 	now := time.Now()
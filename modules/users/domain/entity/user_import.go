@@ -0,0 +1,55 @@
+package entity
+
+import "errors"
+
+// ErrImportOrganizationNotFound is returned for imports into an organization that does not exist
+var ErrImportOrganizationNotFound = errors.New("organization not found")
+
+// Import row statuses
+const (
+	ImportRowValid   = "valid"   // passed every check in a dry run
+	ImportRowCreated = "created" // user created and invited
+	ImportRowFailed  = "failed"
+)
+
+// UserImportRow is one parsed input row. Either User or Error is set.
+type UserImportRow struct {
+	Row   int // 1-based data row, the CSV header excluded
+	Email string
+	User  *User
+	Error string
+}
+
+// UserImportRowResult reports what happened to a single row
+type UserImportRowResult struct {
+	Row     int    `json:"row"`
+	Email   string `json:"email,omitempty"`
+	Status  string `json:"status" enums:"valid,created,failed"`
+	UserID  string `json:"userId,omitempty"`
+	Role    string `json:"role,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// UserImportReport is the outcome of a user import, with one result per row
+type UserImportReport struct {
+	OrganizationID string                `json:"organizationId"`
+	DryRun         bool                  `json:"dryRun"`
+	TotalRows      int                   `json:"totalRows"`
+	Valid          int                   `json:"valid"`
+	Created        int                   `json:"created"`
+	Failed         int                   `json:"failed"`
+	Rows           []UserImportRowResult `json:"rows"`
+}
+
+// Add records the result of a row and counts it
+func (r *UserImportReport) Add(result UserImportRowResult) {
+	switch result.Status {
+	case ImportRowValid:
+		r.Valid++
+	case ImportRowCreated:
+		r.Created++
+	default:
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, result)
+}
@@ -0,0 +1,10 @@
+package entity
+
+import "errors"
+
+// Errors returned when assigning a role to a user
+var (
+	ErrUserNotFound      = errors.New("user not found")
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrRoleNotAssignable = errors.New("role does not belong to the user's organization")
+)
@@ -16,9 +16,20 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*entity.User, error)
 	GetByPhone(ctx context.Context, phone string) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
+	Patch(ctx context.Context, original, updated *entity.User) error
 	
 	// Listing and filtering
 	List(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.User, int64, error)
+
+	// FindPage retrieves the page of users matching filter selected by opts, by page
+	// number or cursor
+	FindPage(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.User, *models.PageInfo, error)
+
+	// Stream calls fn for every user matching filter in sort order, without loading them all
+	Stream(ctx context.Context, filter map[string]interface{}, sort []models.SortField, fn func(*entity.User) error) error
+
+	// Count counts the users matching filter
+	Count(ctx context.Context, filter map[string]interface{}) (int64, error)
 	
 	// Bulk operations
 	BulkSoftDelete(ctx context.Context, ids []string) (*models.BulkDeleteResponse, error)
@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	roleRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/repository"
+)
+
+// AssignUserRoleUseCase moves a user to another role
+type AssignUserRoleUseCase struct {
+	userRepo repository.UserRepository
+	roleRepo roleRepo.RoleRepository
+}
+
+func NewAssignUserRoleUseCase(userRepo repository.UserRepository, roleRepo roleRepo.RoleRepository) *AssignUserRoleUseCase {
+	return &AssignUserRoleUseCase{
+		userRepo: userRepo,
+		roleRepo: roleRepo,
+	}
+}
+
+// Execute gives the user the role. The role must exist and be a system role or one of
+// the user's organization; global roles can only be given to users outside organizations.
+func (uc *AssignUserRoleUseCase) Execute(ctx context.Context, userID, roleID primitive.ObjectID) (*entity.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, entity.ErrUserNotFound
+	}
+
+	role, err := uc.roleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil || role.IsDeleted() {
+		return nil, entity.ErrRoleNotFound
+	}
+	if role.OrganizationID != nil && role.OrganizationID.Hex() != user.OrganizationID {
+		return nil, entity.ErrRoleNotAssignable
+	}
+	if role.Scope == roleEntity.RoleScopeGlobal && user.OrganizationID != "" {
+		return nil, entity.ErrRoleNotAssignable
+	}
+
+	original := *user
+	user.RoleID = role.ID.Hex()
+	user.Role = role.Name
+	if err := uc.userRepo.Patch(ctx, &original, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	orgEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	orgRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+	permissionEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
+	permissionRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/repository"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	roleRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+)
+
+// UserExpansion selects the relationships of users to load
+type UserExpansion struct {
+	Role         bool
+	Organization bool
+	Permissions  bool
+}
+
+// UserRelations holds the related entities loaded for a set of users, keyed by ID hex.
+// Permissions are keyed by role ID.
+type UserRelations struct {
+	Roles         map[string]*roleEntity.Role
+	Organizations map[string]*orgEntity.Organization
+	Permissions   map[string][]*permissionEntity.Permission
+}
+
+// ExpandUsersUseCase loads the roles, organizations and permissions of users with one
+// query per relationship, however many users there are
+type ExpandUsersUseCase struct {
+	roleRepo       roleRepo.RoleRepository
+	orgRepo        orgRepo.OrganizationRepository
+	permissionRepo permissionRepo.PermissionRepository
+}
+
+func NewExpandUsersUseCase(roleRepo roleRepo.RoleRepository, orgRepo orgRepo.OrganizationRepository, permissionRepo permissionRepo.PermissionRepository) *ExpandUsersUseCase {
+	return &ExpandUsersUseCase{
+		roleRepo:       roleRepo,
+		orgRepo:        orgRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+// Execute loads the relationships of users selected by expand. References to missing or
+// malformed IDs are left out.
+func (uc *ExpandUsersUseCase) Execute(ctx context.Context, users []*entity.User, expand UserExpansion) (*UserRelations, error) {
+	relations := &UserRelations{
+		Roles:         map[string]*roleEntity.Role{},
+		Organizations: map[string]*orgEntity.Organization{},
+		Permissions:   map[string][]*permissionEntity.Permission{},
+	}
+
+	if expand.Role || expand.Permissions {
+		roleIDs := objectIDs(users, func(user *entity.User) string { return user.RoleID })
+		if len(roleIDs) > 0 {
+			roles, _, err := uc.roleRepo.FindPage(ctx, idIn(roleIDs), onePage(len(roleIDs)))
+			if err != nil {
+				return nil, err
+			}
+			for _, role := range roles {
+				relations.Roles[role.ID.Hex()] = role
+			}
+		}
+	}
+
+	if expand.Organization {
+		orgIDs := objectIDs(users, func(user *entity.User) string { return user.OrganizationID })
+		if len(orgIDs) > 0 {
+			organizations, _, err := uc.orgRepo.FindPage(ctx, idIn(orgIDs), onePage(len(orgIDs)))
+			if err != nil {
+				return nil, err
+			}
+			for _, organization := range organizations {
+				relations.Organizations[organization.ID.Hex()] = organization
+			}
+		}
+	}
+
+	if expand.Permissions && len(relations.Roles) > 0 {
+		if err := uc.loadPermissions(ctx, relations); err != nil {
+			return nil, err
+		}
+	}
+
+	return relations, nil
+}
+
+// loadPermissions resolves the permissions of the loaded roles, which are stored either
+// as permission IDs or as resource:action pairs
+func (uc *ExpandUsersUseCase) loadPermissions(ctx context.Context, relations *UserRelations) error {
+	var ids []primitive.ObjectID
+	var pairs []interface{}
+	seen := map[string]bool{}
+	for _, role := range relations.Roles {
+		for _, ref := range role.Permissions {
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			if id, err := primitive.ObjectIDFromHex(ref); err == nil {
+				ids = append(ids, id)
+			} else if resource, action, ok := strings.Cut(ref, ":"); ok {
+				pairs = append(pairs, map[string]interface{}{"resource": resource, "action": action})
+			}
+		}
+	}
+	if len(ids) == 0 && len(pairs) == 0 {
+		return nil
+	}
+
+	clauses := pairs
+	if len(ids) > 0 {
+		clauses = append(clauses, idIn(ids))
+	}
+	permissions, _, err := uc.permissionRepo.FindPage(ctx, map[string]interface{}{"$or": clauses}, onePage(len(seen)))
+	if err != nil {
+		return err
+	}
+
+	byRef := make(map[string]*permissionEntity.Permission, len(permissions)*2)
+	for _, permission := range permissions {
+		byRef[permission.ID.Hex()] = permission
+		byRef[permission.Resource+":"+string(permission.Action)] = permission
+	}
+	for roleID, role := range relations.Roles {
+		for _, ref := range role.Permissions {
+			if permission, ok := byRef[ref]; ok {
+				relations.Permissions[roleID] = append(relations.Permissions[roleID], permission)
+			}
+		}
+	}
+	return nil
+}
+
+// objectIDs returns the distinct valid IDs ref returns for users
+func objectIDs(users []*entity.User, ref func(*entity.User) string) []primitive.ObjectID {
+	var ids []primitive.ObjectID
+	seen := map[primitive.ObjectID]bool{}
+	for _, user := range users {
+		id, err := primitive.ObjectIDFromHex(ref(user))
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// idIn matches the documents with one of ids
+func idIn(ids []primitive.ObjectID) map[string]interface{} {
+	return map[string]interface{}{"_id": map[string]interface{}{"$in": ids}}
+}
+
+// onePage selects the n documents looked up by ID as a single uncounted page
+func onePage(n int) models.ListOptions {
+	return models.ListOptions{Page: 1, Limit: n, Count: models.CountNone}
+}
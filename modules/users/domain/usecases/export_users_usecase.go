@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/repository"
+)
+
+// ExportUsersUseCase reads every user matching a list filter, for exports
+type ExportUsersUseCase struct {
+	repo repository.UserRepository
+}
+
+func NewExportUsersUseCase(repo repository.UserRepository) *ExportUsersUseCase {
+	return &ExportUsersUseCase{
+		repo: repo,
+	}
+}
+
+// Count counts the users an export of filter will contain
+func (uc *ExportUsersUseCase) Count(ctx context.Context, filter map[string]interface{}) (int64, error) {
+	return uc.repo.Count(ctx, filter)
+}
+
+// Execute calls write for each user matching filter, in sort order
+func (uc *ExportUsersUseCase) Execute(ctx context.Context, filter map[string]interface{}, sort []models.SortField, write func(*entity.User) error) error {
+	return uc.repo.Stream(ctx, filter, sort, write)
+}
@@ -0,0 +1,203 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/jobs"
+	orgRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/repository"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	roleRepo "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/repository"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/repository"
+)
+
+// invitationTimeout bounds how long the invitations of one import may take to send
+const invitationTimeout = 10 * time.Minute
+
+// ImportUsersUseCase creates the users of an import in one organization and invites them
+type ImportUsersUseCase struct {
+	userRepo    repository.UserRepository
+	roleRepo    roleRepo.RoleRepository
+	orgRepo     orgRepo.OrganizationRepository
+	createUser  *CreateUserUseCase
+	invitations services.InvitationSender
+	background  *jobs.Group
+}
+
+func NewImportUsersUseCase(
+	userRepo repository.UserRepository,
+	roleRepo roleRepo.RoleRepository,
+	orgRepo orgRepo.OrganizationRepository,
+	createUser *CreateUserUseCase,
+	invitations services.InvitationSender,
+	background *jobs.Group,
+) *ImportUsersUseCase {
+	return &ImportUsersUseCase{
+		userRepo:    userRepo,
+		roleRepo:    roleRepo,
+		orgRepo:     orgRepo,
+		createUser:  createUser,
+		invitations: invitations,
+		background:  background,
+	}
+}
+
+// AssignableRoles returns the roles members of the organization can be given, keyed by
+// lower-case name: system roles and the organization's own roles, which win on a name
+// clash. Global roles are left out so an import cannot grant platform-wide access.
+func (uc *ImportUsersUseCase) AssignableRoles(ctx context.Context, organizationID primitive.ObjectID) (map[string]*roleEntity.Role, error) {
+	exists, err := uc.orgRepo.ExistsByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, entity.ErrImportOrganizationNotFound
+	}
+
+	filter := map[string]interface{}{
+		"organizationId": map[string]interface{}{"$in": []interface{}{nil, organizationID}},
+		"deletedAt":      nil,
+	}
+	roles := make(map[string]*roleEntity.Role)
+	err = uc.roleRepo.Stream(ctx, filter, nil, func(role *roleEntity.Role) error {
+		if role.Scope == roleEntity.RoleScopeGlobal {
+			return nil
+		}
+		key := strings.ToLower(strings.TrimSpace(role.Name))
+		if existing, ok := roles[key]; ok && existing.OrganizationID != nil {
+			return nil
+		}
+		roles[key] = role
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// Execute checks every row for duplicates, in the file and among existing users, and
+// unless dryRun creates the valid ones. Rows are independent: a failed row is reported
+// and the rest are still created. Invitations are sent in the background once all rows
+// are processed.
+func (uc *ImportUsersUseCase) Execute(ctx context.Context, organizationID primitive.ObjectID, rows []entity.UserImportRow, dryRun bool, invitedBy string) (*entity.UserImportReport, error) {
+	report := &entity.UserImportReport{
+		OrganizationID: organizationID.Hex(),
+		DryRun:         dryRun,
+		TotalRows:      len(rows),
+		Rows:           make([]entity.UserImportRowResult, 0, len(rows)),
+	}
+
+	emailRows := make(map[string]int)
+	phoneRows := make(map[string]int)
+	var invited []*entity.User
+
+	for _, row := range rows {
+		result := entity.UserImportRowResult{Row: row.Row, Email: row.Email}
+		if row.Error != "" {
+			result.Status = entity.ImportRowFailed
+			result.Message = row.Error
+			report.Add(result)
+			continue
+		}
+
+		user := row.User
+		result.Role = user.Role
+		if err := uc.checkDuplicates(ctx, user, row.Row, emailRows, phoneRows); err != nil {
+			result.Status = entity.ImportRowFailed
+			result.Message = err.Error()
+			report.Add(result)
+			continue
+		}
+
+		if dryRun {
+			result.Status = entity.ImportRowValid
+			report.Add(result)
+			continue
+		}
+
+		if err := uc.createUser.Execute(ctx, user); err != nil {
+			result.Status = entity.ImportRowFailed
+			result.Message = err.Error()
+			report.Add(result)
+			continue
+		}
+		result.Status = entity.ImportRowCreated
+		result.UserID = user.ID.Hex()
+		report.Add(result)
+		invited = append(invited, user)
+	}
+
+	if len(invited) > 0 {
+		// The request context ends with the response, the invitations must outlive it
+		uc.background.Go(func(ctx context.Context) {
+			uc.sendInvitations(ctx, invited, invitedBy)
+		})
+	}
+	return report, nil
+}
+
+// checkDuplicates rejects a user whose email or phone appeared on an earlier row or
+// already belongs to a user, and remembers them for later rows
+func (uc *ImportUsersUseCase) checkDuplicates(ctx context.Context, user *entity.User, row int, emailRows, phoneRows map[string]int) error {
+	email := strings.ToLower(user.GetPrimaryEmail())
+	if earlier, ok := emailRows[email]; ok {
+		return fmt.Errorf("email is already used on row %d", earlier)
+	}
+	phone := user.GetPrimaryPhone()
+	if earlier, ok := phoneRows[phone]; ok && phone != "" {
+		return fmt.Errorf("phone is already used on row %d", earlier)
+	}
+
+	exists, err := uc.userRepo.ExistsByEmail(ctx, user.GetPrimaryEmail())
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("user with this email already exists")
+	}
+	if phone != "" {
+		exists, err := uc.userRepo.ExistsByPhone(ctx, phone)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("user with this phone already exists")
+		}
+		phoneRows[phone] = row
+	}
+	emailRows[email] = row
+	return nil
+}
+
+func (uc *ImportUsersUseCase) sendInvitations(ctx context.Context, users []*entity.User, invitedBy string) {
+	ctx, cancel := context.WithTimeout(ctx, invitationTimeout)
+	defer cancel()
+
+	for i, user := range users {
+		if ctx.Err() != nil {
+			log.Printf("Stopped sending invitations, %d of %d were not sent: %v", len(users)-i, len(users), ctx.Err())
+			return
+		}
+
+		invitation := services.Invitation{
+			UserID:         user.ID.Hex(),
+			Email:          user.GetPrimaryEmail(),
+			FullName:       user.FullName,
+			Role:           user.Role,
+			OrganizationID: user.OrganizationID,
+			InvitedBy:      invitedBy,
+		}
+		if err := uc.invitations.SendInvitation(ctx, invitation); err != nil {
+			log.Printf("Failed to send invitation to user %s: %v", invitation.UserID, err)
+		}
+	}
+}
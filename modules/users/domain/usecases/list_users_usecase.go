@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/repository"
 )
@@ -17,6 +18,6 @@ func NewListUsersUseCase(repo repository.UserRepository) *ListUsersUseCase {
 	}
 }
 
-func (uc *ListUsersUseCase) Execute(ctx context.Context, filter map[string]interface{}, page, limit int) ([]*entity.User, int64, error) {
-	return uc.repo.List(ctx, filter, page, limit)
+func (uc *ListUsersUseCase) Execute(ctx context.Context, filter map[string]interface{}, opts models.ListOptions) ([]*entity.User, *models.PageInfo, error) {
+	return uc.repo.FindPage(ctx, filter, opts)
 }
@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/repository"
+)
+
+type PatchUserUseCase struct {
+	repo repository.UserRepository
+}
+
+func NewPatchUserUseCase(repo repository.UserRepository) *PatchUserUseCase {
+	return &PatchUserUseCase{
+		repo: repo,
+	}
+}
+
+// Execute writes the fields of updated that differ from original, the user as it was read.
+// The password cannot be patched, so it is never re-hashed here.
+func (uc *PatchUserUseCase) Execute(ctx context.Context, original, updated *entity.User) error {
+	// If the primary email is being changed, check for duplicates
+	if len(updated.Emails) > 0 && updated.GetPrimaryEmail() != original.GetPrimaryEmail() {
+		exists, err := uc.repo.ExistsByEmail(ctx, updated.GetPrimaryEmail())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("user with this email already exists")
+		}
+	}
+
+	// If the primary phone is being changed, check for duplicates
+	if len(updated.Phones) > 0 && updated.GetPrimaryPhone() != original.GetPrimaryPhone() {
+		exists, err := uc.repo.ExistsByPhone(ctx, updated.GetPrimaryPhone())
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("user with this phone already exists")
+		}
+	}
+
+	return uc.repo.Patch(ctx, original, updated)
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+)
+
+// BulkUserStatusDto is the data of the status bulk operation
+type BulkUserStatusDto struct {
+	Status string `json:"status" example:"Suspended"`
+}
+
+// Validate validates the BulkUserStatusDto
+func (dto *BulkUserStatusDto) Validate() error {
+	switch entity.UserStatus(dto.Status) {
+	case entity.UserStatusInvited, entity.UserStatusActive, entity.UserStatusSuspended, entity.UserStatusRemoved:
+		return nil
+	default:
+		return ErrInvalidStatus
+	}
+}
+
+// BulkAssignRoleDto is the data of the assignRole bulk operation
+type BulkAssignRoleDto struct {
+	RoleID string `json:"roleId" example:"6835bf49c62fee1db6585e9f"`
+}
+
+// Validate validates the BulkAssignRoleDto and returns the role ID
+func (dto *BulkAssignRoleDto) Validate() (primitive.ObjectID, error) {
+	if dto.RoleID == "" {
+		return primitive.NilObjectID, errors.New("roleId is required")
+	}
+	roleID, err := primitive.ObjectIDFromHex(dto.RoleID)
+	if err != nil {
+		return primitive.NilObjectID, ErrInvalidRoleID
+	}
+	return roleID, nil
+}
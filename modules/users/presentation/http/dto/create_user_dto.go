@@ -28,6 +28,7 @@ var (
 	ErrInvalidRoleID         = errors.New("invalid role ID format")
 	ErrInvalidOrganizationID = errors.New("invalid organization ID format")
 	ErrInvalidStatus         = errors.New("invalid status")
+	ErrStatusRequired        = errors.New("status is required")
 )
 
 func (dto *CreateUserDto) Validate() error {
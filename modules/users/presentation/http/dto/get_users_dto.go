@@ -4,9 +4,34 @@ package dto
 import (
 	"strconv"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// UserFilterFields are the fields users can be filtered by with the filter parameter
+var UserFilterFields = listfilter.Fields{
+	"fullName":       {Path: "fullName", Type: listfilter.String},
+	"email":          {Path: "emails.email", Type: listfilter.String},
+	"phone":          {Path: "phones.number", Type: listfilter.String},
+	"status":         {Path: "status", Type: listfilter.String},
+	"role":           {Path: "role", Type: listfilter.String},
+	"roleId":         {Path: "roleId", Type: listfilter.ObjectID},
+	"organizationId": {Path: "organizationId", Type: listfilter.ObjectID},
+	"lastLoginAt":    {Path: "auditTrail.lastLoginAt", Type: listfilter.Date},
+	"createdAt":      {Path: "createdAt", Type: listfilter.Date},
+	"updatedAt":      {Path: "updatedAt", Type: listfilter.Date},
+}
+
+// UserSortFields are the fields users can be sorted by
+var UserSortFields = pagination.SortFields{
+	"fullName":  "fullName",
+	"status":    "status",
+	"createdAt": "createdAt",
+	"updatedAt": "updatedAt",
+}
+
 // GetUsersDto defines the query parameters for listing users
 type GetUsersDto struct {
 	// Pagination parameters
@@ -95,12 +120,13 @@ func (dto *GetUsersDto) ToFilterMap() map[string]interface{} {
 		filter["status"] = dto.Status
 	}
 
+	// Both are stored as ObjectIDs; an invalid hex string matches nothing
 	if dto.RoleID != "" {
-		filter["roleId"] = dto.RoleID
+		filter["roleId"] = objectIDOrHex(dto.RoleID)
 	}
 
 	if dto.OrganizationID != "" {
-		filter["organizationId"] = dto.OrganizationID
+		filter["organizationId"] = objectIDOrHex(dto.OrganizationID)
 	}
 
 	// Search across multiple fields
@@ -119,4 +145,12 @@ func (dto *GetUsersDto) ToFilterMap() map[string]interface{} {
 	}
 
 	return filter
-}
\ No newline at end of file
+}
+
+// objectIDOrHex converts a hex ID for matching ObjectID fields, keeping invalid input as is
+func objectIDOrHex(hex string) interface{} {
+	if id, err := primitive.ObjectIDFromHex(hex); err == nil {
+		return id
+	}
+	return hex
+}
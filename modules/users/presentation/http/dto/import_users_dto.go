@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+)
+
+const (
+	// MaxImportFileSize is the largest user import file accepted (5MB)
+	MaxImportFileSize = 5 << 20
+	// MaxImportRows is the largest number of users accepted in one import
+	MaxImportRows = 1000
+)
+
+// UserImportColumns are the columns of a user import, matched case-insensitively.
+// Unknown columns are ignored, and fullName is accepted for name.
+var UserImportColumns = []string{"name", "email", "phone", "role"}
+
+var (
+	ErrImportEmpty       = errors.New("import file contains no rows")
+	ErrImportTooManyRows = fmt.Errorf("import exceeds the limit of %d rows", MaxImportRows)
+)
+
+// ImportUsersQueryDto holds the query parameters of a user import
+type ImportUsersQueryDto struct {
+	OrganizationID string `form:"organizationId" json:"organizationId" example:"507f1f77bcf86cd799439012"`
+	DryRun         bool   `form:"dryRun" json:"dryRun"`
+}
+
+// UserImportRecord is one data row of an import file, before its role is resolved
+type UserImportRecord struct {
+	Row      int
+	FullName string
+	Email    string
+	Phone    string
+	RoleName string
+	Error    string // set when the row could not be read
+}
+
+// ParseUserImport reads the rows of a CSV user import. File-level problems (bad header,
+// too many rows) return an error; rows that cannot be read are recorded on the row.
+func ParseUserImport(r io.Reader) ([]UserImportRecord, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, ErrImportEmpty
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if name == "fullname" {
+			name = "name"
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"name", "email", "role"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header is missing required column %q", required)
+		}
+	}
+
+	var records []UserImportRecord
+	for rowNum := 1; ; rowNum++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if rowNum > MaxImportRows {
+			return nil, ErrImportTooManyRows
+		}
+		if err != nil {
+			records = append(records, UserImportRecord{Row: rowNum, Error: err.Error()})
+			continue
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		records = append(records, UserImportRecord{
+			Row:      rowNum,
+			FullName: get("name"),
+			Email:    get("email"),
+			Phone:    get("phone"),
+			RoleName: get("role"),
+		})
+	}
+	if len(records) == 0 {
+		return nil, ErrImportEmpty
+	}
+	return records, nil
+}
+
+// ToRow resolves the record's role among roles, keyed by lower-case name, and validates it
+// as an invitation to the organization
+func (rec UserImportRecord) ToRow(organizationID string, roles map[string]*roleEntity.Role) entity.UserImportRow {
+	row := entity.UserImportRow{Row: rec.Row, Email: rec.Email}
+	if rec.Error != "" {
+		row.Error = rec.Error
+		return row
+	}
+
+	if rec.RoleName == "" {
+		row.Error = "role is required"
+		return row
+	}
+	role, ok := roles[strings.ToLower(rec.RoleName)]
+	if !ok {
+		row.Error = fmt.Sprintf("role %q is not available in this organization", rec.RoleName)
+		return row
+	}
+
+	createDto := CreateUserDto{
+		FullName:       rec.FullName,
+		Email:          rec.Email,
+		Phone:          rec.Phone,
+		Status:         string(entity.UserStatusInvited),
+		RoleID:         role.ID.Hex(),
+		OrganizationID: organizationID,
+	}
+	if err := createDto.Validate(); err != nil {
+		row.Error = err.Error()
+		return row
+	}
+
+	row.User = createDto.ToEntity()
+	row.User.Role = role.Name
+	return row
+}
@@ -2,14 +2,17 @@
 
 package dto
 
-import "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
-
 // PaginatedOrganizationsResponse represents the paginated response for organizations
 type PaginatedUsersResponse struct {
-	Items          []entity.User         `json:"items"`
-	Page           int                   `json:"page" example:"1"`
+	Items          []UserResponse        `json:"items"`
+	Page           int                   `json:"page,omitempty" example:"1"`
 	Limit          int                   `json:"limit" example:"10"`
-	Total          int64                 `json:"total" example:"2"`
-	TotalPages     int64                 `json:"totalPages" example:"1"`
+	Total          int64                 `json:"total,omitempty" example:"2"`
+	TotalPages     int64                 `json:"totalPages,omitempty" example:"1"`
+	TotalEstimated bool                  `json:"totalEstimated,omitempty" example:"false"`
+	HasNext        bool                  `json:"hasNext" example:"true"`
+	HasPrev        bool                  `json:"hasPrev" example:"false"`
+	NextCursor     string                `json:"nextCursor,omitempty" example:"IQAAAAJzAA8AAAAtY3JlYXRlZEF0LC1faWQABHYAAAAAAAA"`
+	PrevCursor     string                `json:"prevCursor,omitempty"`
 	IncludeDeleted bool                  `json:"includeDeleted" example:"false"`
 }
\ No newline at end of file
@@ -9,8 +9,8 @@ import (
 
 type UpdateUserDto struct {
 	FullName        *string  `json:"fullName,omitempty"`
-	Emails          []string `json:"emails,omitempty"` // Array of email strings
-	Phones          []string `json:"phones,omitempty"` // Array of phone strings
+	Emails          []string `json:"emails"` // Array of email strings
+	Phones          []string `json:"phones"` // Array of phone strings
 	Status          *string  `json:"status,omitempty"`
 	ProfilePhotoURL *string  `json:"profilePhotoUrl,omitempty"`
 	RoleID          *string  `json:"roleId,omitempty"`
@@ -66,6 +66,42 @@ func (dto *UpdateUserDto) Validate() error {
 	return nil
 }
 
+// ValidatePatch validates the fields changed by a patch. Removing a field, or setting it
+// to null, clears it, so the full name and status must keep a value.
+func (dto *UpdateUserDto) ValidatePatch() error {
+	if dto.FullName != nil && strings.TrimSpace(*dto.FullName) == "" {
+		return ErrFullNameRequired
+	}
+	if dto.Status != nil && *dto.Status == "" {
+		return ErrStatusRequired
+	}
+	return dto.Validate()
+}
+
+// NewUpdateUserDto returns every updatable field of a user. Partial updates are applied
+// to this document, then only the changed fields are applied back.
+func NewUpdateUserDto(user *entity.User) *UpdateUserDto {
+	emails := make([]string, 0, len(user.Emails))
+	for _, email := range user.Emails {
+		emails = append(emails, email.Email)
+	}
+	phones := make([]string, 0, len(user.Phones))
+	for _, phone := range user.Phones {
+		phones = append(phones, phone.Number)
+	}
+	current := *user
+	status := string(current.Status)
+	return &UpdateUserDto{
+		FullName:        &current.FullName,
+		Emails:          emails,
+		Phones:          phones,
+		Status:          &status,
+		ProfilePhotoURL: &current.ProfilePhotoURL,
+		RoleID:          &current.RoleID,
+		OrganizationID:  &current.OrganizationID,
+	}
+}
+
 // FIXED: ApplyUpdates method now properly handles Email and Phone entities
 func (dto *UpdateUserDto) ApplyUpdates(user *entity.User) {
 	if dto.FullName != nil {
@@ -120,8 +156,10 @@ func (dto *UpdateUserDto) ApplyUpdates(user *entity.User) {
 		user.Status = entity.UserStatus(*dto.Status)
 	}
 
-	if dto.ProfilePhotoURL != nil {
+	if dto.ProfilePhotoURL != nil && *dto.ProfilePhotoURL != user.ProfilePhotoURL {
 		user.ProfilePhotoURL = *dto.ProfilePhotoURL
+		// Variants belong to the previous photo
+		user.ProfilePhotoVariants = nil
 	}
 
 	if dto.RoleID != nil && *dto.RoleID != "" {
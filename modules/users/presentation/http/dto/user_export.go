@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+)
+
+// UserExportColumns are the columns a user export can include, in default order
+var UserExportColumns = export.Columns{
+	{Key: "id", Header: "ID"},
+	{Key: "fullName", Header: "Full Name"},
+	{Key: "emails", Header: "Emails"},
+	{Key: "phones", Header: "Phones"},
+	{Key: "status", Header: "Status"},
+	{Key: "role", Header: "Role"},
+	{Key: "roleId", Header: "Role ID"},
+	{Key: "organizationId", Header: "Organization ID"},
+	{Key: "lastLoginAt", Header: "Last Login At"},
+	{Key: "createdAt", Header: "Created At"},
+	{Key: "updatedAt", Header: "Updated At"},
+	{Key: "deletedAt", Header: "Deleted At"},
+}
+
+// UserExportRow returns the export values of a user keyed by column key
+func UserExportRow(user *entity.User) map[string]string {
+	emails := make([]string, len(user.Emails))
+	for i, email := range user.Emails {
+		emails[i] = email.Email
+	}
+	phones := make([]string, len(user.Phones))
+	for i, phone := range user.Phones {
+		phones[i] = phone.Number
+	}
+
+	return map[string]string{
+		"id":             user.ID.Hex(),
+		"fullName":       user.FullName,
+		"emails":         export.List(emails),
+		"phones":         export.List(phones),
+		"status":         string(user.Status),
+		"role":           user.Role,
+		"roleId":         user.RoleID,
+		"organizationId": user.OrganizationID,
+		"lastLoginAt":    export.OptionalTime(user.AuditTrail.LastLoginAt),
+		"createdAt":      export.Time(user.CreatedAt),
+		"updatedAt":      export.Time(user.UpdatedAt),
+		"deletedAt":      export.OptionalTime(user.DeletedAt),
+	}
+}
@@ -0,0 +1,160 @@
+package dto
+
+import (
+	"time"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	orgEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	permissionEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/permissions/domain/entity"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/usecases"
+)
+
+// Relationships users can be expanded with
+const (
+	ExpandRole         = "role"
+	ExpandOrganization = "organization"
+	ExpandPermissions  = "permissions"
+)
+
+// UserExpandable lists the relationships the expand parameter accepts for users
+var UserExpandable = []string{ExpandRole, ExpandOrganization, ExpandPermissions}
+
+// UserResponseFields are the members the fields parameter can select for users
+var UserResponseFields = fieldset.Of(UserResponse{})
+
+// UserResponse is the representation of a user returned by the API. It never includes
+// the password hash.
+type UserResponse struct {
+	ID                   string               `json:"_id" example:"5f8d0c1b7ea3f0d0f3c8e1b9"`
+	FullName             string               `json:"fullName" example:"Priya Sharma"`
+	Emails               []entity.Email       `json:"emails"`
+	Phones               []entity.Phone       `json:"phones"`
+	Status               entity.UserStatus    `json:"status" example:"Active"`
+	ProfilePhotoURL      string               `json:"profilePhotoUrl"`
+	ProfilePhotoVariants models.ImageVariants `json:"profilePhotoVariants,omitempty"`
+	RoleID               string               `json:"roleId" example:"5f8d0c1b7ea3f0d0f3c8e1c0"`
+	Role                 string               `json:"role" example:"Supplier Admin"`
+	OrganizationID       string               `json:"organizationId" example:"5f8d0c1b7ea3f0d0f3c8e1c1"`
+	AuditTrail           entity.AuditTrail    `json:"auditTrail"`
+	CreatedAt            time.Time            `json:"createdAt"`
+	UpdatedAt            time.Time            `json:"updatedAt"`
+	Version              int64                `json:"version" example:"3"`
+	DeletedAt            *time.Time           `json:"deletedAt,omitempty"`
+
+	// Set when the relationship is expanded
+	RoleDetails  *RoleSummary         `json:"roleDetails,omitempty"`
+	Organization *OrganizationSummary `json:"organization,omitempty"`
+	Permissions  []PermissionSummary  `json:"permissions,omitempty"`
+}
+
+// RoleSummary is the expanded role of a user
+type RoleSummary struct {
+	ID          string `json:"id" example:"5f8d0c1b7ea3f0d0f3c8e1c0"`
+	Name        string `json:"name" example:"Supplier Admin"`
+	Description string `json:"description"`
+	Scope       string `json:"scope" example:"organization"`
+}
+
+// OrganizationSummary is the expanded organization of a user
+type OrganizationSummary struct {
+	ID     string `json:"id" example:"5f8d0c1b7ea3f0d0f3c8e1c1"`
+	Name   string `json:"name" example:"WeCare Holidays"`
+	Slug   string `json:"slug" example:"wecare-holidays"`
+	Type   string `json:"type" example:"SUPPLIER"`
+	Status string `json:"status" example:"Approved"`
+	Logo   string `json:"logo"`
+}
+
+// PermissionSummary is one expanded permission of a user's role
+type PermissionSummary struct {
+	ID       string `json:"id" example:"5f8d0c1b7ea3f0d0f3c8e1c2"`
+	Resource string `json:"resource" example:"users"`
+	Action   string `json:"action" example:"read"`
+}
+
+// NewUserResponse builds the response for user
+func NewUserResponse(user *entity.User) *UserResponse {
+	return &UserResponse{
+		ID:                   user.ID.Hex(),
+		FullName:             user.FullName,
+		Emails:               user.Emails,
+		Phones:               user.Phones,
+		Status:               user.Status,
+		ProfilePhotoURL:      user.ProfilePhotoURL,
+		ProfilePhotoVariants: user.ProfilePhotoVariants,
+		RoleID:               user.RoleID,
+		Role:                 user.Role,
+		OrganizationID:       user.OrganizationID,
+		AuditTrail:           user.AuditTrail,
+		CreatedAt:            user.CreatedAt,
+		UpdatedAt:            user.UpdatedAt,
+		Version:              user.Version,
+		DeletedAt:            user.DeletedAt,
+	}
+}
+
+// NewUserResponses builds the responses for users
+func NewUserResponses(users []*entity.User) []*UserResponse {
+	responses := make([]*UserResponse, len(users))
+	for i, user := range users {
+		responses[i] = NewUserResponse(user)
+	}
+	return responses
+}
+
+// ToUserExpansion converts an expand parameter to the relationships to load
+func ToUserExpansion(expand fieldset.Set) usecases.UserExpansion {
+	return usecases.UserExpansion{
+		Role:         expand.Has(ExpandRole),
+		Organization: expand.Has(ExpandOrganization),
+		Permissions:  expand.Has(ExpandPermissions),
+	}
+}
+
+// Expand sets the relationships in relations selected by expand on the response.
+// resolveFileURL turns the stored organization logo into a URL.
+func (r *UserResponse) Expand(expand fieldset.Set, relations *usecases.UserRelations, resolveFileURL func(string) string) {
+	role := relations.Roles[r.RoleID]
+	if expand.Has(ExpandRole) && role != nil {
+		r.RoleDetails = newRoleSummary(role)
+	}
+	if organization := relations.Organizations[r.OrganizationID]; expand.Has(ExpandOrganization) && organization != nil {
+		r.Organization = newOrganizationSummary(organization, resolveFileURL)
+	}
+	if expand.Has(ExpandPermissions) && role != nil {
+		for _, permission := range relations.Permissions[r.RoleID] {
+			r.Permissions = append(r.Permissions, newPermissionSummary(permission))
+		}
+	}
+}
+
+func newRoleSummary(role *roleEntity.Role) *RoleSummary {
+	return &RoleSummary{
+		ID:          role.ID.Hex(),
+		Name:        role.Name,
+		Description: role.Description,
+		Scope:       string(role.Scope),
+	}
+}
+
+func newOrganizationSummary(organization *orgEntity.Organization, resolveFileURL func(string) string) *OrganizationSummary {
+	return &OrganizationSummary{
+		ID:     organization.ID.Hex(),
+		Name:   organization.Name,
+		Slug:   organization.Slug,
+		Type:   organization.Type,
+		Status: organization.Status,
+		Logo:   resolveFileURL(organization.Logo),
+	}
+}
+
+func newPermissionSummary(permission *permissionEntity.Permission) PermissionSummary {
+	return PermissionSummary{
+		ID:       permission.ID.Hex(),
+		Resource: permission.Resource,
+		Action:   string(permission.Action),
+	}
+}
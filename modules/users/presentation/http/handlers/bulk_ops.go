@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
+)
+
+// BulkOps returns the operations of the users bulk endpoint. Callers below global scope
+// may only change users of their own organization; other IDs fail with a forbidden result.
+func (h *UserHandler) BulkOps(c *gin.Context) bulk.Ops {
+	ops := bulk.Ops{
+		"update":     {Action: "update", Prepare: h.bulkUpdate},
+		"status":     {Action: "update", Prepare: h.bulkStatus},
+		"assignRole": {Action: "update", Prepare: h.bulkAssignRole},
+		"delete":     {Action: "delete", Prepare: h.bulkDelete},
+		"restore":    {Action: "restore", Prepare: h.bulkRestore},
+	}
+
+	authCtx := middleware.GetAuthContext(c.Request.Context())
+	if authCtx != nil && (authCtx.Role == "PLATFORM_ADMIN" || authCtx.RoleScope == roleEntity.RoleScopeGlobal) {
+		return ops
+	}
+	ownOrgID := ""
+	if authCtx != nil && authCtx.OrganizationID != nil {
+		ownOrgID = authCtx.OrganizationID.Hex()
+	}
+	return ops.Restrict(func(ctx context.Context, id primitive.ObjectID) error {
+		// Deleted users are included so restore can be scoped too
+		user, err := h.GetUserUseCase.Execute(ctx, id)
+		if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && user == nil) {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "User not found", nil, http.StatusNotFound)
+		}
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch user", err, http.StatusInternalServerError)
+		}
+		if ownOrgID == "" || user.OrganizationID != ownOrgID {
+			return middleware.NewAppError(middleware.ErrorCodeForbidden, "Access denied to this user", nil, http.StatusForbidden)
+		}
+		return nil
+	})
+}
+
+// bulkUpdate applies data to each user as a JSON Merge Patch, like PatchUser
+func (h *UserHandler) bulkUpdate(data json.RawMessage) (bulk.Apply, error) {
+	var fields map[string]json.RawMessage
+	if err := bulk.DecodeData(data, &fields); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("data must change at least one field")
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		user, err := h.bulkUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		var changes dto.UpdateUserDto
+		if err := patch.ApplyTo(patch.ContentTypeMergePatch, dto.NewUpdateUserDto(user), data, &changes); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest)
+		}
+		if err := changes.ValidatePatch(); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+		}
+		if changes.ProfilePhotoURL != nil {
+			photo := services.FileKey(h.fileService, *changes.ProfilePhotoURL)
+			changes.ProfilePhotoURL = &photo
+		}
+
+		original := *user
+		changes.ApplyUpdates(user)
+		if err := h.PatchUserUseCase.Execute(ctx, &original, user); err != nil {
+			return middleware.NewVersionConflictAppError(err, "Failed to update user")
+		}
+		return nil
+	}, nil
+}
+
+func (h *UserHandler) bulkStatus(data json.RawMessage) (bulk.Apply, error) {
+	var status dto.BulkUserStatusDto
+	if err := bulk.DecodeData(data, &status); err != nil {
+		return nil, err
+	}
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		if _, err := h.bulkUser(ctx, id); err != nil {
+			return err
+		}
+		if err := h.UpdateUserStatusUseCase.Execute(ctx, id, status.Status); err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to update user status", err, http.StatusInternalServerError)
+		}
+		return nil
+	}, nil
+}
+
+func (h *UserHandler) bulkAssignRole(data json.RawMessage) (bulk.Apply, error) {
+	var assign dto.BulkAssignRoleDto
+	if err := bulk.DecodeData(data, &assign); err != nil {
+		return nil, err
+	}
+	roleID, err := assign.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		_, err := h.AssignUserRoleUseCase.Execute(ctx, id, roleID)
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, entity.ErrUserNotFound):
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "User not found", nil, http.StatusNotFound)
+		case errors.Is(err, entity.ErrRoleNotFound), errors.Is(err, entity.ErrRoleNotAssignable):
+			return middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest)
+		default:
+			return middleware.NewVersionConflictAppError(err, "Failed to assign role")
+		}
+	}, nil
+}
+
+func (h *UserHandler) bulkDelete(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		deleted, err := h.SoftDeleteUserUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to delete user", err, http.StatusInternalServerError)
+		}
+		if !deleted {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "User not found or already deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+func (h *UserHandler) bulkRestore(data json.RawMessage) (bulk.Apply, error) {
+	return func(ctx context.Context, id primitive.ObjectID) error {
+		restored, err := h.RestoreUserUseCase.Execute(ctx, id)
+		if err != nil {
+			return middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to restore user", err, http.StatusInternalServerError)
+		}
+		if !restored {
+			return middleware.NewAppError(middleware.ErrorCodeNotFound, "User not found or not deleted", nil, http.StatusNotFound)
+		}
+		return nil
+	}, nil
+}
+
+// bulkUser returns the user with id, or a not found error
+func (h *UserHandler) bulkUser(ctx context.Context, id primitive.ObjectID) (*entity.User, error) {
+	user, err := h.GetUserUseCase.Execute(ctx, id)
+	if err != nil {
+		return nil, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to fetch user", err, http.StatusInternalServerError)
+	}
+	if user == nil {
+		return nil, middleware.NewAppError(middleware.ErrorCodeNotFound, "User not found", nil, http.StatusNotFound)
+	}
+	return user, nil
+}
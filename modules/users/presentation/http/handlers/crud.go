@@ -3,7 +3,10 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,7 +20,7 @@ import (
 //	@Accept			json
 //	@Produce		json
 //	@Param			user	body		dto.CreateUserDto	true	"User data"
-//	@Success		201		{object}	models.SwaggerStandardResponse{data=entity.User}
+//	@Success		201		{object}	models.SwaggerStandardResponse{data=dto.UserResponse}
 //	@Failure		400		{object}	models.SwaggerErrorResponse
 //	@Failure		409		{object}	models.SwaggerErrorResponse
 //	@Failure		422		{object}	models.SwaggerErrorResponse
@@ -47,8 +50,9 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	// Convert to entity
+	// Convert to entity, storing a profile photo of this storage by key
 	user := createDto.ToEntity()
+	user.ProfilePhotoURL = services.FileKey(h.fileService, user.ProfilePhotoURL)
 
 	// Call use case to create
 	if err := h.CreateUserUseCase.Execute(c.Request.Context(), user); err != nil {
@@ -80,7 +84,8 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, user)
+	h.resolveFileURLs(user)
+	c.JSON(http.StatusCreated, dto.NewUserResponse(user))
 }
 
 // GetUser godoc
@@ -91,7 +96,11 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"User ID"	example("507f1f77bcf86cd799439011")
-//	@Success		200	{object}	models.SwaggerStandardResponse{data=entity.User}
+//	@Param			fields	query		string	false	"Comma separated members to return, the ID is always included"	example(fullName,status)
+//	@Param			expand	query		string	false	"Comma separated relationships to include"	Enums(role, organization, permissions)
+//	@Param			If-None-Match	header		string	false	"ETag from an earlier read, answered with 304 if the user is unchanged"
+//	@Success		200	{object}	models.SwaggerStandardResponse{data=dto.UserResponse}
+//	@Header			200	{string}	ETag	"Version of the user, send it as If-Match when updating"
 //	@Failure		400	{object}	models.SwaggerErrorResponse
 //	@Failure		404	{object}	models.SwaggerErrorResponse
 //	@Failure		500	{object}	models.SwaggerErrorResponse
@@ -99,6 +108,17 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 func (h *UserHandler) GetUser(c *gin.Context) {
 	id := c.Param("id")
 
+	fields, err := fieldset.FromQuery(c, dto.UserResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+	expand, err := fieldset.ExpandFromQuery(c, dto.UserExpandable...)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
 	objectId, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		middleware.HandleError(c, middleware.NewAppError(
@@ -131,7 +151,31 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, user)
+	if middleware.NotModified(c, user.Version) {
+		return
+	}
+
+	responses, err := h.userResponses(c.Request.Context(), []*entity.User{user}, expand)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch user",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+	response, err := fieldset.Select(responses[0], fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch user",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // UpdateUser godoc
@@ -143,9 +187,13 @@ func (h *UserHandler) GetUser(c *gin.Context) {
 //	@Produce		json
 //	@Param			id		path		string				true	"User ID"
 //	@Param			user	body		dto.UpdateUserDto	true	"User data to update"
-//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.User}
+//	@Param			If-Match	header		string	true	"ETag of the user being updated"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=dto.UserResponse}
+//	@Header			200	{string}	ETag	"New version of the user"
 //	@Failure		400		{object}	models.SwaggerErrorResponse
 //	@Failure		404		{object}	models.SwaggerErrorResponse
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The user was changed by another request"
+//	@Failure		428	{object}	models.SwaggerErrorResponse	"If-Match header missing"
 //	@Failure		500		{object}	models.SwaggerErrorResponse
 //	@Router			/users/{id} [put]
 func (h *UserHandler) UpdateUser(c *gin.Context) {
@@ -184,6 +232,12 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
+	// Refuse to overwrite changes the client has not seen
+	if appErr := middleware.CheckIfMatch(c, existingUser.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
 	// Parse update DTO
 	var updateDto dto.UpdateUserDto
 	if err := c.ShouldBindJSON(&updateDto); err != nil {
@@ -207,21 +261,23 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	// Apply updates to the existing user
+	// Apply updates to the existing user. A profile photo URL of this storage, such as one
+	// returned by a previous read, is stored by key.
+	if updateDto.ProfilePhotoURL != nil {
+		photo := services.FileKey(h.fileService, *updateDto.ProfilePhotoURL)
+		updateDto.ProfilePhotoURL = &photo
+	}
 	updateDto.ApplyUpdates(existingUser)
 
 	// Call use case to update
 	if err := h.UpdateUserUseCase.Execute(c.Request.Context(), existingUser); err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to update user",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update user"))
 		return
 	}
 
-	c.JSON(http.StatusOK, existingUser)
+	middleware.SetETag(c, existingUser.Version)
+	h.resolveFileURLs(existingUser)
+	c.JSON(http.StatusOK, dto.NewUserResponse(existingUser))
 }
 
 // DeleteUser godoc
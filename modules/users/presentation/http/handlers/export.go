@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/export"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
+)
+
+// ExportSource selects the users an export request covers: the same filters and sort as
+// ListUsers, limited to the caller's organization, or to the caller, below global scope
+func (h *UserHandler) ExportSource(c *gin.Context) (*export.Source, error) {
+	queryDto := dto.NewGetUsersDto(c)
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.UserFilterFields); err != nil {
+		return nil, err
+	}
+	sort, err := pagination.ParseSort(c.Query("sort"), dto.UserSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if authCtx := middleware.GetAuthContext(c.Request.Context()); authCtx != nil {
+		switch authCtx.RoleScope {
+		case roleEntity.RoleScopeGlobal:
+		case roleEntity.RoleScopeSelf:
+			filter["_id"] = authCtx.UserID
+		default:
+			if authCtx.OrganizationID != nil {
+				filter["organizationId"] = *authCtx.OrganizationID
+			}
+		}
+	}
+
+	return &export.Source{
+		Count: func(ctx context.Context) (int64, error) {
+			return h.ExportUsersUseCase.Count(ctx, filter)
+		},
+		Rows: func(ctx context.Context, emit func(map[string]string) error) error {
+			return h.ExportUsersUseCase.Execute(ctx, filter, sort, func(user *entity.User) error {
+				return emit(dto.UserExportRow(user))
+			})
+		},
+	}, nil
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	roleEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/roles/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
+)
+
+// ImportUsers godoc
+//
+//	@Summary		Import users
+//	@Description	Upload a CSV with name, email, phone and role columns to invite users to one organization. Roles are matched by name among the system roles and the organization's roles. Every row is validated and checked for duplicate emails and phones, and the response reports the outcome of each row. Valid rows are created even when others fail, and invitations are sent in the background. With dryRun nothing is created.
+//	@Tags			users
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file			formData	file	true	"CSV file"
+//	@Param			organizationId	query		string	false	"Organization to import into, required for global admins and defaulting to the caller's own organization otherwise"
+//	@Param			dryRun			query		bool	false	"Validate and report without creating users"	default(false)
+//	@Success		200				{object}	models.SwaggerStandardResponse{data=entity.UserImportReport}
+//	@Failure		400				{object}	models.SwaggerErrorResponse
+//	@Failure		403				{object}	models.SwaggerErrorResponse
+//	@Failure		404				{object}	models.SwaggerErrorResponse
+//	@Failure		413				{object}	models.SwaggerErrorResponse
+//	@Failure		500				{object}	models.SwaggerErrorResponse
+//	@Router			/users/import [post]
+func (h *UserHandler) ImportUsers(c *gin.Context) {
+	var queryDto dto.ImportUsersQueryDto
+	if err := c.ShouldBindQuery(&queryDto); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Invalid query parameters", err, http.StatusBadRequest))
+		return
+	}
+
+	organizationID, appErr := importOrganization(c, queryDto.OrganizationID)
+	if appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "No import file provided", err, http.StatusBadRequest))
+		return
+	}
+	if fileHeader.Size > dto.MaxImportFileSize {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, fmt.Sprintf("Import file exceeds %dMB", dto.MaxImportFileSize>>20), nil, http.StatusRequestEntityTooLarge))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInvalidRequest, "Failed to read import file", err, http.StatusBadRequest))
+		return
+	}
+	defer file.Close()
+
+	records, err := dto.ParseUserImport(io.LimitReader(file, dto.MaxImportFileSize))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeValidationFailed, err.Error(), nil, http.StatusBadRequest))
+		return
+	}
+
+	roles, err := h.ImportUsersUseCase.AssignableRoles(c.Request.Context(), organizationID)
+	if errors.Is(err, entity.ErrImportOrganizationNotFound) {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeNotFound, "Organization not found", nil, http.StatusNotFound))
+		return
+	}
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to import users", err, http.StatusInternalServerError))
+		return
+	}
+
+	rows := make([]entity.UserImportRow, len(records))
+	for i, record := range records {
+		rows[i] = record.ToRow(organizationID.Hex(), roles)
+	}
+
+	invitedBy := ""
+	if authCtx := middleware.GetAuthContext(c.Request.Context()); authCtx != nil {
+		invitedBy = authCtx.UserID.Hex()
+	}
+
+	report, err := h.ImportUsersUseCase.Execute(c.Request.Context(), organizationID, rows, queryDto.DryRun, invitedBy)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(middleware.ErrorCodeInternalServer, "Failed to import users", err, http.StatusInternalServerError))
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// importOrganization returns the organization an import adds users to. Global admins
+// name it; everyone else imports into their own organization.
+func importOrganization(c *gin.Context, requested string) (primitive.ObjectID, *middleware.AppError) {
+	authCtx := middleware.GetAuthContext(c.Request.Context())
+	if authCtx == nil {
+		return primitive.NilObjectID, middleware.NewAppError(middleware.ErrorCodeUnauthorized, "Authentication required", nil, http.StatusUnauthorized)
+	}
+
+	if authCtx.RoleScope == roleEntity.RoleScopeGlobal {
+		if requested == "" {
+			return primitive.NilObjectID, middleware.NewAppError(middleware.ErrorCodeValidationFailed, "organizationId is required", nil, http.StatusBadRequest)
+		}
+		organizationID, err := primitive.ObjectIDFromHex(requested)
+		if err != nil {
+			return primitive.NilObjectID, middleware.NewAppError(middleware.ErrorCodeValidationFailed, dto.ErrInvalidOrganizationID.Error(), nil, http.StatusBadRequest)
+		}
+		return organizationID, nil
+	}
+
+	if authCtx.OrganizationID == nil {
+		return primitive.NilObjectID, middleware.NewAppError(middleware.ErrorCodeForbidden, "Importing users requires an organization", nil, http.StatusForbidden)
+	}
+	if requested != "" && requested != authCtx.OrganizationID.Hex() {
+		return primitive.NilObjectID, middleware.NewAppError(middleware.ErrorCodeForbidden, "Cannot import users into another organization", nil, http.StatusForbidden)
+	}
+	return *authCtx.OrganizationID, nil
+}
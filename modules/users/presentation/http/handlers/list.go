@@ -3,7 +3,10 @@ package handlers
 import (
 	"net/http"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/listfilter"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/pagination"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
 
 	"github.com/gin-gonic/gin"
@@ -18,6 +21,13 @@ import (
 //	@Produce		json
 //	@Param			page			query		int									false	"Page number"		default(1)
 //	@Param			limit			query		int									false	"Items per page"	default(10)	maximum(100)
+//	@Param			sort			query		string								false	"Comma separated sort fields, prefixed with - for descending"	example(-createdAt)
+//	@Param			after			query		string								false	"Cursor from nextCursor, returns the page after it"
+//	@Param			before			query		string								false	"Cursor from prevCursor, returns the page before it"
+//	@Param			count			query		string								false	"How total is computed, exact by default with page numbers and none with cursors"	Enums(exact, estimated, none)
+//	@Param			filter			query		string								false	"Filter expression, clauses field:operator:value separated by ;"	example(createdAt:gte:2026-01-01)
+//	@Param			fields			query		string								false	"Comma separated members to return, the ID is always included"	example(fullName,status)
+//	@Param			expand			query		string								false	"Comma separated relationships to include"	Enums(role, organization, permissions)
 //	@Param			fullName		query		string								false	"Filter by full name (partial match, case-insensitive)"
 //	@Param			email			query		string								false	"Filter by email (partial match, case-insensitive)"
 //	@Param			phone			query		string								false	"Filter by phone number (partial match, case-insensitive)"
@@ -34,32 +44,52 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
 	// Parse query parameters using DTO
 	queryDto := dto.NewGetUsersDto(c)
 
+	listOpts, err := pagination.FromQuery(c, queryDto.Page, queryDto.Limit, dto.UserSortFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
+		return
+	}
+	fields, err := fieldset.FromQuery(c, dto.UserResponseFields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
+		return
+	}
+	expand, err := fieldset.ExpandFromQuery(c, dto.UserExpandable...)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
+		return
+	}
+
+	filter := queryDto.ToFilterMap()
+	if err := listfilter.Apply(filter, c.Query("filter"), dto.UserFilterFields); err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
+		return
+	}
+
 	// Call use case with filter from DTO
-	users, total, err := h.ListUsersUseCase.Execute(
+	users, pageInfo, err := h.ListUsersUseCase.Execute(
 		c.Request.Context(),
-		queryDto.ToFilterMap(),
-		queryDto.Page,
-		queryDto.Limit,
+		filter,
+		listOpts,
 	)
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to fetch users",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
 		return
 	}
 
 	// Prepare response
-	response := gin.H{
-		"items":          users,
-		"page":           queryDto.Page,
-		"limit":          queryDto.Limit,
-		"total":          total,
-		"totalPages":     (total + int64(queryDto.Limit) - 1) / int64(queryDto.Limit),
-		"includeDeleted": queryDto.IncludeDeleted,
+	responses, err := h.userResponses(c.Request.Context(), users, expand)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
+		return
+	}
+	items, err := fieldset.SelectAll(responses, fields)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewListAppError(err, "Failed to fetch users"))
+		return
 	}
+	response := pagination.Response(items, pageInfo)
+	response["includeDeleted"] = queryDto.IncludeDeleted
 
 	c.JSON(http.StatusOK, response)
 }
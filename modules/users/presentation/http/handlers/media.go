@@ -2,11 +2,12 @@ package handlers
 
 import (
 	"net/http"
-	"path/filepath"
-	"strings"
 	"time"
 
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -14,16 +15,18 @@ import (
 // UploadUserProfilePhoto godoc
 //
 //	@Summary		Upload user profile photo
-//	@Description	Upload a new profile photo for an user and update its record
+//	@Description	Upload a new profile photo for an user and update its record. The file is checked by the upload scanners and rejected files are quarantined. The image type is detected from its content, metadata is stripped and resized variants are stored in profilePhotoVariants. SVGs are rejected.
 //	@Tags			users
 //	@Accept			multipart/form-data
 //	@Produce		json
 //	@Param			id		path		string															true	"User ID"	example("6824886e6b180b753cea43e9")
 //	@Param			file	formData	file															true	"Logo image file"
-//	@Success		200		{object}	models.SwaggerStandardResponse{data=entity.User}	"Updated user"
+//	@Success		200		{object}	models.SwaggerStandardResponse{data=dto.UserResponse}	"Updated user"
 //	@Failure		400		{object}	models.SwaggerErrorResponse									"Invalid request"
 //	@Failure		404		{object}	models.SwaggerErrorResponse									"User not found"
 //	@Failure		413		{object}	models.SwaggerErrorResponse									"File too large"
+//	@Failure		422		{object}	models.SwaggerErrorResponse									"File rejected by the upload scanners"
+//	@Failure		503		{object}	models.SwaggerErrorResponse									"Upload scanners unavailable"
 //	@Failure		500		{object}	models.SwaggerErrorResponse									"Server error"
 //	@Router			/users/{id}/profile-photo [post]
 func (h *UserHandler) UploadUserProfilePhoto(c *gin.Context) {
@@ -87,66 +90,30 @@ func (h *UserHandler) UploadUserProfilePhoto(c *gin.Context) {
 		return
 	}
 
-	// Check file type (allow only images)
-	ext := strings.ToLower(filepath.Ext(header.Filename))
-	validExtensions := map[string]bool{
-		".jpg":  true,
-		".jpeg": true,
-		".png":  true,
-		".gif":  true,
-		".svg":  true,
-		".webp": true,
-	}
-
-	if !validExtensions[ext] {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInvalidRequest,
-			"Invalid file type, only images are allowed",
-			nil,
-			http.StatusBadRequest,
-		))
+	// Reject files whose content is not an allowed image, is too large or is infected
+	if err := h.uploadScanner.Scan(c.Request.Context(), services.NewMultipartScanInput(file, header, services.PhotoUploadTypes)); err != nil {
+		middleware.HandleError(c, middleware.NewUploadScanAppError(err, "Failed to scan uploaded file"))
 		return
 	}
 
-	// Determine content type
-	contentType := ""
-	switch ext {
-	case ".jpg", ".jpeg":
-		contentType = "image/jpeg"
-	case ".png":
-		contentType = "image/png"
-	case ".gif":
-		contentType = "image/gif"
-	case ".svg":
-		contentType = "image/svg+xml"
-	case ".webp":
-		contentType = "image/webp"
-	}
-
-	// Upload file to S3
-	profilePhotoURL, err := h.fileService.UploadFile(c.Request.Context(), file, header.Filename, contentType)
+	// Validate the image by content, strip its metadata and store it with its variants.
+	// Profile photos are always raster images.
+	photo, err := h.imagePipeline.Process(c.Request.Context(), file, header.Filename, services.ImageOptions{})
 	if err != nil {
-		middleware.HandleError(c, middleware.NewAppError(
-			middleware.ErrorCodeInternalServer,
-			"Failed to upload profile photo",
-			err,
-			http.StatusInternalServerError,
-		))
+		middleware.HandleError(c, middleware.NewImageAppError(err, "Failed to upload profile photo"))
 		return
 	}
 
-	// Delete old profile photo if it exists
-	if user.ProfilePhotoURL != "" {
-		// Try to delete the old profile photo, but don't fail if it doesn't work
-		_ = h.fileService.DeleteFile(c.Request.Context(), user.ProfilePhotoURL)
-	}
+	previousPhoto := &models.ProcessedImage{URL: user.ProfilePhotoURL, Variants: user.ProfilePhotoVariants}
 
 	// Update user with new profile photo URL
-	user.ProfilePhotoURL = profilePhotoURL
+	user.ProfilePhotoURL = photo.URL
+	user.ProfilePhotoVariants = photo.Variants
 	user.UpdatedAt = time.Now()
 
 	// Save updated user
 	if err := h.UpdateUserUseCase.Execute(c.Request.Context(), user); err != nil {
+		h.imagePipeline.DeleteImage(c.Request.Context(), photo)
 		middleware.HandleError(c, middleware.NewAppError(
 			middleware.ErrorCodeInternalServer,
 			"Failed to update user with new profile photo",
@@ -156,6 +123,12 @@ func (h *UserHandler) UploadUserProfilePhoto(c *gin.Context) {
 		return
 	}
 
+	// Delete the old profile photo and its variants only once the new one is saved
+	if previousPhoto.URL != "" {
+		h.imagePipeline.DeleteImage(c.Request.Context(), previousPhoto)
+	}
+
 	// Return updated user
-	c.JSON(http.StatusOK, user)
+	h.resolveFileURLs(user)
+	c.JSON(http.StatusOK, dto.NewUserResponse(user))
 }
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/patch"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
+)
+
+// PatchUser godoc
+//
+//	@Summary		Partially update a user
+//	@Description	Apply a JSON Merge Patch (application/merge-patch+json) or JSON Patch (application/json-patch+json) to the updatable fields of a user. Only the changed fields are validated and saved; removing a field clears it.
+//	@Tags			users
+//	@Accept			application/merge-patch+json,application/json-patch+json
+//	@Produce		json
+//	@Param			id			path		string	true	"User ID"
+//	@Param			patch		body		dto.UpdateUserDto	true	"Merge patch of the fields to change, or an array of JSON Patch operations"
+//	@Param			If-Match	header		string	false	"ETag of the user; when sent, the patch is refused if the user has changed since"
+//	@Success		200			{object}	models.SwaggerStandardResponse{data=dto.UserResponse}
+//	@Header			200	{string}	ETag	"New version of the user"
+//	@Failure		400			{object}	models.SwaggerErrorResponse
+//	@Failure		404			{object}	models.SwaggerErrorResponse
+//	@Failure		409	{object}	models.SwaggerErrorResponse	"A JSON Patch test operation failed"
+//	@Failure		412	{object}	models.SwaggerErrorResponse	"The user was changed by another request"
+//	@Failure		415	{object}	models.SwaggerErrorResponse	"Unsupported patch format"
+//	@Failure		500			{object}	models.SwaggerErrorResponse
+//	@Router			/users/{id} [patch]
+func (h *UserHandler) PatchUser(c *gin.Context) {
+	objectId, err := primitive.ObjectIDFromHex(c.Param("id"))
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid user ID",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	existingUser, err := h.GetUserUseCase.Execute(c.Request.Context(), objectId)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInternalServer,
+			"Failed to fetch user",
+			err,
+			http.StatusInternalServerError,
+		))
+		return
+	}
+
+	if existingUser == nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeNotFound,
+			"User not found",
+			nil,
+			http.StatusNotFound,
+		))
+		return
+	}
+
+	if appErr := middleware.CheckIfMatchIfPresent(c, existingUser.Version); appErr != nil {
+		middleware.HandleError(c, appErr)
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeInvalidRequest,
+			"Invalid request body",
+			err,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// Patch the updatable fields and keep only the ones the patch changed
+	var changes dto.UpdateUserDto
+	if err := patch.ApplyTo(c.ContentType(), dto.NewUpdateUserDto(existingUser), body, &changes); err != nil {
+		middleware.HandleError(c, middleware.NewPatchAppError(c, err, "Failed to apply patch"))
+		return
+	}
+
+	if err := changes.ValidatePatch(); err != nil {
+		middleware.HandleError(c, middleware.NewAppError(
+			middleware.ErrorCodeValidationFailed,
+			err.Error(),
+			nil,
+			http.StatusBadRequest,
+		))
+		return
+	}
+
+	// A profile photo URL of this storage, such as one returned by a previous read, is stored by key
+	if changes.ProfilePhotoURL != nil {
+		photo := services.FileKey(h.fileService, *changes.ProfilePhotoURL)
+		changes.ProfilePhotoURL = &photo
+	}
+	original := *existingUser
+	changes.ApplyUpdates(existingUser)
+
+	if err := h.PatchUserUseCase.Execute(c.Request.Context(), &original, existingUser); err != nil {
+		middleware.HandleError(c, middleware.NewVersionConflictAppError(err, "Failed to update user"))
+		return
+	}
+
+	middleware.SetETag(c, existingUser.Version)
+	h.resolveFileURLs(existingUser)
+	c.JSON(http.StatusOK, dto.NewUserResponse(existingUser))
+}
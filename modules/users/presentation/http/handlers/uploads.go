@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/models"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+)
+
+// UserExists reports whether a user exists, for opening direct uploads
+func (h *UserHandler) UserExists(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	user, err := h.GetUserUseCase.Execute(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return user != nil, nil
+}
+
+// AttachUploadedProfilePhoto replaces the user's profile photo with a confirmed direct upload
+func (h *UserHandler) AttachUploadedProfilePhoto(ctx context.Context, session *uploadEntity.UploadSession) (interface{}, error) {
+	user, err := h.GetUserUseCase.Execute(ctx, session.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	// Direct uploads go through the same validation and variant generation as proxied ones
+	photo, err := h.imagePipeline.ProcessStored(ctx, session.Key, session.FileName, services.ImageOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	previousPhoto := &models.ProcessedImage{URL: user.ProfilePhotoURL, Variants: user.ProfilePhotoVariants}
+	user.ProfilePhotoURL = photo.URL
+	user.ProfilePhotoVariants = photo.Variants
+	user.UpdatedAt = time.Now()
+	if err := h.UpdateUserUseCase.Execute(ctx, user); err != nil {
+		h.imagePipeline.DeleteImage(ctx, photo)
+		return nil, err
+	}
+
+	// The raw upload is replaced by its cleaned copy; old photo deletion must not fail the request
+	_ = h.fileService.DeleteFile(ctx, session.Key)
+	if previousPhoto.URL != "" {
+		h.imagePipeline.DeleteImage(ctx, previousPhoto)
+	}
+	h.resolveFileURLs(user)
+	return user, nil
+}
@@ -1,8 +1,13 @@
 package handlers
 
 import (
+	"context"
+
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/commons/services"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/fieldset"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/entity"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/domain/usecases"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
 )
 
 // UserHandler handles HTTP requests for Users
@@ -19,6 +24,13 @@ type UserHandler struct {
 	UpdateUserStatusUseCase    *usecases.UpdateUserStatusUseCase
 	fileService                services.FileService
 	FindUserByEmailUsecase     *usecases.FindUserByEmailUsecase
+	imagePipeline              *services.ImagePipeline
+	uploadScanner              *services.UploadScanPipeline
+	PatchUserUseCase           *usecases.PatchUserUseCase
+	ExpandUsersUseCase         *usecases.ExpandUsersUseCase
+	ExportUsersUseCase         *usecases.ExportUsersUseCase
+	ImportUsersUseCase         *usecases.ImportUsersUseCase
+	AssignUserRoleUseCase      *usecases.AssignUserRoleUseCase
 }
 
 func NewUserHandler(GetUserUseCase *usecases.GetUserUseCase,
@@ -33,6 +45,13 @@ func NewUserHandler(GetUserUseCase *usecases.GetUserUseCase,
 	UpdateUserStatusUseCase *usecases.UpdateUserStatusUseCase,
 	fileService services.FileService,
 	FindUserByEmailUsecase *usecases.FindUserByEmailUsecase,
+	imagePipeline *services.ImagePipeline,
+	uploadScanner *services.UploadScanPipeline,
+	PatchUserUseCase *usecases.PatchUserUseCase,
+	ExpandUsersUseCase *usecases.ExpandUsersUseCase,
+	ExportUsersUseCase *usecases.ExportUsersUseCase,
+	ImportUsersUseCase *usecases.ImportUsersUseCase,
+	AssignUserRoleUseCase *usecases.AssignUserRoleUseCase,
 ) *UserHandler {
 	return &UserHandler{
 		GetUserUseCase:             GetUserUseCase,
@@ -47,5 +66,45 @@ func NewUserHandler(GetUserUseCase *usecases.GetUserUseCase,
 		UpdateUserStatusUseCase:    UpdateUserStatusUseCase,
 		fileService:                fileService,
 		FindUserByEmailUsecase:     FindUserByEmailUsecase,
+		imagePipeline:              imagePipeline,
+		uploadScanner:              uploadScanner,
+		PatchUserUseCase:           PatchUserUseCase,
+		ExpandUsersUseCase:         ExpandUsersUseCase,
+		ExportUsersUseCase:         ExportUsersUseCase,
+		ImportUsersUseCase:         ImportUsersUseCase,
+		AssignUserRoleUseCase:      AssignUserRoleUseCase,
+	}
+}
+
+// resolveFileURLs replaces the stored profile photo keys of users with URLs for the response
+func (h *UserHandler) resolveFileURLs(users ...*entity.User) {
+	for _, user := range users {
+		if user != nil {
+			user.ResolveFileURLs(h.resolveFileURL)
+		}
+	}
+}
+
+// resolveFileURL returns the URL to serve for a stored file reference
+func (h *UserHandler) resolveFileURL(ref string) string {
+	return services.ResolveFileURL(h.fileService, ref)
+}
+
+// userResponses builds the responses for users, with the relationships selected by
+// expand loaded in one batch for all of them
+func (h *UserHandler) userResponses(ctx context.Context, users []*entity.User, expand fieldset.Set) ([]*dto.UserResponse, error) {
+	h.resolveFileURLs(users...)
+	responses := dto.NewUserResponses(users)
+	if len(expand) == 0 {
+		return responses, nil
+	}
+
+	relations, err := h.ExpandUsersUseCase.Execute(ctx, users, dto.ToUserExpansion(expand))
+	if err != nil {
+		return nil, err
+	}
+	for _, response := range responses {
+		response.Expand(expand, relations, h.resolveFileURL)
 	}
+	return responses, nil
 }
@@ -2,33 +2,49 @@ package routes
 
 import (
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/container"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/bulk"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/constants"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/internal/middleware"
+	exportHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/exports/presentation/http/handlers"
+	orgEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/organizations/domain/entity"
+	uploadEntity "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/domain/entity"
+	uploadHandlers "bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/uploads/presentation/http/handlers"
+	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/dto"
 	"bitbucket.org/abhishek_fordel/we-care-holidays-backend-golang/modules/users/presentation/http/handlers"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterUserRoutes(router *gin.RouterGroup, handler *handlers.UserHandler, app *container.AppContainer) {
+func RegisterUserRoutes(router *gin.RouterGroup, handler *handlers.UserHandler, app *container.AppContainer, uploads *uploadHandlers.UploadHandler, exports *exportHandlers.ExportHandler, bulkOps *bulk.Handler) {
 	userGroup := router.Group(constants.UserBasePath)
 
 	userGroup.Use(middleware.AutoGuard(app.RBACService))
 	{
 		userGroup.GET(constants.ListUsersPath, handler.ListUsers)
 		userGroup.POST(constants.CreateUserPath, handler.CreateUser)
+		userGroup.POST(constants.ImportUsersPath,
+			middleware.RequireFeatureFlag(app.Organization.FeatureFlagEvaluator, orgEntity.FeatureFlagBulkImport),
+			handler.ImportUsers)
 
 		userGroup.DELETE(constants.BulkDeleteUsersPath, handler.BulkDeleteUsers)
 		userGroup.POST(constants.BulkRestoreUsersPath, handler.BulkRestoreUsers)
+		userGroup.GET(constants.ExportUsersPath, exports.RequireEnabled(), exports.Export("users", dto.UserExportColumns, handler.ExportSource))
 
 		userGroup.GET(constants.GetUserPath, handler.GetUser)
 		userGroup.PUT(constants.UpdateUserPath, handler.UpdateUser)
+		userGroup.PATCH(constants.UpdateUserPath, handler.PatchUser)
 		userGroup.DELETE(constants.DeleteUserPath, handler.DeleteUser)
 
 		userGroup.POST(constants.RestoreUserPath, handler.RestoreUser)
 
 		userGroup.POST(constants.UploadUserAvatarPath, handler.UploadUserProfilePhoto)
+		userGroup.POST(constants.UserProfilePhotoUploadsPath, uploads.CreateUploadSession(uploadEntity.TargetUserProfilePhoto, handler.UserExists))
+		userGroup.POST(constants.ConfirmUserProfilePhotoUploadPath, uploads.ConfirmUploadSession(uploadEntity.TargetUserProfilePhoto, handler.AttachUploadedProfilePhoto))
 
 		userGroup.DELETE(constants.HardDeleteUserPath, handler.HardDeleteUser)
 
 	}
 
+	// The bulk endpoint checks the permission of each operation and scopes its records itself
+	bulkGroup := router.Group(constants.UserBasePath, middleware.MultiLayerGuard(app.RBACService, middleware.GuardConfig{RequireAuth: true}))
+	bulkGroup.POST(constants.BulkUsersPath, bulkOps.Bulk("users", handler.BulkOps))
 }